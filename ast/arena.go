@@ -0,0 +1,668 @@
+// Code generated by tools/listgen
+// DO NOT EDIT!
+package ast
+
+// arenaMaxChunkSize is the maximum number of values in each chunk of memory allocated by an Arena.
+// Chunks start small, and double in size up to this limit.
+const arenaMaxChunkSize = 1024
+
+// Arena allocates AST nodes in large chunks of memory, rather than individually, so that a whole
+// document is backed by a few large allocations, which can be released together. The zero value
+// is ready to use. Methods may be called on a nil Arena, in which case each node is allocated on
+// the heap individually instead. An Arena is not safe for concurrent use.
+type Arena struct {
+	listArguments                []Arguments
+	listComments                 []Comments
+	listDefinitions              []Definitions
+	listDirectives               []Directives
+	listEnumValueDefinitions     []EnumValueDefinitions
+	listFieldDefinitions         []FieldDefinitions
+	listInputValueDefinitions    []InputValueDefinitions
+	listOperationTypeDefinitions []OperationTypeDefinitions
+	listPathNodes                []PathNodes
+	listPositions                []Positions
+	listSelections               []Selections
+	listTypes                    []Types
+	listVariableDefinitions      []VariableDefinitions
+	nodeDirectiveDefinition      []DirectiveDefinition
+	nodeExecutableDefinition     []ExecutableDefinition
+	nodeFragmentDefinition       []FragmentDefinition
+	nodeOperationDefinition      []OperationDefinition
+	nodeSchemaDefinition         []SchemaDefinition
+	nodeSchemaExtension          []SchemaExtension
+	nodeType                     []Type
+	nodeTypeCondition            []TypeCondition
+	nodeTypeDefinition           []TypeDefinition
+	nodeTypeExtension            []TypeExtension
+	nodeTypeSystemDefinition     []TypeSystemDefinition
+	nodeTypeSystemExtension      []TypeSystemExtension
+}
+
+// NewArena returns a new, empty Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// Reset releases all of the memory allocated by this arena, apart from the most recent chunk of
+// each type, which is cleared and reused. Nodes allocated before calling Reset must not be used
+// afterwards.
+func (a *Arena) Reset() {
+	for i := range a.listArguments {
+		a.listArguments[i] = Arguments{}
+	}
+	a.listArguments = a.listArguments[:0]
+	for i := range a.listComments {
+		a.listComments[i] = Comments{}
+	}
+	a.listComments = a.listComments[:0]
+	for i := range a.listDefinitions {
+		a.listDefinitions[i] = Definitions{}
+	}
+	a.listDefinitions = a.listDefinitions[:0]
+	for i := range a.listDirectives {
+		a.listDirectives[i] = Directives{}
+	}
+	a.listDirectives = a.listDirectives[:0]
+	for i := range a.listEnumValueDefinitions {
+		a.listEnumValueDefinitions[i] = EnumValueDefinitions{}
+	}
+	a.listEnumValueDefinitions = a.listEnumValueDefinitions[:0]
+	for i := range a.listFieldDefinitions {
+		a.listFieldDefinitions[i] = FieldDefinitions{}
+	}
+	a.listFieldDefinitions = a.listFieldDefinitions[:0]
+	for i := range a.listInputValueDefinitions {
+		a.listInputValueDefinitions[i] = InputValueDefinitions{}
+	}
+	a.listInputValueDefinitions = a.listInputValueDefinitions[:0]
+	for i := range a.listOperationTypeDefinitions {
+		a.listOperationTypeDefinitions[i] = OperationTypeDefinitions{}
+	}
+	a.listOperationTypeDefinitions = a.listOperationTypeDefinitions[:0]
+	for i := range a.listPathNodes {
+		a.listPathNodes[i] = PathNodes{}
+	}
+	a.listPathNodes = a.listPathNodes[:0]
+	for i := range a.listPositions {
+		a.listPositions[i] = Positions{}
+	}
+	a.listPositions = a.listPositions[:0]
+	for i := range a.listSelections {
+		a.listSelections[i] = Selections{}
+	}
+	a.listSelections = a.listSelections[:0]
+	for i := range a.listTypes {
+		a.listTypes[i] = Types{}
+	}
+	a.listTypes = a.listTypes[:0]
+	for i := range a.listVariableDefinitions {
+		a.listVariableDefinitions[i] = VariableDefinitions{}
+	}
+	a.listVariableDefinitions = a.listVariableDefinitions[:0]
+	for i := range a.nodeDirectiveDefinition {
+		a.nodeDirectiveDefinition[i] = DirectiveDefinition{}
+	}
+	a.nodeDirectiveDefinition = a.nodeDirectiveDefinition[:0]
+	for i := range a.nodeExecutableDefinition {
+		a.nodeExecutableDefinition[i] = ExecutableDefinition{}
+	}
+	a.nodeExecutableDefinition = a.nodeExecutableDefinition[:0]
+	for i := range a.nodeFragmentDefinition {
+		a.nodeFragmentDefinition[i] = FragmentDefinition{}
+	}
+	a.nodeFragmentDefinition = a.nodeFragmentDefinition[:0]
+	for i := range a.nodeOperationDefinition {
+		a.nodeOperationDefinition[i] = OperationDefinition{}
+	}
+	a.nodeOperationDefinition = a.nodeOperationDefinition[:0]
+	for i := range a.nodeSchemaDefinition {
+		a.nodeSchemaDefinition[i] = SchemaDefinition{}
+	}
+	a.nodeSchemaDefinition = a.nodeSchemaDefinition[:0]
+	for i := range a.nodeSchemaExtension {
+		a.nodeSchemaExtension[i] = SchemaExtension{}
+	}
+	a.nodeSchemaExtension = a.nodeSchemaExtension[:0]
+	for i := range a.nodeType {
+		a.nodeType[i] = Type{}
+	}
+	a.nodeType = a.nodeType[:0]
+	for i := range a.nodeTypeCondition {
+		a.nodeTypeCondition[i] = TypeCondition{}
+	}
+	a.nodeTypeCondition = a.nodeTypeCondition[:0]
+	for i := range a.nodeTypeDefinition {
+		a.nodeTypeDefinition[i] = TypeDefinition{}
+	}
+	a.nodeTypeDefinition = a.nodeTypeDefinition[:0]
+	for i := range a.nodeTypeExtension {
+		a.nodeTypeExtension[i] = TypeExtension{}
+	}
+	a.nodeTypeExtension = a.nodeTypeExtension[:0]
+	for i := range a.nodeTypeSystemDefinition {
+		a.nodeTypeSystemDefinition[i] = TypeSystemDefinition{}
+	}
+	a.nodeTypeSystemDefinition = a.nodeTypeSystemDefinition[:0]
+	for i := range a.nodeTypeSystemExtension {
+		a.nodeTypeSystemExtension[i] = TypeSystemExtension{}
+	}
+	a.nodeTypeSystemExtension = a.nodeTypeSystemExtension[:0]
+}
+
+// arenaChunkSize returns the size of the chunk to allocate after a chunk of the given size.
+func arenaChunkSize(prev int) int {
+	if prev == 0 {
+		return 16
+	}
+
+	if prev >= arenaMaxChunkSize {
+		return arenaMaxChunkSize
+	}
+
+	return prev * 2
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Arguments) AddIn(a *Arena, data Argument) *Arguments {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listArguments) == cap(a.listArguments) {
+		a.listArguments = make([]Arguments, 0, arenaChunkSize(cap(a.listArguments)))
+	}
+
+	a.listArguments = append(a.listArguments, Arguments{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listArguments[len(a.listArguments)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Comments) AddIn(a *Arena, data Comment) *Comments {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listComments) == cap(a.listComments) {
+		a.listComments = make([]Comments, 0, arenaChunkSize(cap(a.listComments)))
+	}
+
+	a.listComments = append(a.listComments, Comments{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listComments[len(a.listComments)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Definitions) AddIn(a *Arena, data Definition) *Definitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listDefinitions) == cap(a.listDefinitions) {
+		a.listDefinitions = make([]Definitions, 0, arenaChunkSize(cap(a.listDefinitions)))
+	}
+
+	a.listDefinitions = append(a.listDefinitions, Definitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listDefinitions[len(a.listDefinitions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Directives) AddIn(a *Arena, data Directive) *Directives {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listDirectives) == cap(a.listDirectives) {
+		a.listDirectives = make([]Directives, 0, arenaChunkSize(cap(a.listDirectives)))
+	}
+
+	a.listDirectives = append(a.listDirectives, Directives{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listDirectives[len(a.listDirectives)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *EnumValueDefinitions) AddIn(a *Arena, data EnumValueDefinition) *EnumValueDefinitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listEnumValueDefinitions) == cap(a.listEnumValueDefinitions) {
+		a.listEnumValueDefinitions = make([]EnumValueDefinitions, 0, arenaChunkSize(cap(a.listEnumValueDefinitions)))
+	}
+
+	a.listEnumValueDefinitions = append(a.listEnumValueDefinitions, EnumValueDefinitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listEnumValueDefinitions[len(a.listEnumValueDefinitions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *FieldDefinitions) AddIn(a *Arena, data FieldDefinition) *FieldDefinitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listFieldDefinitions) == cap(a.listFieldDefinitions) {
+		a.listFieldDefinitions = make([]FieldDefinitions, 0, arenaChunkSize(cap(a.listFieldDefinitions)))
+	}
+
+	a.listFieldDefinitions = append(a.listFieldDefinitions, FieldDefinitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listFieldDefinitions[len(a.listFieldDefinitions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *InputValueDefinitions) AddIn(a *Arena, data InputValueDefinition) *InputValueDefinitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listInputValueDefinitions) == cap(a.listInputValueDefinitions) {
+		a.listInputValueDefinitions = make([]InputValueDefinitions, 0, arenaChunkSize(cap(a.listInputValueDefinitions)))
+	}
+
+	a.listInputValueDefinitions = append(a.listInputValueDefinitions, InputValueDefinitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listInputValueDefinitions[len(a.listInputValueDefinitions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *OperationTypeDefinitions) AddIn(a *Arena, data OperationTypeDefinition) *OperationTypeDefinitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listOperationTypeDefinitions) == cap(a.listOperationTypeDefinitions) {
+		a.listOperationTypeDefinitions = make([]OperationTypeDefinitions, 0, arenaChunkSize(cap(a.listOperationTypeDefinitions)))
+	}
+
+	a.listOperationTypeDefinitions = append(a.listOperationTypeDefinitions, OperationTypeDefinitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listOperationTypeDefinitions[len(a.listOperationTypeDefinitions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *PathNodes) AddIn(a *Arena, data PathNode) *PathNodes {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listPathNodes) == cap(a.listPathNodes) {
+		a.listPathNodes = make([]PathNodes, 0, arenaChunkSize(cap(a.listPathNodes)))
+	}
+
+	a.listPathNodes = append(a.listPathNodes, PathNodes{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listPathNodes[len(a.listPathNodes)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Positions) AddIn(a *Arena, data Position) *Positions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listPositions) == cap(a.listPositions) {
+		a.listPositions = make([]Positions, 0, arenaChunkSize(cap(a.listPositions)))
+	}
+
+	a.listPositions = append(a.listPositions, Positions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listPositions[len(a.listPositions)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Selections) AddIn(a *Arena, data Selection) *Selections {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listSelections) == cap(a.listSelections) {
+		a.listSelections = make([]Selections, 0, arenaChunkSize(cap(a.listSelections)))
+	}
+
+	a.listSelections = append(a.listSelections, Selections{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listSelections[len(a.listSelections)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *Types) AddIn(a *Arena, data Type) *Types {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listTypes) == cap(a.listTypes) {
+		a.listTypes = make([]Types, 0, arenaChunkSize(cap(a.listTypes)))
+	}
+
+	a.listTypes = append(a.listTypes, Types{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listTypes[len(a.listTypes)-1]
+}
+
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *VariableDefinitions) AddIn(a *Arena, data VariableDefinition) *VariableDefinitions {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.listVariableDefinitions) == cap(a.listVariableDefinitions) {
+		a.listVariableDefinitions = make([]VariableDefinitions, 0, arenaChunkSize(cap(a.listVariableDefinitions)))
+	}
+
+	a.listVariableDefinitions = append(a.listVariableDefinitions, VariableDefinitions{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.listVariableDefinitions[len(a.listVariableDefinitions)-1]
+}
+
+// NewDirectiveDefinition returns a pointer to a copy of the given DirectiveDefinition, allocated in this arena.
+func (a *Arena) NewDirectiveDefinition(v DirectiveDefinition) *DirectiveDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeDirectiveDefinition) == cap(a.nodeDirectiveDefinition) {
+		a.nodeDirectiveDefinition = make([]DirectiveDefinition, 0, arenaChunkSize(cap(a.nodeDirectiveDefinition)))
+	}
+
+	a.nodeDirectiveDefinition = append(a.nodeDirectiveDefinition, v)
+
+	return &a.nodeDirectiveDefinition[len(a.nodeDirectiveDefinition)-1]
+}
+
+// NewExecutableDefinition returns a pointer to a copy of the given ExecutableDefinition, allocated in this arena.
+func (a *Arena) NewExecutableDefinition(v ExecutableDefinition) *ExecutableDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeExecutableDefinition) == cap(a.nodeExecutableDefinition) {
+		a.nodeExecutableDefinition = make([]ExecutableDefinition, 0, arenaChunkSize(cap(a.nodeExecutableDefinition)))
+	}
+
+	a.nodeExecutableDefinition = append(a.nodeExecutableDefinition, v)
+
+	return &a.nodeExecutableDefinition[len(a.nodeExecutableDefinition)-1]
+}
+
+// NewFragmentDefinition returns a pointer to a copy of the given FragmentDefinition, allocated in this arena.
+func (a *Arena) NewFragmentDefinition(v FragmentDefinition) *FragmentDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeFragmentDefinition) == cap(a.nodeFragmentDefinition) {
+		a.nodeFragmentDefinition = make([]FragmentDefinition, 0, arenaChunkSize(cap(a.nodeFragmentDefinition)))
+	}
+
+	a.nodeFragmentDefinition = append(a.nodeFragmentDefinition, v)
+
+	return &a.nodeFragmentDefinition[len(a.nodeFragmentDefinition)-1]
+}
+
+// NewOperationDefinition returns a pointer to a copy of the given OperationDefinition, allocated in this arena.
+func (a *Arena) NewOperationDefinition(v OperationDefinition) *OperationDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeOperationDefinition) == cap(a.nodeOperationDefinition) {
+		a.nodeOperationDefinition = make([]OperationDefinition, 0, arenaChunkSize(cap(a.nodeOperationDefinition)))
+	}
+
+	a.nodeOperationDefinition = append(a.nodeOperationDefinition, v)
+
+	return &a.nodeOperationDefinition[len(a.nodeOperationDefinition)-1]
+}
+
+// NewSchemaDefinition returns a pointer to a copy of the given SchemaDefinition, allocated in this arena.
+func (a *Arena) NewSchemaDefinition(v SchemaDefinition) *SchemaDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeSchemaDefinition) == cap(a.nodeSchemaDefinition) {
+		a.nodeSchemaDefinition = make([]SchemaDefinition, 0, arenaChunkSize(cap(a.nodeSchemaDefinition)))
+	}
+
+	a.nodeSchemaDefinition = append(a.nodeSchemaDefinition, v)
+
+	return &a.nodeSchemaDefinition[len(a.nodeSchemaDefinition)-1]
+}
+
+// NewSchemaExtension returns a pointer to a copy of the given SchemaExtension, allocated in this arena.
+func (a *Arena) NewSchemaExtension(v SchemaExtension) *SchemaExtension {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeSchemaExtension) == cap(a.nodeSchemaExtension) {
+		a.nodeSchemaExtension = make([]SchemaExtension, 0, arenaChunkSize(cap(a.nodeSchemaExtension)))
+	}
+
+	a.nodeSchemaExtension = append(a.nodeSchemaExtension, v)
+
+	return &a.nodeSchemaExtension[len(a.nodeSchemaExtension)-1]
+}
+
+// NewType returns a pointer to a copy of the given Type, allocated in this arena.
+func (a *Arena) NewType(v Type) *Type {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeType) == cap(a.nodeType) {
+		a.nodeType = make([]Type, 0, arenaChunkSize(cap(a.nodeType)))
+	}
+
+	a.nodeType = append(a.nodeType, v)
+
+	return &a.nodeType[len(a.nodeType)-1]
+}
+
+// NewTypeCondition returns a pointer to a copy of the given TypeCondition, allocated in this arena.
+func (a *Arena) NewTypeCondition(v TypeCondition) *TypeCondition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeTypeCondition) == cap(a.nodeTypeCondition) {
+		a.nodeTypeCondition = make([]TypeCondition, 0, arenaChunkSize(cap(a.nodeTypeCondition)))
+	}
+
+	a.nodeTypeCondition = append(a.nodeTypeCondition, v)
+
+	return &a.nodeTypeCondition[len(a.nodeTypeCondition)-1]
+}
+
+// NewTypeDefinition returns a pointer to a copy of the given TypeDefinition, allocated in this arena.
+func (a *Arena) NewTypeDefinition(v TypeDefinition) *TypeDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeTypeDefinition) == cap(a.nodeTypeDefinition) {
+		a.nodeTypeDefinition = make([]TypeDefinition, 0, arenaChunkSize(cap(a.nodeTypeDefinition)))
+	}
+
+	a.nodeTypeDefinition = append(a.nodeTypeDefinition, v)
+
+	return &a.nodeTypeDefinition[len(a.nodeTypeDefinition)-1]
+}
+
+// NewTypeExtension returns a pointer to a copy of the given TypeExtension, allocated in this arena.
+func (a *Arena) NewTypeExtension(v TypeExtension) *TypeExtension {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeTypeExtension) == cap(a.nodeTypeExtension) {
+		a.nodeTypeExtension = make([]TypeExtension, 0, arenaChunkSize(cap(a.nodeTypeExtension)))
+	}
+
+	a.nodeTypeExtension = append(a.nodeTypeExtension, v)
+
+	return &a.nodeTypeExtension[len(a.nodeTypeExtension)-1]
+}
+
+// NewTypeSystemDefinition returns a pointer to a copy of the given TypeSystemDefinition, allocated in this arena.
+func (a *Arena) NewTypeSystemDefinition(v TypeSystemDefinition) *TypeSystemDefinition {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeTypeSystemDefinition) == cap(a.nodeTypeSystemDefinition) {
+		a.nodeTypeSystemDefinition = make([]TypeSystemDefinition, 0, arenaChunkSize(cap(a.nodeTypeSystemDefinition)))
+	}
+
+	a.nodeTypeSystemDefinition = append(a.nodeTypeSystemDefinition, v)
+
+	return &a.nodeTypeSystemDefinition[len(a.nodeTypeSystemDefinition)-1]
+}
+
+// NewTypeSystemExtension returns a pointer to a copy of the given TypeSystemExtension, allocated in this arena.
+func (a *Arena) NewTypeSystemExtension(v TypeSystemExtension) *TypeSystemExtension {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.nodeTypeSystemExtension) == cap(a.nodeTypeSystemExtension) {
+		a.nodeTypeSystemExtension = make([]TypeSystemExtension, 0, arenaChunkSize(cap(a.nodeTypeSystemExtension)))
+	}
+
+	a.nodeTypeSystemExtension = append(a.nodeTypeSystemExtension, v)
+
+	return &a.nodeTypeSystemExtension[len(a.nodeTypeSystemExtension)-1]
+}
@@ -0,0 +1,64 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArguments_AddIn(t *testing.T) {
+	arena := NewArena()
+
+	var list *Arguments
+	for i := 0; i < 100; i++ {
+		list = list.AddIn(arena, Argument{Value: Value{IntValue: i}})
+	}
+
+	assert.Equal(t, 100, list.Len())
+
+	list.Reverse().ForEach(func(a Argument, i int) {
+		assert.Equal(t, i, a.Value.IntValue)
+	})
+
+	// Chunks double in size from 16, so 100 items fit in chunks of 16, 32, and 64.
+	assert.Equal(t, 100-16-32, len(arena.listArguments))
+	assert.Equal(t, 64, cap(arena.listArguments))
+
+	// A nil arena allocates on the heap instead.
+	list = (*Arguments)(nil).AddIn(nil, Argument{Name: "foo"})
+	assert.Equal(t, "foo", list.Data.Name)
+	assert.Equal(t, 1, list.Len())
+}
+
+func TestArena_New(t *testing.T) {
+	arena := NewArena()
+
+	a := arena.NewType(Type{NamedType: "Foo"})
+	b := arena.NewType(Type{NamedType: "Bar"})
+
+	assert.Equal(t, "Foo", a.NamedType)
+	assert.Equal(t, "Bar", b.NamedType)
+	assert.Equal(t, &arena.nodeType[0], a)
+
+	c := (*Arena)(nil).NewType(Type{NamedType: "Baz"})
+	assert.Equal(t, "Baz", c.NamedType)
+}
+
+func TestArena_Reset(t *testing.T) {
+	arena := NewArena()
+
+	for i := 0; i < 1000; i++ {
+		arena.NewTypeDefinition(TypeDefinition{Name: "Foo"})
+	}
+
+	chunk := cap(arena.nodeTypeDefinition)
+
+	arena.Reset()
+
+	assert.Len(t, arena.nodeTypeDefinition, 0)
+	assert.Equal(t, chunk, cap(arena.nodeTypeDefinition))
+	assert.Equal(t, TypeDefinition{}, arena.nodeTypeDefinition[:1][0])
+
+	def := arena.NewTypeDefinition(TypeDefinition{Name: "Bar"})
+	assert.Equal(t, &arena.nodeTypeDefinition[0], def)
+}
@@ -1,9 +1,42 @@
 package ast
 
-// Location contains location information about where an AST type is in a document.
+import (
+	"math/big"
+	"strconv"
+)
+
+// Location contains location information about where an AST type is in a document. Only offsets
+// are kept, the line and column of a location can be found with Document.Position.
 type Location struct {
+	Start int // Byte offset of the first byte.
+	End   int // Byte offset just past the last byte.
+}
+
+// Position is a line and column in a document, and the name of the source that it's in, if known.
+// It's used to report where errors occurred.
+type Position struct {
 	Line   int
 	Column int
+	Source string
+}
+
+// @wg:ignore
+const (
+	CommentKindLeading CommentKind = iota
+	CommentKindTrailing
+)
+
+// CommentKind describes where a comment is in relation to the AST node it's attached to.
+type CommentKind int8
+
+// Comment is a comment from a document, attached to the AST node that it's nearest to. Leading
+// comments come before the node, on their own lines. Trailing comments come after the node, either
+// on the same line as the end of it, or on their own lines if no other node follows in the same
+// block.
+type Comment struct {
+	Loc  Location
+	Text string // The text of the comment, without the leading '#'.
+	Kind CommentKind
 }
 
 // @wg:field self
@@ -42,7 +75,9 @@ func NewIntPathNode(i int) PathNode {
 // http://facebook.github.io/graphql/June2018/#sec-Language.Document
 
 type Document struct {
-	Definitions          *Definitions
+	Definitions *Definitions
+	// Sources are the sources that this document was parsed from, see Position.
+	Sources              []*Source
 	OperationDefinitions int32
 	FragmentDefinitions  int32
 	DirectiveDefinitions int32
@@ -52,6 +87,55 @@ type Document struct {
 	TypeExtensions       int32
 }
 
+// CountDefinitions sets the counts of each kind of definition on this document from its list of
+// definitions.
+func (d *Document) CountDefinitions() {
+	d.OperationDefinitions = 0
+	d.FragmentDefinitions = 0
+	d.DirectiveDefinitions = 0
+	d.SchemaDefinitions = 0
+	d.TypeDefinitions = 0
+	d.SchemaExtensions = 0
+
+	// Type extensions are counted per type, as the same type may be extended many times. The map
+	// is only made if there are any, so counting executable documents doesn't allocate.
+	var typeExtensions map[string]struct{}
+
+	d.Definitions.ForEach(func(def Definition, i int) {
+		switch def.Kind {
+		case DefinitionKindExecutable:
+			switch def.ExecutableDefinition.Kind {
+			case ExecutableDefinitionKindOperation:
+				d.OperationDefinitions++
+			case ExecutableDefinitionKindFragment:
+				d.FragmentDefinitions++
+			}
+		case DefinitionKindTypeSystem:
+			switch def.TypeSystemDefinition.Kind {
+			case TypeSystemDefinitionKindDirective:
+				d.DirectiveDefinitions++
+			case TypeSystemDefinitionKindSchema:
+				d.SchemaDefinitions++
+			case TypeSystemDefinitionKindType:
+				d.TypeDefinitions++
+			}
+		case DefinitionKindTypeSystemExtension:
+			switch def.TypeSystemExtension.Kind {
+			case TypeSystemExtensionKindSchema:
+				d.SchemaExtensions++
+			case TypeSystemExtensionKindType:
+				if typeExtensions == nil {
+					typeExtensions = make(map[string]struct{})
+				}
+
+				typeExtensions[def.TypeSystemExtension.TypeExtension.Name] = struct{}{}
+			}
+		}
+	})
+
+	d.TypeExtensions = int32(len(typeExtensions))
+}
+
 const (
 	// @wg:field ExecutableDefinition
 	DefinitionKindExecutable DefinitionKind = iota
@@ -77,7 +161,10 @@ func (k DefinitionKind) String() string {
 }
 
 type Definition struct {
-	Location             Location
+	// @wg:ignore
+	Loc Location
+	// @wg:ignore
+	Comments             *Comments
 	ExecutableDefinition *ExecutableDefinition
 	TypeSystemDefinition *TypeSystemDefinition
 	TypeSystemExtension  *TypeSystemExtension
@@ -127,10 +214,12 @@ func (def ExecutableDefinition) String() string {
 }
 
 type FragmentDefinition struct {
-	Name          string
-	TypeCondition *TypeCondition
-	Directives    *Directives
-	SelectionSet  *Selections
+	Name string
+	// Only set when parsing with experimental fragment arguments enabled.
+	VariableDefinitions *VariableDefinitions
+	TypeCondition       *TypeCondition
+	Directives          *Directives
+	SelectionSet        *Selections
 }
 
 // @wg:field self
@@ -189,15 +278,21 @@ func (k SelectionKind) String() string {
 }
 
 type Selection struct {
-	Name  string // but not "on"
-	Alias string
+	// @wg:ignore
+	Loc Location
+	// @wg:ignore
+	Comments *Comments
+	Name     string // but not "on"
+	Alias    string
 	// @wg:on_kinds InlineFragmentSelection
 	TypeCondition *TypeCondition
-	Arguments     *Arguments
-	Directives    *Directives
-	SelectionSet  *Selections
-	Path          *PathNodes
-	Kind          SelectionKind
+	// Set on fields, and on fragment spreads when parsing with experimental fragment arguments
+	// enabled.
+	Arguments    *Arguments
+	Directives   *Directives
+	SelectionSet *Selections
+	Path         *PathNodes
+	Kind         SelectionKind
 }
 
 // 2.6 Arguments
@@ -205,6 +300,8 @@ type Selection struct {
 
 // Argument :
 type Argument struct {
+	// @wg:ignore
+	Loc   Location
 	Name  string
 	Value Value
 }
@@ -261,8 +358,13 @@ func (k ValueKind) String() string {
 }
 
 type Value struct {
+	// @wg:ignore
+	Loc Location
+	// IntValue and FloatValue are clamped if the value is out of range, see BigInt and BigFloat.
 	IntValue   int
 	FloatValue float64
+	// RawValue is the literal source text of Int and Float values, as it appeared in the document.
+	RawValue string
 	// StringValue covers variables and enums, enums are names, but not `true`, `false`, or `null`.
 	StringValue string
 	// @wg:on_kinds ListValue
@@ -273,7 +375,52 @@ type Value struct {
 	Kind         ValueKind
 }
 
+// BigInt returns the value of an Int value as a big.Int, which can hold integers of any size, e.g.
+// for custom scalars like BigInt or Long. If this is not an Int value, false is returned.
+func (v Value) BigInt() (*big.Int, bool) {
+	if v.Kind != ValueKindInt {
+		return nil, false
+	}
+
+	if v.RawValue == "" {
+		return big.NewInt(int64(v.IntValue)), true
+	}
+
+	return new(big.Int).SetString(v.RawValue, 10)
+}
+
+// BigFloat returns the value of an Int or Float value as a big.Float, e.g. for custom scalars like
+// Decimal. The precision is chosen to be high enough to keep all of the digits of the literal. If
+// this is not an Int or Float value, false is returned.
+func (v Value) BigFloat() (*big.Float, bool) {
+	raw := v.RawValue
+
+	switch {
+	case v.Kind == ValueKindInt && raw == "":
+		raw = strconv.Itoa(v.IntValue)
+	case v.Kind == ValueKindFloat && raw == "":
+		raw = strconv.FormatFloat(v.FloatValue, 'g', -1, 64)
+	case v.Kind != ValueKindInt && v.Kind != ValueKindFloat:
+		return nil, false
+	}
+
+	// Each decimal digit needs a little less than 4 bits.
+	prec := uint(len(raw)) * 4
+	if prec < 64 {
+		prec = 64
+	}
+
+	f, _, err := big.ParseFloat(raw, 10, prec, big.ToNearestEven)
+	if err != nil {
+		return nil, false
+	}
+
+	return f, true
+}
+
 type ObjectField struct {
+	// @wg:ignore
+	Loc   Location
 	Name  string
 	Value Value
 }
@@ -282,6 +429,8 @@ type ObjectField struct {
 // http://facebook.github.io/graphql/June2018/#sec-Language.Variables
 
 type VariableDefinition struct {
+	// @wg:ignore
+	Loc          Location
 	Name         string
 	Type         Type
 	DefaultValue *Value
@@ -336,6 +485,8 @@ func nonNullable(isNN bool, typeName string) string {
 
 // Directive :
 type Directive struct {
+	// @wg:ignore
+	Loc       Location
 	Name      string
 	Arguments *Arguments
 	Location  DirectiveLocation
@@ -525,6 +676,10 @@ func IsInputObjectTypeDefinition(def *TypeDefinition) bool {
 }
 
 type FieldDefinition struct {
+	// @wg:ignore
+	Loc Location
+	// @wg:ignore
+	Comments            *Comments
 	Description         string
 	Name                string
 	ArgumentsDefinition *InputValueDefinitions
@@ -533,6 +688,10 @@ type FieldDefinition struct {
 }
 
 type EnumValueDefinition struct {
+	// @wg:ignore
+	Loc Location
+	// @wg:ignore
+	Comments    *Comments
 	Description string
 	Directives  *Directives
 	EnumValue   string // Name but not true or false or null.
@@ -552,6 +711,8 @@ const (
 type TypeExtensionKind int8
 
 type TypeExtension struct {
+	// @wg:ignore
+	Loc                   Location
 	Directives            *Directives
 	ImplementsInterface   *Types // Only allow "TypeKindNamed" kind NamedType.
 	FieldsDefinition      *FieldDefinitions
@@ -596,6 +757,10 @@ func IsInputObjectTypeExtension(def *TypeExtension) bool {
 // http://facebook.github.io/graphql/June2018/#sec-Objects
 
 type InputValueDefinition struct {
+	// @wg:ignore
+	Loc Location
+	// @wg:ignore
+	Comments     *Comments
 	Description  string
 	Name         string
 	Type         Type
@@ -683,4 +848,5 @@ type DirectiveDefinition struct {
 	Name                string
 	ArgumentsDefinition *InputValueDefinitions
 	DirectiveLocations  DirectiveLocation
+	Repeatable          bool
 }
@@ -79,3 +79,83 @@ func TestType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestValue_BigInt(t *testing.T) {
+	tt := []struct {
+		msg   string
+		Value Value
+		want  string
+		ok    bool
+	}{
+		{
+			msg:   "Raw value",
+			Value: Value{Kind: ValueKindInt, RawValue: "-123456789012345678901234567890"},
+			want:  "-123456789012345678901234567890",
+			ok:    true,
+		},
+		{
+			msg:   "No raw value",
+			Value: Value{Kind: ValueKindInt, IntValue: 42},
+			want:  "42",
+			ok:    true,
+		},
+		{
+			msg:   "Not an int",
+			Value: Value{Kind: ValueKindFloat, RawValue: "1.5"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.msg, func(t *testing.T) {
+			got, ok := tc.Value.BigInt()
+			if ok != tc.ok {
+				t.Fatalf("Value.BigInt() ok = %v, want %v", ok, tc.ok)
+			}
+			if ok && got.String() != tc.want {
+				t.Errorf("Value.BigInt() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValue_BigFloat(t *testing.T) {
+	tt := []struct {
+		msg   string
+		Value Value
+		want  string
+		ok    bool
+	}{
+		{
+			msg:   "Raw value",
+			Value: Value{Kind: ValueKindFloat, RawValue: "12345678901234567890.125"},
+			want:  "12345678901234567890.125",
+			ok:    true,
+		},
+		{
+			msg:   "Raw int value",
+			Value: Value{Kind: ValueKindInt, RawValue: "123456789012345678901234567890"},
+			want:  "123456789012345678901234567890",
+			ok:    true,
+		},
+		{
+			msg:   "No raw value",
+			Value: Value{Kind: ValueKindFloat, FloatValue: 1.5},
+			want:  "1.5",
+			ok:    true,
+		},
+		{
+			msg:   "Not a number",
+			Value: Value{Kind: ValueKindString, StringValue: "1.5"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.msg, func(t *testing.T) {
+			got, ok := tc.Value.BigFloat()
+			if ok != tc.ok {
+				t.Fatalf("Value.BigFloat() ok = %v, want %v", ok, tc.ok)
+			}
+			if ok && got.Text('f', -1) != tc.want {
+				t.Errorf("Value.BigFloat() = %v, want %v", got.Text('f', -1), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1643 @@
+// Code generated by tools/walkergen
+// DO NOT EDIT!
+package ast
+
+// Clone returns a deep copy of this Argument.
+func (a Argument) Clone() Argument {
+	clone := a
+	clone.Loc = a.Loc.Clone()
+	clone.Value = a.Value.Clone()
+
+	return clone
+}
+
+// Equal returns true if this Argument is structurally equal to the given one.
+func (a Argument) Equal(other Argument) bool {
+	return equalArgument(&a, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Argument is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (a Argument) EqualIgnoringLocations(other Argument) bool {
+	return equalArgument(&a, &other, true)
+}
+
+// equalArgument ...
+func equalArgument(x, y *Argument, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.Name == y.Name &&
+		equalValue(&x.Value, &y.Value, ignoreLocations)
+}
+
+// Clone returns a deep copy of this linked list of Argument.
+func (as *Arguments) Clone() *Arguments {
+	var clone *Arguments
+
+	as.ForEach(func(a Argument, i int) {
+		clone = clone.Add(a.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Argument is structurally equal to the given
+// one.
+func (as *Arguments) Equal(other *Arguments) bool {
+	return equalArguments(as, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Argument is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (as *Arguments) EqualIgnoringLocations(other *Arguments) bool {
+	return equalArguments(as, other, true)
+}
+
+// equalArguments ...
+func equalArguments(x, y *Arguments, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalArgument(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Comment.
+func (c Comment) Clone() Comment {
+	clone := c
+	clone.Loc = c.Loc.Clone()
+
+	return clone
+}
+
+// Equal returns true if this Comment is structurally equal to the given one.
+func (c Comment) Equal(other Comment) bool {
+	return equalComment(&c, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Comment is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (c Comment) EqualIgnoringLocations(other Comment) bool {
+	return equalComment(&c, &other, true)
+}
+
+// equalComment ...
+func equalComment(x, y *Comment, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.Text == y.Text &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this linked list of Comment.
+func (cs *Comments) Clone() *Comments {
+	var clone *Comments
+
+	cs.ForEach(func(c Comment, i int) {
+		clone = clone.Add(c.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Comment is structurally equal to the given
+// one.
+func (cs *Comments) Equal(other *Comments) bool {
+	return equalComments(cs, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Comment is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (cs *Comments) EqualIgnoringLocations(other *Comments) bool {
+	return equalComments(cs, other, true)
+}
+
+// equalComments ...
+func equalComments(x, y *Comments, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalComment(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Definition.
+func (d Definition) Clone() Definition {
+	clone := d
+	clone.Loc = d.Loc.Clone()
+	clone.Comments = d.Comments.Clone()
+	clone.ExecutableDefinition = cloneExecutableDefinitionPointer(d.ExecutableDefinition)
+	clone.TypeSystemDefinition = cloneTypeSystemDefinitionPointer(d.TypeSystemDefinition)
+	clone.TypeSystemExtension = cloneTypeSystemExtensionPointer(d.TypeSystemExtension)
+
+	return clone
+}
+
+// Equal returns true if this Definition is structurally equal to the given one.
+func (d Definition) Equal(other Definition) bool {
+	return equalDefinition(&d, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Definition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (d Definition) EqualIgnoringLocations(other Definition) bool {
+	return equalDefinition(&d, &other, true)
+}
+
+// equalDefinition ...
+func equalDefinition(x, y *Definition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalComments(x.Comments, y.Comments, ignoreLocations) &&
+		equalExecutableDefinition(x.ExecutableDefinition, y.ExecutableDefinition, ignoreLocations) &&
+		equalTypeSystemDefinition(x.TypeSystemDefinition, y.TypeSystemDefinition, ignoreLocations) &&
+		equalTypeSystemExtension(x.TypeSystemExtension, y.TypeSystemExtension, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this linked list of Definition.
+func (ds *Definitions) Clone() *Definitions {
+	var clone *Definitions
+
+	ds.ForEach(func(d Definition, i int) {
+		clone = clone.Add(d.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Definition is structurally equal to the given
+// one.
+func (ds *Definitions) Equal(other *Definitions) bool {
+	return equalDefinitions(ds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Definition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ds *Definitions) EqualIgnoringLocations(other *Definitions) bool {
+	return equalDefinitions(ds, other, true)
+}
+
+// equalDefinitions ...
+func equalDefinitions(x, y *Definitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Directive.
+func (d Directive) Clone() Directive {
+	clone := d
+	clone.Loc = d.Loc.Clone()
+	clone.Arguments = d.Arguments.Clone()
+
+	return clone
+}
+
+// Equal returns true if this Directive is structurally equal to the given one.
+func (d Directive) Equal(other Directive) bool {
+	return equalDirective(&d, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Directive is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (d Directive) EqualIgnoringLocations(other Directive) bool {
+	return equalDirective(&d, &other, true)
+}
+
+// equalDirective ...
+func equalDirective(x, y *Directive, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.Name == y.Name &&
+		equalArguments(x.Arguments, y.Arguments, ignoreLocations) &&
+		x.Location == y.Location
+}
+
+// Clone returns a deep copy of this DirectiveDefinition.
+func (dd DirectiveDefinition) Clone() DirectiveDefinition {
+	clone := dd
+	clone.ArgumentsDefinition = dd.ArgumentsDefinition.Clone()
+
+	return clone
+}
+
+// Equal returns true if this DirectiveDefinition is structurally equal to the given one.
+func (dd DirectiveDefinition) Equal(other DirectiveDefinition) bool {
+	return equalDirectiveDefinition(&dd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this DirectiveDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (dd DirectiveDefinition) EqualIgnoringLocations(other DirectiveDefinition) bool {
+	return equalDirectiveDefinition(&dd, &other, true)
+}
+
+// equalDirectiveDefinition ...
+func equalDirectiveDefinition(x, y *DirectiveDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Description == y.Description &&
+		x.Name == y.Name &&
+		equalInputValueDefinitions(x.ArgumentsDefinition, y.ArgumentsDefinition, ignoreLocations) &&
+		x.DirectiveLocations == y.DirectiveLocations &&
+		x.Repeatable == y.Repeatable
+}
+
+// Clone returns a deep copy of this linked list of Directive.
+func (ds *Directives) Clone() *Directives {
+	var clone *Directives
+
+	ds.ForEach(func(d Directive, i int) {
+		clone = clone.Add(d.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Directive is structurally equal to the given
+// one.
+func (ds *Directives) Equal(other *Directives) bool {
+	return equalDirectives(ds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Directive is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ds *Directives) EqualIgnoringLocations(other *Directives) bool {
+	return equalDirectives(ds, other, true)
+}
+
+// equalDirectives ...
+func equalDirectives(x, y *Directives, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalDirective(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Document.
+func (d Document) Clone() Document {
+	clone := d
+	clone.Definitions = d.Definitions.Clone()
+
+	return clone
+}
+
+// Equal returns true if this Document is structurally equal to the given one.
+func (d Document) Equal(other Document) bool {
+	return equalDocument(&d, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Document is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (d Document) EqualIgnoringLocations(other Document) bool {
+	return equalDocument(&d, &other, true)
+}
+
+// equalDocument ...
+func equalDocument(x, y *Document, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalDefinitions(x.Definitions, y.Definitions, ignoreLocations) &&
+		x.OperationDefinitions == y.OperationDefinitions &&
+		x.FragmentDefinitions == y.FragmentDefinitions &&
+		x.DirectiveDefinitions == y.DirectiveDefinitions &&
+		x.SchemaDefinitions == y.SchemaDefinitions &&
+		x.TypeDefinitions == y.TypeDefinitions &&
+		x.SchemaExtensions == y.SchemaExtensions &&
+		x.TypeExtensions == y.TypeExtensions
+}
+
+// Clone returns a deep copy of this EnumValueDefinition.
+func (evd EnumValueDefinition) Clone() EnumValueDefinition {
+	clone := evd
+	clone.Loc = evd.Loc.Clone()
+	clone.Comments = evd.Comments.Clone()
+	clone.Directives = evd.Directives.Clone()
+
+	return clone
+}
+
+// Equal returns true if this EnumValueDefinition is structurally equal to the given one.
+func (evd EnumValueDefinition) Equal(other EnumValueDefinition) bool {
+	return equalEnumValueDefinition(&evd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this EnumValueDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (evd EnumValueDefinition) EqualIgnoringLocations(other EnumValueDefinition) bool {
+	return equalEnumValueDefinition(&evd, &other, true)
+}
+
+// equalEnumValueDefinition ...
+func equalEnumValueDefinition(x, y *EnumValueDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalComments(x.Comments, y.Comments, ignoreLocations) &&
+		x.Description == y.Description &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		x.EnumValue == y.EnumValue
+}
+
+// Clone returns a deep copy of this linked list of EnumValueDefinition.
+func (evds *EnumValueDefinitions) Clone() *EnumValueDefinitions {
+	var clone *EnumValueDefinitions
+
+	evds.ForEach(func(evd EnumValueDefinition, i int) {
+		clone = clone.Add(evd.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of EnumValueDefinition is structurally equal to the given
+// one.
+func (evds *EnumValueDefinitions) Equal(other *EnumValueDefinitions) bool {
+	return equalEnumValueDefinitions(evds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of EnumValueDefinition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (evds *EnumValueDefinitions) EqualIgnoringLocations(other *EnumValueDefinitions) bool {
+	return equalEnumValueDefinitions(evds, other, true)
+}
+
+// equalEnumValueDefinitions ...
+func equalEnumValueDefinitions(x, y *EnumValueDefinitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalEnumValueDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this ExecutableDefinition.
+func (ed ExecutableDefinition) Clone() ExecutableDefinition {
+	clone := ed
+	clone.FragmentDefinition = cloneFragmentDefinitionPointer(ed.FragmentDefinition)
+	clone.OperationDefinition = cloneOperationDefinitionPointer(ed.OperationDefinition)
+
+	return clone
+}
+
+// Equal returns true if this ExecutableDefinition is structurally equal to the given one.
+func (ed ExecutableDefinition) Equal(other ExecutableDefinition) bool {
+	return equalExecutableDefinition(&ed, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this ExecutableDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (ed ExecutableDefinition) EqualIgnoringLocations(other ExecutableDefinition) bool {
+	return equalExecutableDefinition(&ed, &other, true)
+}
+
+// equalExecutableDefinition ...
+func equalExecutableDefinition(x, y *ExecutableDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalFragmentDefinition(x.FragmentDefinition, y.FragmentDefinition, ignoreLocations) &&
+		equalOperationDefinition(x.OperationDefinition, y.OperationDefinition, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this FieldDefinition.
+func (fd FieldDefinition) Clone() FieldDefinition {
+	clone := fd
+	clone.Loc = fd.Loc.Clone()
+	clone.Comments = fd.Comments.Clone()
+	clone.ArgumentsDefinition = fd.ArgumentsDefinition.Clone()
+	clone.Type = fd.Type.Clone()
+	clone.Directives = fd.Directives.Clone()
+
+	return clone
+}
+
+// Equal returns true if this FieldDefinition is structurally equal to the given one.
+func (fd FieldDefinition) Equal(other FieldDefinition) bool {
+	return equalFieldDefinition(&fd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this FieldDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (fd FieldDefinition) EqualIgnoringLocations(other FieldDefinition) bool {
+	return equalFieldDefinition(&fd, &other, true)
+}
+
+// equalFieldDefinition ...
+func equalFieldDefinition(x, y *FieldDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalComments(x.Comments, y.Comments, ignoreLocations) &&
+		x.Description == y.Description &&
+		x.Name == y.Name &&
+		equalInputValueDefinitions(x.ArgumentsDefinition, y.ArgumentsDefinition, ignoreLocations) &&
+		equalType(&x.Type, &y.Type, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations)
+}
+
+// Clone returns a deep copy of this linked list of FieldDefinition.
+func (fds *FieldDefinitions) Clone() *FieldDefinitions {
+	var clone *FieldDefinitions
+
+	fds.ForEach(func(fd FieldDefinition, i int) {
+		clone = clone.Add(fd.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of FieldDefinition is structurally equal to the given
+// one.
+func (fds *FieldDefinitions) Equal(other *FieldDefinitions) bool {
+	return equalFieldDefinitions(fds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of FieldDefinition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (fds *FieldDefinitions) EqualIgnoringLocations(other *FieldDefinitions) bool {
+	return equalFieldDefinitions(fds, other, true)
+}
+
+// equalFieldDefinitions ...
+func equalFieldDefinitions(x, y *FieldDefinitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalFieldDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this FragmentDefinition.
+func (fd FragmentDefinition) Clone() FragmentDefinition {
+	clone := fd
+	clone.VariableDefinitions = fd.VariableDefinitions.Clone()
+	clone.TypeCondition = cloneTypeConditionPointer(fd.TypeCondition)
+	clone.Directives = fd.Directives.Clone()
+	clone.SelectionSet = fd.SelectionSet.Clone()
+
+	return clone
+}
+
+// Equal returns true if this FragmentDefinition is structurally equal to the given one.
+func (fd FragmentDefinition) Equal(other FragmentDefinition) bool {
+	return equalFragmentDefinition(&fd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this FragmentDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (fd FragmentDefinition) EqualIgnoringLocations(other FragmentDefinition) bool {
+	return equalFragmentDefinition(&fd, &other, true)
+}
+
+// equalFragmentDefinition ...
+func equalFragmentDefinition(x, y *FragmentDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Name == y.Name &&
+		equalVariableDefinitions(x.VariableDefinitions, y.VariableDefinitions, ignoreLocations) &&
+		equalTypeCondition(x.TypeCondition, y.TypeCondition, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalSelections(x.SelectionSet, y.SelectionSet, ignoreLocations)
+}
+
+// Clone returns a deep copy of this InputValueDefinition.
+func (ivd InputValueDefinition) Clone() InputValueDefinition {
+	clone := ivd
+	clone.Loc = ivd.Loc.Clone()
+	clone.Comments = ivd.Comments.Clone()
+	clone.Type = ivd.Type.Clone()
+	clone.Directives = ivd.Directives.Clone()
+	clone.DefaultValue = cloneValuePointer(ivd.DefaultValue)
+
+	return clone
+}
+
+// Equal returns true if this InputValueDefinition is structurally equal to the given one.
+func (ivd InputValueDefinition) Equal(other InputValueDefinition) bool {
+	return equalInputValueDefinition(&ivd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this InputValueDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (ivd InputValueDefinition) EqualIgnoringLocations(other InputValueDefinition) bool {
+	return equalInputValueDefinition(&ivd, &other, true)
+}
+
+// equalInputValueDefinition ...
+func equalInputValueDefinition(x, y *InputValueDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalComments(x.Comments, y.Comments, ignoreLocations) &&
+		x.Description == y.Description &&
+		x.Name == y.Name &&
+		equalType(&x.Type, &y.Type, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalValue(x.DefaultValue, y.DefaultValue, ignoreLocations)
+}
+
+// Clone returns a deep copy of this linked list of InputValueDefinition.
+func (ivds *InputValueDefinitions) Clone() *InputValueDefinitions {
+	var clone *InputValueDefinitions
+
+	ivds.ForEach(func(ivd InputValueDefinition, i int) {
+		clone = clone.Add(ivd.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of InputValueDefinition is structurally equal to the given
+// one.
+func (ivds *InputValueDefinitions) Equal(other *InputValueDefinitions) bool {
+	return equalInputValueDefinitions(ivds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of InputValueDefinition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ivds *InputValueDefinitions) EqualIgnoringLocations(other *InputValueDefinitions) bool {
+	return equalInputValueDefinitions(ivds, other, true)
+}
+
+// equalInputValueDefinitions ...
+func equalInputValueDefinitions(x, y *InputValueDefinitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalInputValueDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Location.
+func (l Location) Clone() Location {
+	clone := l
+
+	return clone
+}
+
+// Equal returns true if this Location is structurally equal to the given one.
+func (l Location) Equal(other Location) bool {
+	return equalLocation(&l, &other, false)
+}
+
+// equalLocation ...
+func equalLocation(x, y *Location, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	// Locations are equal to any other location when they're being ignored.
+	if ignoreLocations {
+		return true
+	}
+
+	return x.Start == y.Start &&
+		x.End == y.End
+}
+
+// Clone returns a deep copy of this ObjectField.
+func (of ObjectField) Clone() ObjectField {
+	clone := of
+	clone.Loc = of.Loc.Clone()
+	clone.Value = of.Value.Clone()
+
+	return clone
+}
+
+// Equal returns true if this ObjectField is structurally equal to the given one.
+func (of ObjectField) Equal(other ObjectField) bool {
+	return equalObjectField(&of, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this ObjectField is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (of ObjectField) EqualIgnoringLocations(other ObjectField) bool {
+	return equalObjectField(&of, &other, true)
+}
+
+// equalObjectField ...
+func equalObjectField(x, y *ObjectField, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.Name == y.Name &&
+		equalValue(&x.Value, &y.Value, ignoreLocations)
+}
+
+// Clone returns a deep copy of this OperationDefinition.
+func (od OperationDefinition) Clone() OperationDefinition {
+	clone := od
+	clone.VariableDefinitions = od.VariableDefinitions.Clone()
+	clone.Directives = od.Directives.Clone()
+	clone.SelectionSet = od.SelectionSet.Clone()
+
+	return clone
+}
+
+// Equal returns true if this OperationDefinition is structurally equal to the given one.
+func (od OperationDefinition) Equal(other OperationDefinition) bool {
+	return equalOperationDefinition(&od, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this OperationDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (od OperationDefinition) EqualIgnoringLocations(other OperationDefinition) bool {
+	return equalOperationDefinition(&od, &other, true)
+}
+
+// equalOperationDefinition ...
+func equalOperationDefinition(x, y *OperationDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Name == y.Name &&
+		equalVariableDefinitions(x.VariableDefinitions, y.VariableDefinitions, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalSelections(x.SelectionSet, y.SelectionSet, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this OperationTypeDefinition.
+func (otd OperationTypeDefinition) Clone() OperationTypeDefinition {
+	clone := otd
+	clone.NamedType = otd.NamedType.Clone()
+
+	return clone
+}
+
+// Equal returns true if this OperationTypeDefinition is structurally equal to the given one.
+func (otd OperationTypeDefinition) Equal(other OperationTypeDefinition) bool {
+	return equalOperationTypeDefinition(&otd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this OperationTypeDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (otd OperationTypeDefinition) EqualIgnoringLocations(other OperationTypeDefinition) bool {
+	return equalOperationTypeDefinition(&otd, &other, true)
+}
+
+// equalOperationTypeDefinition ...
+func equalOperationTypeDefinition(x, y *OperationTypeDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalType(&x.NamedType, &y.NamedType, ignoreLocations) &&
+		x.OperationType == y.OperationType
+}
+
+// Clone returns a deep copy of this linked list of OperationTypeDefinition.
+func (otds *OperationTypeDefinitions) Clone() *OperationTypeDefinitions {
+	var clone *OperationTypeDefinitions
+
+	otds.ForEach(func(otd OperationTypeDefinition, i int) {
+		clone = clone.Add(otd.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of OperationTypeDefinition is structurally equal to the given
+// one.
+func (otds *OperationTypeDefinitions) Equal(other *OperationTypeDefinitions) bool {
+	return equalOperationTypeDefinitions(otds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of OperationTypeDefinition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (otds *OperationTypeDefinitions) EqualIgnoringLocations(other *OperationTypeDefinitions) bool {
+	return equalOperationTypeDefinitions(otds, other, true)
+}
+
+// equalOperationTypeDefinitions ...
+func equalOperationTypeDefinitions(x, y *OperationTypeDefinitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalOperationTypeDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this PathNode.
+func (pn PathNode) Clone() PathNode {
+	clone := pn
+
+	return clone
+}
+
+// Equal returns true if this PathNode is structurally equal to the given one.
+func (pn PathNode) Equal(other PathNode) bool {
+	return equalPathNode(&pn, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this PathNode is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (pn PathNode) EqualIgnoringLocations(other PathNode) bool {
+	return equalPathNode(&pn, &other, true)
+}
+
+// equalPathNode ...
+func equalPathNode(x, y *PathNode, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Kind == y.Kind &&
+		x.String == y.String &&
+		x.Int == y.Int
+}
+
+// Clone returns a deep copy of this linked list of PathNode.
+func (pns *PathNodes) Clone() *PathNodes {
+	var clone *PathNodes
+
+	pns.ForEach(func(pn PathNode, i int) {
+		clone = clone.Add(pn.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of PathNode is structurally equal to the given
+// one.
+func (pns *PathNodes) Equal(other *PathNodes) bool {
+	return equalPathNodes(pns, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of PathNode is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (pns *PathNodes) EqualIgnoringLocations(other *PathNodes) bool {
+	return equalPathNodes(pns, other, true)
+}
+
+// equalPathNodes ...
+func equalPathNodes(x, y *PathNodes, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalPathNode(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Position.
+func (p Position) Clone() Position {
+	clone := p
+
+	return clone
+}
+
+// Equal returns true if this Position is structurally equal to the given one.
+func (p Position) Equal(other Position) bool {
+	return equalPosition(&p, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Position is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (p Position) EqualIgnoringLocations(other Position) bool {
+	return equalPosition(&p, &other, true)
+}
+
+// equalPosition ...
+func equalPosition(x, y *Position, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Line == y.Line &&
+		x.Column == y.Column &&
+		x.Source == y.Source
+}
+
+// Clone returns a deep copy of this linked list of Position.
+func (ps *Positions) Clone() *Positions {
+	var clone *Positions
+
+	ps.ForEach(func(p Position, i int) {
+		clone = clone.Add(p.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Position is structurally equal to the given
+// one.
+func (ps *Positions) Equal(other *Positions) bool {
+	return equalPositions(ps, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Position is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ps *Positions) EqualIgnoringLocations(other *Positions) bool {
+	return equalPositions(ps, other, true)
+}
+
+// equalPositions ...
+func equalPositions(x, y *Positions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalPosition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this SchemaDefinition.
+func (sd SchemaDefinition) Clone() SchemaDefinition {
+	clone := sd
+	clone.Directives = sd.Directives.Clone()
+	clone.OperationTypeDefinitions = sd.OperationTypeDefinitions.Clone()
+
+	return clone
+}
+
+// Equal returns true if this SchemaDefinition is structurally equal to the given one.
+func (sd SchemaDefinition) Equal(other SchemaDefinition) bool {
+	return equalSchemaDefinition(&sd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this SchemaDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (sd SchemaDefinition) EqualIgnoringLocations(other SchemaDefinition) bool {
+	return equalSchemaDefinition(&sd, &other, true)
+}
+
+// equalSchemaDefinition ...
+func equalSchemaDefinition(x, y *SchemaDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalOperationTypeDefinitions(x.OperationTypeDefinitions, y.OperationTypeDefinitions, ignoreLocations)
+}
+
+// Clone returns a deep copy of this SchemaExtension.
+func (se SchemaExtension) Clone() SchemaExtension {
+	clone := se
+	clone.Directives = se.Directives.Clone()
+	clone.OperationTypeDefinitions = se.OperationTypeDefinitions.Clone()
+
+	return clone
+}
+
+// Equal returns true if this SchemaExtension is structurally equal to the given one.
+func (se SchemaExtension) Equal(other SchemaExtension) bool {
+	return equalSchemaExtension(&se, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this SchemaExtension is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (se SchemaExtension) EqualIgnoringLocations(other SchemaExtension) bool {
+	return equalSchemaExtension(&se, &other, true)
+}
+
+// equalSchemaExtension ...
+func equalSchemaExtension(x, y *SchemaExtension, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalOperationTypeDefinitions(x.OperationTypeDefinitions, y.OperationTypeDefinitions, ignoreLocations)
+}
+
+// Clone returns a deep copy of this Selection.
+func (s Selection) Clone() Selection {
+	clone := s
+	clone.Loc = s.Loc.Clone()
+	clone.Comments = s.Comments.Clone()
+	clone.TypeCondition = cloneTypeConditionPointer(s.TypeCondition)
+	clone.Arguments = s.Arguments.Clone()
+	clone.Directives = s.Directives.Clone()
+	clone.SelectionSet = s.SelectionSet.Clone()
+	clone.Path = s.Path.Clone()
+
+	return clone
+}
+
+// Equal returns true if this Selection is structurally equal to the given one.
+func (s Selection) Equal(other Selection) bool {
+	return equalSelection(&s, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Selection is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (s Selection) EqualIgnoringLocations(other Selection) bool {
+	return equalSelection(&s, &other, true)
+}
+
+// equalSelection ...
+func equalSelection(x, y *Selection, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalComments(x.Comments, y.Comments, ignoreLocations) &&
+		x.Name == y.Name &&
+		x.Alias == y.Alias &&
+		equalTypeCondition(x.TypeCondition, y.TypeCondition, ignoreLocations) &&
+		equalArguments(x.Arguments, y.Arguments, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalSelections(x.SelectionSet, y.SelectionSet, ignoreLocations) &&
+		equalPathNodes(x.Path, y.Path, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this linked list of Selection.
+func (ss *Selections) Clone() *Selections {
+	var clone *Selections
+
+	ss.ForEach(func(s Selection, i int) {
+		clone = clone.Add(s.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Selection is structurally equal to the given
+// one.
+func (ss *Selections) Equal(other *Selections) bool {
+	return equalSelections(ss, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Selection is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ss *Selections) EqualIgnoringLocations(other *Selections) bool {
+	return equalSelections(ss, other, true)
+}
+
+// equalSelections ...
+func equalSelections(x, y *Selections, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalSelection(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Type.
+func (t Type) Clone() Type {
+	clone := t
+	clone.ListType = cloneTypePointer(t.ListType)
+
+	return clone
+}
+
+// Equal returns true if this Type is structurally equal to the given one.
+func (t Type) Equal(other Type) bool {
+	return equalType(&t, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Type is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (t Type) EqualIgnoringLocations(other Type) bool {
+	return equalType(&t, &other, true)
+}
+
+// equalType ...
+func equalType(x, y *Type, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.NamedType == y.NamedType &&
+		equalType(x.ListType, y.ListType, ignoreLocations) &&
+		x.NonNullable == y.NonNullable &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this TypeCondition.
+func (tc TypeCondition) Clone() TypeCondition {
+	clone := tc
+	clone.NamedType = tc.NamedType.Clone()
+
+	return clone
+}
+
+// Equal returns true if this TypeCondition is structurally equal to the given one.
+func (tc TypeCondition) Equal(other TypeCondition) bool {
+	return equalTypeCondition(&tc, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this TypeCondition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (tc TypeCondition) EqualIgnoringLocations(other TypeCondition) bool {
+	return equalTypeCondition(&tc, &other, true)
+}
+
+// equalTypeCondition ...
+func equalTypeCondition(x, y *TypeCondition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalType(&x.NamedType, &y.NamedType, ignoreLocations)
+}
+
+// Clone returns a deep copy of this TypeDefinition.
+func (td TypeDefinition) Clone() TypeDefinition {
+	clone := td
+	clone.ImplementsInterface = td.ImplementsInterface.Clone()
+	clone.Directives = td.Directives.Clone()
+	clone.FieldsDefinition = td.FieldsDefinition.Clone()
+	clone.UnionMemberTypes = td.UnionMemberTypes.Clone()
+	clone.EnumValuesDefinition = td.EnumValuesDefinition.Clone()
+	clone.InputFieldsDefinition = td.InputFieldsDefinition.Clone()
+
+	return clone
+}
+
+// Equal returns true if this TypeDefinition is structurally equal to the given one.
+func (td TypeDefinition) Equal(other TypeDefinition) bool {
+	return equalTypeDefinition(&td, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this TypeDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (td TypeDefinition) EqualIgnoringLocations(other TypeDefinition) bool {
+	return equalTypeDefinition(&td, &other, true)
+}
+
+// equalTypeDefinition ...
+func equalTypeDefinition(x, y *TypeDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Description == y.Description &&
+		x.Name == y.Name &&
+		equalTypes(x.ImplementsInterface, y.ImplementsInterface, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalFieldDefinitions(x.FieldsDefinition, y.FieldsDefinition, ignoreLocations) &&
+		equalTypes(x.UnionMemberTypes, y.UnionMemberTypes, ignoreLocations) &&
+		equalEnumValueDefinitions(x.EnumValuesDefinition, y.EnumValuesDefinition, ignoreLocations) &&
+		equalInputValueDefinitions(x.InputFieldsDefinition, y.InputFieldsDefinition, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this TypeExtension.
+func (te TypeExtension) Clone() TypeExtension {
+	clone := te
+	clone.Loc = te.Loc.Clone()
+	clone.Directives = te.Directives.Clone()
+	clone.ImplementsInterface = te.ImplementsInterface.Clone()
+	clone.FieldsDefinition = te.FieldsDefinition.Clone()
+	clone.UnionMemberTypes = te.UnionMemberTypes.Clone()
+	clone.EnumValuesDefinition = te.EnumValuesDefinition.Clone()
+	clone.InputFieldsDefinition = te.InputFieldsDefinition.Clone()
+
+	return clone
+}
+
+// Equal returns true if this TypeExtension is structurally equal to the given one.
+func (te TypeExtension) Equal(other TypeExtension) bool {
+	return equalTypeExtension(&te, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this TypeExtension is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (te TypeExtension) EqualIgnoringLocations(other TypeExtension) bool {
+	return equalTypeExtension(&te, &other, true)
+}
+
+// equalTypeExtension ...
+func equalTypeExtension(x, y *TypeExtension, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		equalDirectives(x.Directives, y.Directives, ignoreLocations) &&
+		equalTypes(x.ImplementsInterface, y.ImplementsInterface, ignoreLocations) &&
+		equalFieldDefinitions(x.FieldsDefinition, y.FieldsDefinition, ignoreLocations) &&
+		equalTypes(x.UnionMemberTypes, y.UnionMemberTypes, ignoreLocations) &&
+		equalEnumValueDefinitions(x.EnumValuesDefinition, y.EnumValuesDefinition, ignoreLocations) &&
+		equalInputValueDefinitions(x.InputFieldsDefinition, y.InputFieldsDefinition, ignoreLocations) &&
+		x.Name == y.Name &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this TypeSystemDefinition.
+func (tsd TypeSystemDefinition) Clone() TypeSystemDefinition {
+	clone := tsd
+	clone.SchemaDefinition = cloneSchemaDefinitionPointer(tsd.SchemaDefinition)
+	clone.TypeDefinition = cloneTypeDefinitionPointer(tsd.TypeDefinition)
+	clone.DirectiveDefinition = cloneDirectiveDefinitionPointer(tsd.DirectiveDefinition)
+
+	return clone
+}
+
+// Equal returns true if this TypeSystemDefinition is structurally equal to the given one.
+func (tsd TypeSystemDefinition) Equal(other TypeSystemDefinition) bool {
+	return equalTypeSystemDefinition(&tsd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this TypeSystemDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (tsd TypeSystemDefinition) EqualIgnoringLocations(other TypeSystemDefinition) bool {
+	return equalTypeSystemDefinition(&tsd, &other, true)
+}
+
+// equalTypeSystemDefinition ...
+func equalTypeSystemDefinition(x, y *TypeSystemDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalSchemaDefinition(x.SchemaDefinition, y.SchemaDefinition, ignoreLocations) &&
+		equalTypeDefinition(x.TypeDefinition, y.TypeDefinition, ignoreLocations) &&
+		equalDirectiveDefinition(x.DirectiveDefinition, y.DirectiveDefinition, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this TypeSystemExtension.
+func (tse TypeSystemExtension) Clone() TypeSystemExtension {
+	clone := tse
+	clone.SchemaExtension = cloneSchemaExtensionPointer(tse.SchemaExtension)
+	clone.TypeExtension = cloneTypeExtensionPointer(tse.TypeExtension)
+
+	return clone
+}
+
+// Equal returns true if this TypeSystemExtension is structurally equal to the given one.
+func (tse TypeSystemExtension) Equal(other TypeSystemExtension) bool {
+	return equalTypeSystemExtension(&tse, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this TypeSystemExtension is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (tse TypeSystemExtension) EqualIgnoringLocations(other TypeSystemExtension) bool {
+	return equalTypeSystemExtension(&tse, &other, true)
+}
+
+// equalTypeSystemExtension ...
+func equalTypeSystemExtension(x, y *TypeSystemExtension, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalSchemaExtension(x.SchemaExtension, y.SchemaExtension, ignoreLocations) &&
+		equalTypeExtension(x.TypeExtension, y.TypeExtension, ignoreLocations) &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this linked list of Type.
+func (ts *Types) Clone() *Types {
+	var clone *Types
+
+	ts.ForEach(func(t Type, i int) {
+		clone = clone.Add(t.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of Type is structurally equal to the given
+// one.
+func (ts *Types) Equal(other *Types) bool {
+	return equalTypes(ts, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of Type is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (ts *Types) EqualIgnoringLocations(other *Types) bool {
+	return equalTypes(ts, other, true)
+}
+
+// equalTypes ...
+func equalTypes(x, y *Types, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalType(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Value.
+func (v Value) Clone() Value {
+	clone := v
+	clone.Loc = v.Loc.Clone()
+	clone.ListValue = cloneValueSlice(v.ListValue)
+	clone.ObjectValue = cloneObjectFieldSlice(v.ObjectValue)
+
+	return clone
+}
+
+// Equal returns true if this Value is structurally equal to the given one.
+func (v Value) Equal(other Value) bool {
+	return equalValue(&v, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this Value is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (v Value) EqualIgnoringLocations(other Value) bool {
+	return equalValue(&v, &other, true)
+}
+
+// equalValue ...
+func equalValue(x, y *Value, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.IntValue == y.IntValue &&
+		x.FloatValue == y.FloatValue &&
+		x.RawValue == y.RawValue &&
+		x.StringValue == y.StringValue &&
+		equalValueSlice(x.ListValue, y.ListValue, ignoreLocations) &&
+		equalObjectFieldSlice(x.ObjectValue, y.ObjectValue, ignoreLocations) &&
+		x.BooleanValue == y.BooleanValue &&
+		x.Kind == y.Kind
+}
+
+// Clone returns a deep copy of this VariableDefinition.
+func (vd VariableDefinition) Clone() VariableDefinition {
+	clone := vd
+	clone.Loc = vd.Loc.Clone()
+	clone.Type = vd.Type.Clone()
+	clone.DefaultValue = cloneValuePointer(vd.DefaultValue)
+
+	return clone
+}
+
+// Equal returns true if this VariableDefinition is structurally equal to the given one.
+func (vd VariableDefinition) Equal(other VariableDefinition) bool {
+	return equalVariableDefinition(&vd, &other, false)
+}
+
+// EqualIgnoringLocations returns true if this VariableDefinition is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func (vd VariableDefinition) EqualIgnoringLocations(other VariableDefinition) bool {
+	return equalVariableDefinition(&vd, &other, true)
+}
+
+// equalVariableDefinition ...
+func equalVariableDefinition(x, y *VariableDefinition, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return equalLocation(&x.Loc, &y.Loc, ignoreLocations) &&
+		x.Name == y.Name &&
+		equalType(&x.Type, &y.Type, ignoreLocations) &&
+		equalValue(x.DefaultValue, y.DefaultValue, ignoreLocations)
+}
+
+// Clone returns a deep copy of this linked list of VariableDefinition.
+func (vds *VariableDefinitions) Clone() *VariableDefinitions {
+	var clone *VariableDefinitions
+
+	vds.ForEach(func(vd VariableDefinition, i int) {
+		clone = clone.Add(vd.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of VariableDefinition is structurally equal to the given
+// one.
+func (vds *VariableDefinitions) Equal(other *VariableDefinitions) bool {
+	return equalVariableDefinitions(vds, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of VariableDefinition is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func (vds *VariableDefinitions) EqualIgnoringLocations(other *VariableDefinitions) bool {
+	return equalVariableDefinitions(vds, other, true)
+}
+
+// equalVariableDefinitions ...
+func equalVariableDefinitions(x, y *VariableDefinitions, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equalVariableDefinition(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+
+// cloneDirectiveDefinitionPointer ...
+func cloneDirectiveDefinitionPointer(x *DirectiveDefinition) *DirectiveDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneExecutableDefinitionPointer ...
+func cloneExecutableDefinitionPointer(x *ExecutableDefinition) *ExecutableDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneFragmentDefinitionPointer ...
+func cloneFragmentDefinitionPointer(x *FragmentDefinition) *FragmentDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneOperationDefinitionPointer ...
+func cloneOperationDefinitionPointer(x *OperationDefinition) *OperationDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneSchemaDefinitionPointer ...
+func cloneSchemaDefinitionPointer(x *SchemaDefinition) *SchemaDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneSchemaExtensionPointer ...
+func cloneSchemaExtensionPointer(x *SchemaExtension) *SchemaExtension {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypePointer ...
+func cloneTypePointer(x *Type) *Type {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypeConditionPointer ...
+func cloneTypeConditionPointer(x *TypeCondition) *TypeCondition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypeDefinitionPointer ...
+func cloneTypeDefinitionPointer(x *TypeDefinition) *TypeDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypeExtensionPointer ...
+func cloneTypeExtensionPointer(x *TypeExtension) *TypeExtension {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypeSystemDefinitionPointer ...
+func cloneTypeSystemDefinitionPointer(x *TypeSystemDefinition) *TypeSystemDefinition {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneTypeSystemExtensionPointer ...
+func cloneTypeSystemExtensionPointer(x *TypeSystemExtension) *TypeSystemExtension {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneValuePointer ...
+func cloneValuePointer(x *Value) *Value {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+
+// cloneObjectFieldSlice ...
+func cloneObjectFieldSlice(xs []ObjectField) []ObjectField {
+	if xs == nil {
+		return nil
+	}
+
+	clone := make([]ObjectField, len(xs))
+	for i, x := range xs {
+		clone[i] = x.Clone()
+	}
+
+	return clone
+}
+
+// equalObjectFieldSlice ...
+func equalObjectFieldSlice(xs, ys []ObjectField, ignoreLocations bool) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+
+	for i := range xs {
+		if !equalObjectField(&xs[i], &ys[i], ignoreLocations) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cloneValueSlice ...
+func cloneValueSlice(xs []Value) []Value {
+	if xs == nil {
+		return nil
+	}
+
+	clone := make([]Value, len(xs))
+	for i, x := range xs {
+		clone[i] = x.Clone()
+	}
+
+	return clone
+}
+
+// equalValueSlice ...
+func equalValueSlice(xs, ys []Value, ignoreLocations bool) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+
+	for i := range xs {
+		if !equalValue(&xs[i], &ys[i], ignoreLocations) {
+			return false
+		}
+	}
+
+	return true
+}
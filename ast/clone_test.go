@@ -0,0 +1,153 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_Clone(t *testing.T) {
+	query := `
+# A leading comment.
+query Foo($a: [Int!] = [1, 2]) @foo(b: {c: "d"}) {
+  foo(a: $a) {
+    ... on Bar { bar }
+    ...Baz
+  }
+}
+
+fragment Baz on Baz { baz }
+
+type Query { foo(a: Int = 1): String }
+extend type Query { bar: String }
+`
+
+	doc, err := language.NewParserWithOptions([]byte(query), language.ParserOptions{
+		ParseComments: true,
+	}).Parse()
+	require.NoError(t, err)
+
+	clone := doc.Clone()
+	require.True(t, clone.Equal(doc))
+
+	t.Run("should not share memory with the original", func(t *testing.T) {
+		clone := doc.Clone()
+		original := ast.Sdump(doc)
+
+		clone.Definitions.ForEach(func(def ast.Definition, i int) {
+			switch def.Kind {
+			case ast.DefinitionKindExecutable:
+				if def.ExecutableDefinition.Kind != ast.ExecutableDefinitionKindOperation {
+					return
+				}
+
+				op := def.ExecutableDefinition.OperationDefinition
+				op.Name = "Bar"
+				op.VariableDefinitions.Data.DefaultValue.ListValue[0].RawValue = "3"
+				op.Directives.Data.Arguments.Data.Value.ObjectValue[0].Value.StringValue = "e"
+				op.SelectionSet.Data.SelectionSet.Join((*ast.Selections)(nil).Add(ast.Selection{
+					Kind: ast.SelectionKindField,
+					Name: "qux",
+				}))
+			case ast.DefinitionKindTypeSystem:
+				def.TypeSystemDefinition.TypeDefinition.FieldsDefinition.Data.Type.NamedType = "Int"
+			case ast.DefinitionKindTypeSystemExtension:
+				def.TypeSystemExtension.TypeExtension.FieldsDefinition.Data.Name = "baz"
+			}
+		})
+
+		assert.False(t, clone.Equal(doc))
+		assert.Equal(t, original, ast.Sdump(doc))
+	})
+
+	t.Run("should keep comments and locations", func(t *testing.T) {
+		require.Equal(t, 1, clone.Definitions.Data.Comments.Len())
+		assert.Equal(t, " A leading comment.", clone.Definitions.Data.Comments.Data.Text)
+		assert.Equal(t, doc.Definitions.Data.Loc, clone.Definitions.Data.Loc)
+	})
+}
+
+func TestDocument_Equal(t *testing.T) {
+	parse := func(t *testing.T, query string) ast.Document {
+		doc, err := language.NewParser([]byte(query)).Parse()
+		require.NoError(t, err)
+		return doc
+	}
+
+	tests := []struct {
+		msg                    string
+		a, b                   string
+		equal                  bool
+		equalIgnoringLocations bool
+	}{
+		{
+			msg:                    "identical documents",
+			a:                      `{ foo(a: 1) { bar } }`,
+			b:                      `{ foo(a: 1) { bar } }`,
+			equal:                  true,
+			equalIgnoringLocations: true,
+		},
+		{
+			msg: "documents that only differ in locations",
+			a:   `{ foo(a: 1) { bar } }`,
+			b: `{
+  foo(a: 1) {
+    bar
+  }
+}`,
+			equal:                  false,
+			equalIgnoringLocations: true,
+		},
+		{
+			msg:                    "different names",
+			a:                      `{ foo }`,
+			b:                      `{ bar }`,
+			equal:                  false,
+			equalIgnoringLocations: false,
+		},
+		{
+			msg:                    "different values",
+			a:                      `{ foo(a: [1, 2]) }`,
+			b:                      `{ foo(a: [1, 3]) }`,
+			equal:                  false,
+			equalIgnoringLocations: false,
+		},
+		{
+			msg:                    "different list lengths",
+			a:                      `{ foo bar }`,
+			b:                      `{ foo }`,
+			equal:                  false,
+			equalIgnoringLocations: false,
+		},
+		{
+			msg:                    "missing nodes",
+			a:                      `query ($a: Int = 1) { foo }`,
+			b:                      `query ($a: Int) { foo }`,
+			equal:                  false,
+			equalIgnoringLocations: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			a := parse(t, test.a)
+			b := parse(t, test.b)
+
+			assert.Equal(t, test.equal, a.Equal(b))
+			assert.Equal(t, test.equal, b.Equal(a))
+			assert.Equal(t, test.equalIgnoringLocations, a.EqualIgnoringLocations(b))
+			assert.Equal(t, test.equalIgnoringLocations, b.EqualIgnoringLocations(a))
+		})
+	}
+
+	t.Run("should compare nil lists", func(t *testing.T) {
+		var selections *ast.Selections
+
+		assert.True(t, selections.Equal(nil))
+		assert.True(t, selections.Clone().Equal(nil))
+		assert.False(t, selections.Equal(selections.Add(ast.Selection{Name: "foo"})))
+	})
+}
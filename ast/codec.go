@@ -0,0 +1,1187 @@
+// Code generated by tools/walkergen
+// DO NOT EDIT!
+package ast
+
+// encodeArgument ...
+func (e *encoder) encodeArgument(x *Argument) {
+	e.encodeLocation(&x.Loc)
+	e.string(x.Name)
+	e.encodeValue(&x.Value)
+}
+
+// decodeArgument ...
+func (d *decoder) decodeArgument(x *Argument) {
+	d.decodeLocation(&x.Loc)
+	x.Name = d.string()
+	d.decodeValue(&x.Value)
+}
+
+// encodeArguments ...
+func (e *encoder) encodeArguments(as *Arguments) {
+	e.uint(uint64(as.Len()))
+
+	for ; as != nil; as = as.next {
+		e.encodeArgument(&as.Data)
+	}
+}
+
+// decodeArguments ...
+func (d *decoder) decodeArguments() *Arguments {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	as := make([]Arguments, n)
+	for i := range as {
+		d.decodeArgument(&as[i].Data)
+		as[i].pos = n - 1 - i
+
+		if i < n-1 {
+			as[i].next = &as[i+1]
+		}
+	}
+
+	return &as[0]
+}
+
+// encodeComment ...
+func (e *encoder) encodeComment(x *Comment) {
+	e.encodeLocation(&x.Loc)
+	e.string(x.Text)
+	e.int(int64(x.Kind))
+}
+
+// decodeComment ...
+func (d *decoder) decodeComment(x *Comment) {
+	d.decodeLocation(&x.Loc)
+	x.Text = d.string()
+	x.Kind = CommentKind(d.int())
+}
+
+// encodeComments ...
+func (e *encoder) encodeComments(cs *Comments) {
+	e.uint(uint64(cs.Len()))
+
+	for ; cs != nil; cs = cs.next {
+		e.encodeComment(&cs.Data)
+	}
+}
+
+// decodeComments ...
+func (d *decoder) decodeComments() *Comments {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	cs := make([]Comments, n)
+	for i := range cs {
+		d.decodeComment(&cs[i].Data)
+		cs[i].pos = n - 1 - i
+
+		if i < n-1 {
+			cs[i].next = &cs[i+1]
+		}
+	}
+
+	return &cs[0]
+}
+
+// encodeDefinition ...
+func (e *encoder) encodeDefinition(x *Definition) {
+	e.encodeLocation(&x.Loc)
+	e.encodeComments(x.Comments)
+	e.encodeExecutableDefinitionPointer(x.ExecutableDefinition)
+	e.encodeTypeSystemDefinitionPointer(x.TypeSystemDefinition)
+	e.encodeTypeSystemExtensionPointer(x.TypeSystemExtension)
+	e.int(int64(x.Kind))
+}
+
+// decodeDefinition ...
+func (d *decoder) decodeDefinition(x *Definition) {
+	d.decodeLocation(&x.Loc)
+	x.Comments = d.decodeComments()
+	x.ExecutableDefinition = d.decodeExecutableDefinitionPointer()
+	x.TypeSystemDefinition = d.decodeTypeSystemDefinitionPointer()
+	x.TypeSystemExtension = d.decodeTypeSystemExtensionPointer()
+	x.Kind = DefinitionKind(d.int())
+}
+
+// encodeDefinitions ...
+func (e *encoder) encodeDefinitions(ds *Definitions) {
+	e.uint(uint64(ds.Len()))
+
+	for ; ds != nil; ds = ds.next {
+		e.encodeDefinition(&ds.Data)
+	}
+}
+
+// decodeDefinitions ...
+func (d *decoder) decodeDefinitions() *Definitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ds := make([]Definitions, n)
+	for i := range ds {
+		d.decodeDefinition(&ds[i].Data)
+		ds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ds[i].next = &ds[i+1]
+		}
+	}
+
+	return &ds[0]
+}
+
+// encodeDirective ...
+func (e *encoder) encodeDirective(x *Directive) {
+	e.encodeLocation(&x.Loc)
+	e.string(x.Name)
+	e.encodeArguments(x.Arguments)
+	e.int(int64(x.Location))
+}
+
+// decodeDirective ...
+func (d *decoder) decodeDirective(x *Directive) {
+	d.decodeLocation(&x.Loc)
+	x.Name = d.string()
+	x.Arguments = d.decodeArguments()
+	x.Location = DirectiveLocation(d.int())
+}
+
+// encodeDirectiveDefinition ...
+func (e *encoder) encodeDirectiveDefinition(x *DirectiveDefinition) {
+	e.string(x.Description)
+	e.string(x.Name)
+	e.encodeInputValueDefinitions(x.ArgumentsDefinition)
+	e.int(int64(x.DirectiveLocations))
+	e.bool(x.Repeatable)
+}
+
+// decodeDirectiveDefinition ...
+func (d *decoder) decodeDirectiveDefinition(x *DirectiveDefinition) {
+	x.Description = d.string()
+	x.Name = d.string()
+	x.ArgumentsDefinition = d.decodeInputValueDefinitions()
+	x.DirectiveLocations = DirectiveLocation(d.int())
+	x.Repeatable = d.bool()
+}
+
+// encodeDirectives ...
+func (e *encoder) encodeDirectives(ds *Directives) {
+	e.uint(uint64(ds.Len()))
+
+	for ; ds != nil; ds = ds.next {
+		e.encodeDirective(&ds.Data)
+	}
+}
+
+// decodeDirectives ...
+func (d *decoder) decodeDirectives() *Directives {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ds := make([]Directives, n)
+	for i := range ds {
+		d.decodeDirective(&ds[i].Data)
+		ds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ds[i].next = &ds[i+1]
+		}
+	}
+
+	return &ds[0]
+}
+
+// encodeDocument ...
+func (e *encoder) encodeDocument(x *Document) {
+	e.encodeDefinitions(x.Definitions)
+	e.int(int64(x.OperationDefinitions))
+	e.int(int64(x.FragmentDefinitions))
+	e.int(int64(x.DirectiveDefinitions))
+	e.int(int64(x.SchemaDefinitions))
+	e.int(int64(x.TypeDefinitions))
+	e.int(int64(x.SchemaExtensions))
+	e.int(int64(x.TypeExtensions))
+}
+
+// decodeDocument ...
+func (d *decoder) decodeDocument(x *Document) {
+	x.Definitions = d.decodeDefinitions()
+	x.OperationDefinitions = int32(d.int())
+	x.FragmentDefinitions = int32(d.int())
+	x.DirectiveDefinitions = int32(d.int())
+	x.SchemaDefinitions = int32(d.int())
+	x.TypeDefinitions = int32(d.int())
+	x.SchemaExtensions = int32(d.int())
+	x.TypeExtensions = int32(d.int())
+}
+
+// encodeEnumValueDefinition ...
+func (e *encoder) encodeEnumValueDefinition(x *EnumValueDefinition) {
+	e.encodeLocation(&x.Loc)
+	e.encodeComments(x.Comments)
+	e.string(x.Description)
+	e.encodeDirectives(x.Directives)
+	e.string(x.EnumValue)
+}
+
+// decodeEnumValueDefinition ...
+func (d *decoder) decodeEnumValueDefinition(x *EnumValueDefinition) {
+	d.decodeLocation(&x.Loc)
+	x.Comments = d.decodeComments()
+	x.Description = d.string()
+	x.Directives = d.decodeDirectives()
+	x.EnumValue = d.string()
+}
+
+// encodeEnumValueDefinitions ...
+func (e *encoder) encodeEnumValueDefinitions(evds *EnumValueDefinitions) {
+	e.uint(uint64(evds.Len()))
+
+	for ; evds != nil; evds = evds.next {
+		e.encodeEnumValueDefinition(&evds.Data)
+	}
+}
+
+// decodeEnumValueDefinitions ...
+func (d *decoder) decodeEnumValueDefinitions() *EnumValueDefinitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	evds := make([]EnumValueDefinitions, n)
+	for i := range evds {
+		d.decodeEnumValueDefinition(&evds[i].Data)
+		evds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			evds[i].next = &evds[i+1]
+		}
+	}
+
+	return &evds[0]
+}
+
+// encodeExecutableDefinition ...
+func (e *encoder) encodeExecutableDefinition(x *ExecutableDefinition) {
+	e.encodeFragmentDefinitionPointer(x.FragmentDefinition)
+	e.encodeOperationDefinitionPointer(x.OperationDefinition)
+	e.int(int64(x.Kind))
+}
+
+// decodeExecutableDefinition ...
+func (d *decoder) decodeExecutableDefinition(x *ExecutableDefinition) {
+	x.FragmentDefinition = d.decodeFragmentDefinitionPointer()
+	x.OperationDefinition = d.decodeOperationDefinitionPointer()
+	x.Kind = ExecutableDefinitionKind(d.int())
+}
+
+// encodeFieldDefinition ...
+func (e *encoder) encodeFieldDefinition(x *FieldDefinition) {
+	e.encodeLocation(&x.Loc)
+	e.encodeComments(x.Comments)
+	e.string(x.Description)
+	e.string(x.Name)
+	e.encodeInputValueDefinitions(x.ArgumentsDefinition)
+	e.encodeType(&x.Type)
+	e.encodeDirectives(x.Directives)
+}
+
+// decodeFieldDefinition ...
+func (d *decoder) decodeFieldDefinition(x *FieldDefinition) {
+	d.decodeLocation(&x.Loc)
+	x.Comments = d.decodeComments()
+	x.Description = d.string()
+	x.Name = d.string()
+	x.ArgumentsDefinition = d.decodeInputValueDefinitions()
+	d.decodeType(&x.Type)
+	x.Directives = d.decodeDirectives()
+}
+
+// encodeFieldDefinitions ...
+func (e *encoder) encodeFieldDefinitions(fds *FieldDefinitions) {
+	e.uint(uint64(fds.Len()))
+
+	for ; fds != nil; fds = fds.next {
+		e.encodeFieldDefinition(&fds.Data)
+	}
+}
+
+// decodeFieldDefinitions ...
+func (d *decoder) decodeFieldDefinitions() *FieldDefinitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	fds := make([]FieldDefinitions, n)
+	for i := range fds {
+		d.decodeFieldDefinition(&fds[i].Data)
+		fds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			fds[i].next = &fds[i+1]
+		}
+	}
+
+	return &fds[0]
+}
+
+// encodeFragmentDefinition ...
+func (e *encoder) encodeFragmentDefinition(x *FragmentDefinition) {
+	e.string(x.Name)
+	e.encodeVariableDefinitions(x.VariableDefinitions)
+	e.encodeTypeConditionPointer(x.TypeCondition)
+	e.encodeDirectives(x.Directives)
+	e.encodeSelections(x.SelectionSet)
+}
+
+// decodeFragmentDefinition ...
+func (d *decoder) decodeFragmentDefinition(x *FragmentDefinition) {
+	x.Name = d.string()
+	x.VariableDefinitions = d.decodeVariableDefinitions()
+	x.TypeCondition = d.decodeTypeConditionPointer()
+	x.Directives = d.decodeDirectives()
+	x.SelectionSet = d.decodeSelections()
+}
+
+// encodeInputValueDefinition ...
+func (e *encoder) encodeInputValueDefinition(x *InputValueDefinition) {
+	e.encodeLocation(&x.Loc)
+	e.encodeComments(x.Comments)
+	e.string(x.Description)
+	e.string(x.Name)
+	e.encodeType(&x.Type)
+	e.encodeDirectives(x.Directives)
+	e.encodeValuePointer(x.DefaultValue)
+}
+
+// decodeInputValueDefinition ...
+func (d *decoder) decodeInputValueDefinition(x *InputValueDefinition) {
+	d.decodeLocation(&x.Loc)
+	x.Comments = d.decodeComments()
+	x.Description = d.string()
+	x.Name = d.string()
+	d.decodeType(&x.Type)
+	x.Directives = d.decodeDirectives()
+	x.DefaultValue = d.decodeValuePointer()
+}
+
+// encodeInputValueDefinitions ...
+func (e *encoder) encodeInputValueDefinitions(ivds *InputValueDefinitions) {
+	e.uint(uint64(ivds.Len()))
+
+	for ; ivds != nil; ivds = ivds.next {
+		e.encodeInputValueDefinition(&ivds.Data)
+	}
+}
+
+// decodeInputValueDefinitions ...
+func (d *decoder) decodeInputValueDefinitions() *InputValueDefinitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ivds := make([]InputValueDefinitions, n)
+	for i := range ivds {
+		d.decodeInputValueDefinition(&ivds[i].Data)
+		ivds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ivds[i].next = &ivds[i+1]
+		}
+	}
+
+	return &ivds[0]
+}
+
+// encodeLocation ...
+func (e *encoder) encodeLocation(x *Location) {
+	e.int(int64(x.Start))
+	e.int(int64(x.End))
+}
+
+// decodeLocation ...
+func (d *decoder) decodeLocation(x *Location) {
+	x.Start = int(d.int())
+	x.End = int(d.int())
+}
+
+// encodeObjectField ...
+func (e *encoder) encodeObjectField(x *ObjectField) {
+	e.encodeLocation(&x.Loc)
+	e.string(x.Name)
+	e.encodeValue(&x.Value)
+}
+
+// decodeObjectField ...
+func (d *decoder) decodeObjectField(x *ObjectField) {
+	d.decodeLocation(&x.Loc)
+	x.Name = d.string()
+	d.decodeValue(&x.Value)
+}
+
+// encodeOperationDefinition ...
+func (e *encoder) encodeOperationDefinition(x *OperationDefinition) {
+	e.string(x.Name)
+	e.encodeVariableDefinitions(x.VariableDefinitions)
+	e.encodeDirectives(x.Directives)
+	e.encodeSelections(x.SelectionSet)
+	e.int(int64(x.Kind))
+}
+
+// decodeOperationDefinition ...
+func (d *decoder) decodeOperationDefinition(x *OperationDefinition) {
+	x.Name = d.string()
+	x.VariableDefinitions = d.decodeVariableDefinitions()
+	x.Directives = d.decodeDirectives()
+	x.SelectionSet = d.decodeSelections()
+	x.Kind = OperationDefinitionKind(d.int())
+}
+
+// encodeOperationTypeDefinition ...
+func (e *encoder) encodeOperationTypeDefinition(x *OperationTypeDefinition) {
+	e.encodeType(&x.NamedType)
+	e.int(int64(x.OperationType))
+}
+
+// decodeOperationTypeDefinition ...
+func (d *decoder) decodeOperationTypeDefinition(x *OperationTypeDefinition) {
+	d.decodeType(&x.NamedType)
+	x.OperationType = OperationDefinitionKind(d.int())
+}
+
+// encodeOperationTypeDefinitions ...
+func (e *encoder) encodeOperationTypeDefinitions(otds *OperationTypeDefinitions) {
+	e.uint(uint64(otds.Len()))
+
+	for ; otds != nil; otds = otds.next {
+		e.encodeOperationTypeDefinition(&otds.Data)
+	}
+}
+
+// decodeOperationTypeDefinitions ...
+func (d *decoder) decodeOperationTypeDefinitions() *OperationTypeDefinitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	otds := make([]OperationTypeDefinitions, n)
+	for i := range otds {
+		d.decodeOperationTypeDefinition(&otds[i].Data)
+		otds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			otds[i].next = &otds[i+1]
+		}
+	}
+
+	return &otds[0]
+}
+
+// encodePathNode ...
+func (e *encoder) encodePathNode(x *PathNode) {
+	e.int(int64(x.Kind))
+	e.string(x.String)
+	e.int(int64(x.Int))
+}
+
+// decodePathNode ...
+func (d *decoder) decodePathNode(x *PathNode) {
+	x.Kind = PathNodeKind(d.int())
+	x.String = d.string()
+	x.Int = int(d.int())
+}
+
+// encodePathNodes ...
+func (e *encoder) encodePathNodes(pns *PathNodes) {
+	e.uint(uint64(pns.Len()))
+
+	for ; pns != nil; pns = pns.next {
+		e.encodePathNode(&pns.Data)
+	}
+}
+
+// decodePathNodes ...
+func (d *decoder) decodePathNodes() *PathNodes {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	pns := make([]PathNodes, n)
+	for i := range pns {
+		d.decodePathNode(&pns[i].Data)
+		pns[i].pos = n - 1 - i
+
+		if i < n-1 {
+			pns[i].next = &pns[i+1]
+		}
+	}
+
+	return &pns[0]
+}
+
+// encodePosition ...
+func (e *encoder) encodePosition(x *Position) {
+	e.int(int64(x.Line))
+	e.int(int64(x.Column))
+	e.string(x.Source)
+}
+
+// decodePosition ...
+func (d *decoder) decodePosition(x *Position) {
+	x.Line = int(d.int())
+	x.Column = int(d.int())
+	x.Source = d.string()
+}
+
+// encodePositions ...
+func (e *encoder) encodePositions(ps *Positions) {
+	e.uint(uint64(ps.Len()))
+
+	for ; ps != nil; ps = ps.next {
+		e.encodePosition(&ps.Data)
+	}
+}
+
+// decodePositions ...
+func (d *decoder) decodePositions() *Positions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ps := make([]Positions, n)
+	for i := range ps {
+		d.decodePosition(&ps[i].Data)
+		ps[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ps[i].next = &ps[i+1]
+		}
+	}
+
+	return &ps[0]
+}
+
+// encodeSchemaDefinition ...
+func (e *encoder) encodeSchemaDefinition(x *SchemaDefinition) {
+	e.encodeDirectives(x.Directives)
+	e.encodeOperationTypeDefinitions(x.OperationTypeDefinitions)
+}
+
+// decodeSchemaDefinition ...
+func (d *decoder) decodeSchemaDefinition(x *SchemaDefinition) {
+	x.Directives = d.decodeDirectives()
+	x.OperationTypeDefinitions = d.decodeOperationTypeDefinitions()
+}
+
+// encodeSchemaExtension ...
+func (e *encoder) encodeSchemaExtension(x *SchemaExtension) {
+	e.encodeDirectives(x.Directives)
+	e.encodeOperationTypeDefinitions(x.OperationTypeDefinitions)
+}
+
+// decodeSchemaExtension ...
+func (d *decoder) decodeSchemaExtension(x *SchemaExtension) {
+	x.Directives = d.decodeDirectives()
+	x.OperationTypeDefinitions = d.decodeOperationTypeDefinitions()
+}
+
+// encodeSelection ...
+func (e *encoder) encodeSelection(x *Selection) {
+	e.encodeLocation(&x.Loc)
+	e.encodeComments(x.Comments)
+	e.string(x.Name)
+	e.string(x.Alias)
+	e.encodeTypeConditionPointer(x.TypeCondition)
+	e.encodeArguments(x.Arguments)
+	e.encodeDirectives(x.Directives)
+	e.encodeSelections(x.SelectionSet)
+	e.encodePathNodes(x.Path)
+	e.int(int64(x.Kind))
+}
+
+// decodeSelection ...
+func (d *decoder) decodeSelection(x *Selection) {
+	d.decodeLocation(&x.Loc)
+	x.Comments = d.decodeComments()
+	x.Name = d.string()
+	x.Alias = d.string()
+	x.TypeCondition = d.decodeTypeConditionPointer()
+	x.Arguments = d.decodeArguments()
+	x.Directives = d.decodeDirectives()
+	x.SelectionSet = d.decodeSelections()
+	x.Path = d.decodePathNodes()
+	x.Kind = SelectionKind(d.int())
+}
+
+// encodeSelections ...
+func (e *encoder) encodeSelections(ss *Selections) {
+	e.uint(uint64(ss.Len()))
+
+	for ; ss != nil; ss = ss.next {
+		e.encodeSelection(&ss.Data)
+	}
+}
+
+// decodeSelections ...
+func (d *decoder) decodeSelections() *Selections {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ss := make([]Selections, n)
+	for i := range ss {
+		d.decodeSelection(&ss[i].Data)
+		ss[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ss[i].next = &ss[i+1]
+		}
+	}
+
+	return &ss[0]
+}
+
+// encodeType ...
+func (e *encoder) encodeType(x *Type) {
+	e.string(x.NamedType)
+	e.encodeTypePointer(x.ListType)
+	e.bool(x.NonNullable)
+	e.int(int64(x.Kind))
+}
+
+// decodeType ...
+func (d *decoder) decodeType(x *Type) {
+	x.NamedType = d.string()
+	x.ListType = d.decodeTypePointer()
+	x.NonNullable = d.bool()
+	x.Kind = TypeKind(d.int())
+}
+
+// encodeTypeCondition ...
+func (e *encoder) encodeTypeCondition(x *TypeCondition) {
+	e.encodeType(&x.NamedType)
+}
+
+// decodeTypeCondition ...
+func (d *decoder) decodeTypeCondition(x *TypeCondition) {
+	d.decodeType(&x.NamedType)
+}
+
+// encodeTypeDefinition ...
+func (e *encoder) encodeTypeDefinition(x *TypeDefinition) {
+	e.string(x.Description)
+	e.string(x.Name)
+	e.encodeTypes(x.ImplementsInterface)
+	e.encodeDirectives(x.Directives)
+	e.encodeFieldDefinitions(x.FieldsDefinition)
+	e.encodeTypes(x.UnionMemberTypes)
+	e.encodeEnumValueDefinitions(x.EnumValuesDefinition)
+	e.encodeInputValueDefinitions(x.InputFieldsDefinition)
+	e.int(int64(x.Kind))
+}
+
+// decodeTypeDefinition ...
+func (d *decoder) decodeTypeDefinition(x *TypeDefinition) {
+	x.Description = d.string()
+	x.Name = d.string()
+	x.ImplementsInterface = d.decodeTypes()
+	x.Directives = d.decodeDirectives()
+	x.FieldsDefinition = d.decodeFieldDefinitions()
+	x.UnionMemberTypes = d.decodeTypes()
+	x.EnumValuesDefinition = d.decodeEnumValueDefinitions()
+	x.InputFieldsDefinition = d.decodeInputValueDefinitions()
+	x.Kind = TypeDefinitionKind(d.int())
+}
+
+// encodeTypeExtension ...
+func (e *encoder) encodeTypeExtension(x *TypeExtension) {
+	e.encodeLocation(&x.Loc)
+	e.encodeDirectives(x.Directives)
+	e.encodeTypes(x.ImplementsInterface)
+	e.encodeFieldDefinitions(x.FieldsDefinition)
+	e.encodeTypes(x.UnionMemberTypes)
+	e.encodeEnumValueDefinitions(x.EnumValuesDefinition)
+	e.encodeInputValueDefinitions(x.InputFieldsDefinition)
+	e.string(x.Name)
+	e.int(int64(x.Kind))
+}
+
+// decodeTypeExtension ...
+func (d *decoder) decodeTypeExtension(x *TypeExtension) {
+	d.decodeLocation(&x.Loc)
+	x.Directives = d.decodeDirectives()
+	x.ImplementsInterface = d.decodeTypes()
+	x.FieldsDefinition = d.decodeFieldDefinitions()
+	x.UnionMemberTypes = d.decodeTypes()
+	x.EnumValuesDefinition = d.decodeEnumValueDefinitions()
+	x.InputFieldsDefinition = d.decodeInputValueDefinitions()
+	x.Name = d.string()
+	x.Kind = TypeExtensionKind(d.int())
+}
+
+// encodeTypeSystemDefinition ...
+func (e *encoder) encodeTypeSystemDefinition(x *TypeSystemDefinition) {
+	e.encodeSchemaDefinitionPointer(x.SchemaDefinition)
+	e.encodeTypeDefinitionPointer(x.TypeDefinition)
+	e.encodeDirectiveDefinitionPointer(x.DirectiveDefinition)
+	e.int(int64(x.Kind))
+}
+
+// decodeTypeSystemDefinition ...
+func (d *decoder) decodeTypeSystemDefinition(x *TypeSystemDefinition) {
+	x.SchemaDefinition = d.decodeSchemaDefinitionPointer()
+	x.TypeDefinition = d.decodeTypeDefinitionPointer()
+	x.DirectiveDefinition = d.decodeDirectiveDefinitionPointer()
+	x.Kind = TypeSystemDefinitionKind(d.int())
+}
+
+// encodeTypeSystemExtension ...
+func (e *encoder) encodeTypeSystemExtension(x *TypeSystemExtension) {
+	e.encodeSchemaExtensionPointer(x.SchemaExtension)
+	e.encodeTypeExtensionPointer(x.TypeExtension)
+	e.int(int64(x.Kind))
+}
+
+// decodeTypeSystemExtension ...
+func (d *decoder) decodeTypeSystemExtension(x *TypeSystemExtension) {
+	x.SchemaExtension = d.decodeSchemaExtensionPointer()
+	x.TypeExtension = d.decodeTypeExtensionPointer()
+	x.Kind = TypeSystemExtensionKind(d.int())
+}
+
+// encodeTypes ...
+func (e *encoder) encodeTypes(ts *Types) {
+	e.uint(uint64(ts.Len()))
+
+	for ; ts != nil; ts = ts.next {
+		e.encodeType(&ts.Data)
+	}
+}
+
+// decodeTypes ...
+func (d *decoder) decodeTypes() *Types {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	ts := make([]Types, n)
+	for i := range ts {
+		d.decodeType(&ts[i].Data)
+		ts[i].pos = n - 1 - i
+
+		if i < n-1 {
+			ts[i].next = &ts[i+1]
+		}
+	}
+
+	return &ts[0]
+}
+
+// encodeValue ...
+func (e *encoder) encodeValue(x *Value) {
+	e.encodeLocation(&x.Loc)
+	e.int(int64(x.IntValue))
+	e.float(x.FloatValue)
+	e.string(x.RawValue)
+	e.string(x.StringValue)
+	e.encodeValueSlice(x.ListValue)
+	e.encodeObjectFieldSlice(x.ObjectValue)
+	e.bool(x.BooleanValue)
+	e.int(int64(x.Kind))
+}
+
+// decodeValue ...
+func (d *decoder) decodeValue(x *Value) {
+	d.decodeLocation(&x.Loc)
+	x.IntValue = int(d.int())
+	x.FloatValue = d.float()
+	x.RawValue = d.string()
+	x.StringValue = d.string()
+	x.ListValue = d.decodeValueSlice()
+	x.ObjectValue = d.decodeObjectFieldSlice()
+	x.BooleanValue = d.bool()
+	x.Kind = ValueKind(d.int())
+}
+
+// encodeVariableDefinition ...
+func (e *encoder) encodeVariableDefinition(x *VariableDefinition) {
+	e.encodeLocation(&x.Loc)
+	e.string(x.Name)
+	e.encodeType(&x.Type)
+	e.encodeValuePointer(x.DefaultValue)
+}
+
+// decodeVariableDefinition ...
+func (d *decoder) decodeVariableDefinition(x *VariableDefinition) {
+	d.decodeLocation(&x.Loc)
+	x.Name = d.string()
+	d.decodeType(&x.Type)
+	x.DefaultValue = d.decodeValuePointer()
+}
+
+// encodeVariableDefinitions ...
+func (e *encoder) encodeVariableDefinitions(vds *VariableDefinitions) {
+	e.uint(uint64(vds.Len()))
+
+	for ; vds != nil; vds = vds.next {
+		e.encodeVariableDefinition(&vds.Data)
+	}
+}
+
+// decodeVariableDefinitions ...
+func (d *decoder) decodeVariableDefinitions() *VariableDefinitions {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	vds := make([]VariableDefinitions, n)
+	for i := range vds {
+		d.decodeVariableDefinition(&vds[i].Data)
+		vds[i].pos = n - 1 - i
+
+		if i < n-1 {
+			vds[i].next = &vds[i+1]
+		}
+	}
+
+	return &vds[0]
+}
+
+// encodeDirectiveDefinitionPointer ...
+func (e *encoder) encodeDirectiveDefinitionPointer(x *DirectiveDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeDirectiveDefinition(x)
+	}
+}
+
+// decodeDirectiveDefinitionPointer ...
+func (d *decoder) decodeDirectiveDefinitionPointer() *DirectiveDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &DirectiveDefinition{}
+	d.decodeDirectiveDefinition(x)
+
+	return x
+}
+
+// encodeExecutableDefinitionPointer ...
+func (e *encoder) encodeExecutableDefinitionPointer(x *ExecutableDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeExecutableDefinition(x)
+	}
+}
+
+// decodeExecutableDefinitionPointer ...
+func (d *decoder) decodeExecutableDefinitionPointer() *ExecutableDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &ExecutableDefinition{}
+	d.decodeExecutableDefinition(x)
+
+	return x
+}
+
+// encodeFragmentDefinitionPointer ...
+func (e *encoder) encodeFragmentDefinitionPointer(x *FragmentDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeFragmentDefinition(x)
+	}
+}
+
+// decodeFragmentDefinitionPointer ...
+func (d *decoder) decodeFragmentDefinitionPointer() *FragmentDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &FragmentDefinition{}
+	d.decodeFragmentDefinition(x)
+
+	return x
+}
+
+// encodeOperationDefinitionPointer ...
+func (e *encoder) encodeOperationDefinitionPointer(x *OperationDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeOperationDefinition(x)
+	}
+}
+
+// decodeOperationDefinitionPointer ...
+func (d *decoder) decodeOperationDefinitionPointer() *OperationDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &OperationDefinition{}
+	d.decodeOperationDefinition(x)
+
+	return x
+}
+
+// encodeSchemaDefinitionPointer ...
+func (e *encoder) encodeSchemaDefinitionPointer(x *SchemaDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeSchemaDefinition(x)
+	}
+}
+
+// decodeSchemaDefinitionPointer ...
+func (d *decoder) decodeSchemaDefinitionPointer() *SchemaDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &SchemaDefinition{}
+	d.decodeSchemaDefinition(x)
+
+	return x
+}
+
+// encodeSchemaExtensionPointer ...
+func (e *encoder) encodeSchemaExtensionPointer(x *SchemaExtension) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeSchemaExtension(x)
+	}
+}
+
+// decodeSchemaExtensionPointer ...
+func (d *decoder) decodeSchemaExtensionPointer() *SchemaExtension {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &SchemaExtension{}
+	d.decodeSchemaExtension(x)
+
+	return x
+}
+
+// encodeTypePointer ...
+func (e *encoder) encodeTypePointer(x *Type) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeType(x)
+	}
+}
+
+// decodeTypePointer ...
+func (d *decoder) decodeTypePointer() *Type {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &Type{}
+	d.decodeType(x)
+
+	return x
+}
+
+// encodeTypeConditionPointer ...
+func (e *encoder) encodeTypeConditionPointer(x *TypeCondition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeTypeCondition(x)
+	}
+}
+
+// decodeTypeConditionPointer ...
+func (d *decoder) decodeTypeConditionPointer() *TypeCondition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &TypeCondition{}
+	d.decodeTypeCondition(x)
+
+	return x
+}
+
+// encodeTypeDefinitionPointer ...
+func (e *encoder) encodeTypeDefinitionPointer(x *TypeDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeTypeDefinition(x)
+	}
+}
+
+// decodeTypeDefinitionPointer ...
+func (d *decoder) decodeTypeDefinitionPointer() *TypeDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &TypeDefinition{}
+	d.decodeTypeDefinition(x)
+
+	return x
+}
+
+// encodeTypeExtensionPointer ...
+func (e *encoder) encodeTypeExtensionPointer(x *TypeExtension) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeTypeExtension(x)
+	}
+}
+
+// decodeTypeExtensionPointer ...
+func (d *decoder) decodeTypeExtensionPointer() *TypeExtension {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &TypeExtension{}
+	d.decodeTypeExtension(x)
+
+	return x
+}
+
+// encodeTypeSystemDefinitionPointer ...
+func (e *encoder) encodeTypeSystemDefinitionPointer(x *TypeSystemDefinition) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeTypeSystemDefinition(x)
+	}
+}
+
+// decodeTypeSystemDefinitionPointer ...
+func (d *decoder) decodeTypeSystemDefinitionPointer() *TypeSystemDefinition {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &TypeSystemDefinition{}
+	d.decodeTypeSystemDefinition(x)
+
+	return x
+}
+
+// encodeTypeSystemExtensionPointer ...
+func (e *encoder) encodeTypeSystemExtensionPointer(x *TypeSystemExtension) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeTypeSystemExtension(x)
+	}
+}
+
+// decodeTypeSystemExtensionPointer ...
+func (d *decoder) decodeTypeSystemExtensionPointer() *TypeSystemExtension {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &TypeSystemExtension{}
+	d.decodeTypeSystemExtension(x)
+
+	return x
+}
+
+// encodeValuePointer ...
+func (e *encoder) encodeValuePointer(x *Value) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encodeValue(x)
+	}
+}
+
+// decodeValuePointer ...
+func (d *decoder) decodeValuePointer() *Value {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &Value{}
+	d.decodeValue(x)
+
+	return x
+}
+
+// encodeObjectFieldSlice ...
+func (e *encoder) encodeObjectFieldSlice(xs []ObjectField) {
+	e.uint(uint64(len(xs)))
+
+	for i := range xs {
+		e.encodeObjectField(&xs[i])
+	}
+}
+
+// decodeObjectFieldSlice ...
+func (d *decoder) decodeObjectFieldSlice() []ObjectField {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	xs := make([]ObjectField, n)
+	for i := range xs {
+		d.decodeObjectField(&xs[i])
+	}
+
+	return xs
+}
+
+// encodeValueSlice ...
+func (e *encoder) encodeValueSlice(xs []Value) {
+	e.uint(uint64(len(xs)))
+
+	for i := range xs {
+		e.encodeValue(&xs[i])
+	}
+}
+
+// decodeValueSlice ...
+func (d *decoder) decodeValueSlice() []Value {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	xs := make([]Value, n)
+	for i := range xs {
+		d.decodeValue(&xs[i])
+	}
+
+	return xs
+}
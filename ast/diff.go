@@ -0,0 +1,668 @@
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind constants.
+const (
+	// ChangeKindAdded is a node that's only in the second document.
+	ChangeKindAdded ChangeKind = iota
+	// ChangeKindRemoved is a node that's only in the first document.
+	ChangeKindRemoved
+	// ChangeKindModified is a property of a node in both documents that has a different value.
+	ChangeKindModified
+)
+
+// ChangeKind describes whether a node was added, removed, or modified.
+type ChangeKind int8
+
+// String returns the name of this kind of change, as used in Change.String, e.g. "added".
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeKindAdded:
+		return "added"
+	case ChangeKindRemoved:
+		return "removed"
+	case ChangeKindModified:
+		return "modified"
+	}
+
+	return "invalid"
+}
+
+// ChangeNodeKind constants.
+const (
+	// ChangeNodeKindDefinition is a top-level definition, e.g. an operation or a type.
+	ChangeNodeKindDefinition ChangeNodeKind = iota
+	// ChangeNodeKindOperationTypeDefinition is an operation type in a schema definition.
+	ChangeNodeKindOperationTypeDefinition
+	// ChangeNodeKindSelection is a field, fragment spread, or inline fragment.
+	ChangeNodeKindSelection
+	// ChangeNodeKindArgument is an argument given to a field or directive.
+	ChangeNodeKindArgument
+	// ChangeNodeKindDirective is a directive applied to a node.
+	ChangeNodeKindDirective
+	// ChangeNodeKindVariableDefinition is a variable defined by an operation.
+	ChangeNodeKindVariableDefinition
+	// ChangeNodeKindFieldDefinition is a field defined by an object or interface type.
+	ChangeNodeKindFieldDefinition
+	// ChangeNodeKindInputValueDefinition is an argument definition, or an input object field.
+	ChangeNodeKindInputValueDefinition
+	// ChangeNodeKindEnumValueDefinition is a value defined by an enum type.
+	ChangeNodeKindEnumValueDefinition
+	// ChangeNodeKindName is the name of a node, e.g. the type that a fragment is on.
+	ChangeNodeKindName
+	// ChangeNodeKindType is a type reference, e.g. the type of a field.
+	ChangeNodeKindType
+	// ChangeNodeKindValue is a value, e.g. an argument's value, or a default value.
+	ChangeNodeKindValue
+	// ChangeNodeKindDescription is the description of a definition.
+	ChangeNodeKindDescription
+	// ChangeNodeKindDirectiveLocations is the locations that a directive may be used at.
+	ChangeNodeKindDirectiveLocations
+	// ChangeNodeKindRepeatable is whether a directive is repeatable.
+	ChangeNodeKindRepeatable
+)
+
+// ChangeNodeKind describes the kind of node, or property of a node, that a change was made to.
+type ChangeNodeKind int8
+
+// String returns the name of this kind of node, as used in Change.String, e.g. "field definition".
+func (k ChangeNodeKind) String() string {
+	switch k {
+	case ChangeNodeKindDefinition:
+		return "definition"
+	case ChangeNodeKindOperationTypeDefinition:
+		return "operation type definition"
+	case ChangeNodeKindSelection:
+		return "selection"
+	case ChangeNodeKindArgument:
+		return "argument"
+	case ChangeNodeKindDirective:
+		return "directive"
+	case ChangeNodeKindVariableDefinition:
+		return "variable definition"
+	case ChangeNodeKindFieldDefinition:
+		return "field definition"
+	case ChangeNodeKindInputValueDefinition:
+		return "input value definition"
+	case ChangeNodeKindEnumValueDefinition:
+		return "enum value definition"
+	case ChangeNodeKindName:
+		return "name"
+	case ChangeNodeKindType:
+		return "type"
+	case ChangeNodeKindValue:
+		return "value"
+	case ChangeNodeKindDescription:
+		return "description"
+	case ChangeNodeKindDirectiveLocations:
+		return "directive locations"
+	case ChangeNodeKindRepeatable:
+		return "repeatable"
+	}
+
+	return "invalid"
+}
+
+// Change is a single difference between two documents.
+type Change struct {
+	Kind ChangeKind
+	Node ChangeNodeKind
+	// Path is the path to the node that was changed, starting at its definition. Each part of the
+	// path is a string node holding the name of a node, prefixed with its kind where names could
+	// clash, e.g. "query Foo", "@include", "$id", or "(id:)" for arguments. Fields are named by their
+	// alias, if they have one.
+	Path *PathNodes
+	// Before and After are the printed values of types, values, and other properties of nodes. They
+	// are empty when a whole node is added or removed.
+	Before string
+	After  string
+}
+
+// PathString returns the path of this change as a single string, e.g. "query Foo.user(id:)".
+func (c Change) PathString() string {
+	buf := bytes.Buffer{}
+
+	c.Path.ForEach(func(pn PathNode, i int) {
+		if i > 0 && !strings.HasPrefix(pn.String, "(") {
+			buf.WriteString(".")
+		}
+
+		buf.WriteString(pn.String)
+	})
+
+	return buf.String()
+}
+
+func (c Change) String() string {
+	str := fmt.Sprintf("%s %s at %s", c.Kind, c.Node, c.PathString())
+
+	switch {
+	case c.Kind == ChangeKindModified:
+		str += fmt.Sprintf(": %s -> %s", c.Before, c.After)
+	case c.After != "":
+		str += ": " + c.After
+	case c.Before != "":
+		str += ": " + c.Before
+	}
+
+	return str
+}
+
+// Diff returns the changes that would turn document a into document b. Nodes in lists are matched
+// by their names, rather than their positions, so reordering nodes isn't a change. Changes to nodes
+// that exist in both documents are returned in the order that they appear in a, followed by nodes
+// that were added in b. Locations and comments are ignored.
+func Diff(a, b Document) []Change {
+	d := differ{}
+	d.diffDefinitions(a.Definitions, b.Definitions)
+
+	return d.changes
+}
+
+// differ collects the changes between two documents.
+type differ struct {
+	changes []Change
+}
+
+// add records a change to a node, or a property of a node, at the given path.
+func (d *differ) add(kind ChangeKind, node ChangeNodeKind, path []string, before, after string) {
+	var pathNodes *PathNodes
+	for _, part := range path {
+		pathNodes = pathNodes.Add(NewStringPathNode(part))
+	}
+
+	d.changes = append(d.changes, Change{
+		Kind:   kind,
+		Node:   node,
+		Path:   pathNodes.Reverse(),
+		Before: before,
+		After:  after,
+	})
+}
+
+// diffProperty records a modification of a property of a node, if its value has changed.
+func (d *differ) diffProperty(node ChangeNodeKind, path []string, before, after string) {
+	if before != after {
+		d.add(ChangeKindModified, node, path, before, after)
+	}
+}
+
+// diffOptionalValue records a change to a value that may not be set, e.g. a default value.
+func (d *differ) diffOptionalValue(path []string, before, after *Value) {
+	switch {
+	case before == nil && after == nil:
+		return
+	case before == nil:
+		d.add(ChangeKindAdded, ChangeNodeKindValue, path, "", sprintValue(*after))
+	case after == nil:
+		d.add(ChangeKindRemoved, ChangeNodeKindValue, path, sprintValue(*before), "")
+	default:
+		d.diffProperty(ChangeNodeKindValue, path, sprintValue(*before), sprintValue(*after))
+	}
+}
+
+// diffKeys matches the nodes of two lists by the given keys, recording nodes that were removed and
+// added, and calling fn with the indexes of nodes that are in both lists, so they can be compared.
+func (d *differ) diffKeys(node ChangeNodeKind, path []string, as, bs []string, fn func(path []string, i, j int)) {
+	as, bs = uniqueKeys(as), uniqueKeys(bs)
+
+	bIndexes := make(map[string]int, len(bs))
+	for j, key := range bs {
+		bIndexes[key] = j
+	}
+
+	aIndexes := make(map[string]int, len(as))
+	for i, key := range as {
+		aIndexes[key] = i
+
+		j, ok := bIndexes[key]
+		if !ok {
+			d.add(ChangeKindRemoved, node, appendPath(path, key), "", "")
+			continue
+		}
+
+		if fn != nil {
+			fn(appendPath(path, key), i, j)
+		}
+	}
+
+	for _, key := range bs {
+		if _, ok := aIndexes[key]; !ok {
+			d.add(ChangeKindAdded, node, appendPath(path, key), "", "")
+		}
+	}
+}
+
+// 2.2 Document
+func (d *differ) diffDefinitions(as, bs *Definitions) {
+	var aDefs, bDefs []Definition
+	var aKeys, bKeys []string
+
+	as.ForEach(func(def Definition, _ int) {
+		aDefs = append(aDefs, def)
+		aKeys = append(aKeys, definitionKey(def))
+	})
+
+	bs.ForEach(func(def Definition, _ int) {
+		bDefs = append(bDefs, def)
+		bKeys = append(bKeys, definitionKey(def))
+	})
+
+	d.diffKeys(ChangeNodeKindDefinition, nil, aKeys, bKeys, func(path []string, i, j int) {
+		d.diffDefinition(path, aDefs[i], bDefs[j])
+	})
+}
+
+// diffDefinition compares two definitions with the same key, which means they're the same kind.
+func (d *differ) diffDefinition(path []string, a, b Definition) {
+	switch a.Kind {
+	case DefinitionKindExecutable:
+		switch a.ExecutableDefinition.Kind {
+		case ExecutableDefinitionKindOperation:
+			d.diffOperationDefinition(path, a.ExecutableDefinition.OperationDefinition, b.ExecutableDefinition.OperationDefinition)
+		case ExecutableDefinitionKindFragment:
+			d.diffFragmentDefinition(path, a.ExecutableDefinition.FragmentDefinition, b.ExecutableDefinition.FragmentDefinition)
+		}
+	case DefinitionKindTypeSystem:
+		switch a.TypeSystemDefinition.Kind {
+		case TypeSystemDefinitionKindSchema:
+			as, bs := a.TypeSystemDefinition.SchemaDefinition, b.TypeSystemDefinition.SchemaDefinition
+			d.diffDirectives(path, as.Directives, bs.Directives)
+			d.diffOperationTypeDefinitions(path, as.OperationTypeDefinitions, bs.OperationTypeDefinitions)
+		case TypeSystemDefinitionKindType:
+			d.diffTypeDefinition(path, a.TypeSystemDefinition.TypeDefinition, b.TypeSystemDefinition.TypeDefinition)
+		case TypeSystemDefinitionKindDirective:
+			d.diffDirectiveDefinition(path, a.TypeSystemDefinition.DirectiveDefinition, b.TypeSystemDefinition.DirectiveDefinition)
+		}
+	case DefinitionKindTypeSystemExtension:
+		switch a.TypeSystemExtension.Kind {
+		case TypeSystemExtensionKindSchema:
+			as, bs := a.TypeSystemExtension.SchemaExtension, b.TypeSystemExtension.SchemaExtension
+			d.diffDirectives(path, as.Directives, bs.Directives)
+			d.diffOperationTypeDefinitions(path, as.OperationTypeDefinitions, bs.OperationTypeDefinitions)
+		case TypeSystemExtensionKindType:
+			d.diffTypeExtension(path, a.TypeSystemExtension.TypeExtension, b.TypeSystemExtension.TypeExtension)
+		}
+	}
+}
+
+// 2.3 Operations
+func (d *differ) diffOperationDefinition(path []string, a, b *OperationDefinition) {
+	d.diffVariableDefinitions(path, a.VariableDefinitions, b.VariableDefinitions)
+	d.diffDirectives(path, a.Directives, b.Directives)
+	d.diffSelections(path, a.SelectionSet, b.SelectionSet)
+}
+
+// 2.4 Selection Sets
+func (d *differ) diffSelections(path []string, as, bs *Selections) {
+	var aSels, bSels []Selection
+	var aKeys, bKeys []string
+
+	as.ForEach(func(sel Selection, _ int) {
+		aSels = append(aSels, sel)
+		aKeys = append(aKeys, selectionKey(sel))
+	})
+
+	bs.ForEach(func(sel Selection, _ int) {
+		bSels = append(bSels, sel)
+		bKeys = append(bKeys, selectionKey(sel))
+	})
+
+	d.diffKeys(ChangeNodeKindSelection, path, aKeys, bKeys, func(path []string, i, j int) {
+		a, b := aSels[i], bSels[j]
+
+		// Aliased fields are matched by their alias, so the field they select may have changed.
+		if a.Kind == SelectionKindField {
+			d.diffProperty(ChangeNodeKindName, path, a.Name, b.Name)
+		}
+
+		d.diffArguments(path, a.Arguments, b.Arguments)
+		d.diffDirectives(path, a.Directives, b.Directives)
+		d.diffSelections(path, a.SelectionSet, b.SelectionSet)
+	})
+}
+
+// 2.6 Arguments
+func (d *differ) diffArguments(path []string, as, bs *Arguments) {
+	var aArgs, bArgs []Argument
+	var aKeys, bKeys []string
+
+	as.ForEach(func(arg Argument, _ int) {
+		aArgs = append(aArgs, arg)
+		aKeys = append(aKeys, "("+arg.Name+":)")
+	})
+
+	bs.ForEach(func(arg Argument, _ int) {
+		bArgs = append(bArgs, arg)
+		bKeys = append(bKeys, "("+arg.Name+":)")
+	})
+
+	d.diffKeys(ChangeNodeKindArgument, path, aKeys, bKeys, func(path []string, i, j int) {
+		d.diffProperty(ChangeNodeKindValue, path, sprintValue(aArgs[i].Value), sprintValue(bArgs[j].Value))
+	})
+}
+
+// 2.8 Fragments
+func (d *differ) diffFragmentDefinition(path []string, a, b *FragmentDefinition) {
+	d.diffProperty(ChangeNodeKindType, path, a.TypeCondition.NamedType.String(), b.TypeCondition.NamedType.String())
+	d.diffVariableDefinitions(path, a.VariableDefinitions, b.VariableDefinitions)
+	d.diffDirectives(path, a.Directives, b.Directives)
+	d.diffSelections(path, a.SelectionSet, b.SelectionSet)
+}
+
+// 2.10 Variables
+func (d *differ) diffVariableDefinitions(path []string, as, bs *VariableDefinitions) {
+	var aVars, bVars []VariableDefinition
+	var aKeys, bKeys []string
+
+	as.ForEach(func(vd VariableDefinition, _ int) {
+		aVars = append(aVars, vd)
+		aKeys = append(aKeys, "$"+vd.Name)
+	})
+
+	bs.ForEach(func(vd VariableDefinition, _ int) {
+		bVars = append(bVars, vd)
+		bKeys = append(bKeys, "$"+vd.Name)
+	})
+
+	d.diffKeys(ChangeNodeKindVariableDefinition, path, aKeys, bKeys, func(path []string, i, j int) {
+		a, b := aVars[i], bVars[j]
+
+		d.diffProperty(ChangeNodeKindType, path, a.Type.String(), b.Type.String())
+		d.diffOptionalValue(path, a.DefaultValue, b.DefaultValue)
+	})
+}
+
+// 2.12 Directives
+func (d *differ) diffDirectives(path []string, as, bs *Directives) {
+	var aDirs, bDirs []Directive
+	var aKeys, bKeys []string
+
+	as.ForEach(func(dir Directive, _ int) {
+		aDirs = append(aDirs, dir)
+		aKeys = append(aKeys, "@"+dir.Name)
+	})
+
+	bs.ForEach(func(dir Directive, _ int) {
+		bDirs = append(bDirs, dir)
+		bKeys = append(bKeys, "@"+dir.Name)
+	})
+
+	d.diffKeys(ChangeNodeKindDirective, path, aKeys, bKeys, func(path []string, i, j int) {
+		d.diffArguments(path, aDirs[i].Arguments, bDirs[j].Arguments)
+	})
+}
+
+// 3.2 Schema
+func (d *differ) diffOperationTypeDefinitions(path []string, as, bs *OperationTypeDefinitions) {
+	var aOTDs, bOTDs []OperationTypeDefinition
+	var aKeys, bKeys []string
+
+	as.ForEach(func(otd OperationTypeDefinition, _ int) {
+		aOTDs = append(aOTDs, otd)
+		aKeys = append(aKeys, otd.OperationType.String())
+	})
+
+	bs.ForEach(func(otd OperationTypeDefinition, _ int) {
+		bOTDs = append(bOTDs, otd)
+		bKeys = append(bKeys, otd.OperationType.String())
+	})
+
+	d.diffKeys(ChangeNodeKindOperationTypeDefinition, path, aKeys, bKeys, func(path []string, i, j int) {
+		d.diffProperty(ChangeNodeKindType, path, aOTDs[i].NamedType.String(), bOTDs[j].NamedType.String())
+	})
+}
+
+// 3.4 Types
+func (d *differ) diffTypeDefinition(path []string, a, b *TypeDefinition) {
+	d.diffProperty(ChangeNodeKindDescription, path, a.Description, b.Description)
+	d.diffTypes(path, a.ImplementsInterface, b.ImplementsInterface)
+	d.diffDirectives(path, a.Directives, b.Directives)
+	d.diffFieldDefinitions(path, a.FieldsDefinition, b.FieldsDefinition)
+	d.diffTypes(path, a.UnionMemberTypes, b.UnionMemberTypes)
+	d.diffEnumValueDefinitions(path, a.EnumValuesDefinition, b.EnumValuesDefinition)
+	d.diffInputValueDefinitions(path, a.InputFieldsDefinition, b.InputFieldsDefinition, false)
+}
+
+// 3.4.3 Type Extensions
+func (d *differ) diffTypeExtension(path []string, a, b *TypeExtension) {
+	d.diffTypes(path, a.ImplementsInterface, b.ImplementsInterface)
+	d.diffDirectives(path, a.Directives, b.Directives)
+	d.diffFieldDefinitions(path, a.FieldsDefinition, b.FieldsDefinition)
+	d.diffTypes(path, a.UnionMemberTypes, b.UnionMemberTypes)
+	d.diffEnumValueDefinitions(path, a.EnumValuesDefinition, b.EnumValuesDefinition)
+	d.diffInputValueDefinitions(path, a.InputFieldsDefinition, b.InputFieldsDefinition, false)
+}
+
+// diffTypes compares lists of named types, i.e. implemented interfaces, and union member types.
+func (d *differ) diffTypes(path []string, as, bs *Types) {
+	var aKeys, bKeys []string
+
+	as.ForEach(func(t Type, _ int) {
+		aKeys = append(aKeys, t.String())
+	})
+
+	bs.ForEach(func(t Type, _ int) {
+		bKeys = append(bKeys, t.String())
+	})
+
+	d.diffKeys(ChangeNodeKindType, path, aKeys, bKeys, nil)
+}
+
+// 3.6 Objects
+func (d *differ) diffFieldDefinitions(path []string, as, bs *FieldDefinitions) {
+	var aFields, bFields []FieldDefinition
+	var aKeys, bKeys []string
+
+	as.ForEach(func(fd FieldDefinition, _ int) {
+		aFields = append(aFields, fd)
+		aKeys = append(aKeys, fd.Name)
+	})
+
+	bs.ForEach(func(fd FieldDefinition, _ int) {
+		bFields = append(bFields, fd)
+		bKeys = append(bKeys, fd.Name)
+	})
+
+	d.diffKeys(ChangeNodeKindFieldDefinition, path, aKeys, bKeys, func(path []string, i, j int) {
+		a, b := aFields[i], bFields[j]
+
+		d.diffProperty(ChangeNodeKindDescription, path, a.Description, b.Description)
+		d.diffInputValueDefinitions(path, a.ArgumentsDefinition, b.ArgumentsDefinition, true)
+		d.diffProperty(ChangeNodeKindType, path, a.Type.String(), b.Type.String())
+		d.diffDirectives(path, a.Directives, b.Directives)
+	})
+}
+
+// diffInputValueDefinitions compares argument definitions, or input field definitions. Arguments
+// are named like arguments in the paths of changes.
+func (d *differ) diffInputValueDefinitions(path []string, as, bs *InputValueDefinitions, arguments bool) {
+	var aIVDs, bIVDs []InputValueDefinition
+	var aKeys, bKeys []string
+
+	key := func(ivd InputValueDefinition) string {
+		if arguments {
+			return "(" + ivd.Name + ":)"
+		}
+
+		return ivd.Name
+	}
+
+	as.ForEach(func(ivd InputValueDefinition, _ int) {
+		aIVDs = append(aIVDs, ivd)
+		aKeys = append(aKeys, key(ivd))
+	})
+
+	bs.ForEach(func(ivd InputValueDefinition, _ int) {
+		bIVDs = append(bIVDs, ivd)
+		bKeys = append(bKeys, key(ivd))
+	})
+
+	d.diffKeys(ChangeNodeKindInputValueDefinition, path, aKeys, bKeys, func(path []string, i, j int) {
+		a, b := aIVDs[i], bIVDs[j]
+
+		d.diffProperty(ChangeNodeKindDescription, path, a.Description, b.Description)
+		d.diffProperty(ChangeNodeKindType, path, a.Type.String(), b.Type.String())
+		d.diffOptionalValue(path, a.DefaultValue, b.DefaultValue)
+		d.diffDirectives(path, a.Directives, b.Directives)
+	})
+}
+
+// 3.9 Enums
+func (d *differ) diffEnumValueDefinitions(path []string, as, bs *EnumValueDefinitions) {
+	var aEVDs, bEVDs []EnumValueDefinition
+	var aKeys, bKeys []string
+
+	as.ForEach(func(evd EnumValueDefinition, _ int) {
+		aEVDs = append(aEVDs, evd)
+		aKeys = append(aKeys, evd.EnumValue)
+	})
+
+	bs.ForEach(func(evd EnumValueDefinition, _ int) {
+		bEVDs = append(bEVDs, evd)
+		bKeys = append(bKeys, evd.EnumValue)
+	})
+
+	d.diffKeys(ChangeNodeKindEnumValueDefinition, path, aKeys, bKeys, func(path []string, i, j int) {
+		d.diffProperty(ChangeNodeKindDescription, path, aEVDs[i].Description, bEVDs[j].Description)
+		d.diffDirectives(path, aEVDs[i].Directives, bEVDs[j].Directives)
+	})
+}
+
+// 3.13 Directives
+func (d *differ) diffDirectiveDefinition(path []string, a, b *DirectiveDefinition) {
+	d.diffProperty(ChangeNodeKindDescription, path, a.Description, b.Description)
+	d.diffInputValueDefinitions(path, a.ArgumentsDefinition, b.ArgumentsDefinition, true)
+	d.diffProperty(ChangeNodeKindRepeatable, path, strconv.FormatBool(a.Repeatable), strconv.FormatBool(b.Repeatable))
+	d.diffProperty(ChangeNodeKindDirectiveLocations, path, sprintDirectiveLocations(a.DirectiveLocations), sprintDirectiveLocations(b.DirectiveLocations))
+}
+
+/*****************************************************************************
+ * Utility functions                                                         *
+ *****************************************************************************/
+
+// typeKeywords are the keywords used to define each kind of type. Type extensions use the same
+// keywords, and their kinds have the same values as the kinds of type definitions.
+var typeKeywords = map[TypeDefinitionKind]string{
+	TypeDefinitionKindScalar:      "scalar",
+	TypeDefinitionKindObject:      "type",
+	TypeDefinitionKindInterface:   "interface",
+	TypeDefinitionKindUnion:       "union",
+	TypeDefinitionKindEnum:        "enum",
+	TypeDefinitionKindInputObject: "input",
+}
+
+// definitionKey returns the name used to match a definition with the same definition in another
+// document, which is also the first part of the paths of changes to it.
+func definitionKey(def Definition) string {
+	switch def.Kind {
+	case DefinitionKindExecutable:
+		switch def.ExecutableDefinition.Kind {
+		case ExecutableDefinitionKindOperation:
+			op := def.ExecutableDefinition.OperationDefinition
+			if op.Name == "" {
+				return op.Kind.String()
+			}
+
+			return op.Kind.String() + " " + op.Name
+		case ExecutableDefinitionKindFragment:
+			return "fragment " + def.ExecutableDefinition.FragmentDefinition.Name
+		}
+	case DefinitionKindTypeSystem:
+		switch def.TypeSystemDefinition.Kind {
+		case TypeSystemDefinitionKindSchema:
+			return "schema"
+		case TypeSystemDefinitionKindType:
+			td := def.TypeSystemDefinition.TypeDefinition
+			return typeKeywords[td.Kind] + " " + td.Name
+		case TypeSystemDefinitionKindDirective:
+			return "directive @" + def.TypeSystemDefinition.DirectiveDefinition.Name
+		}
+	case DefinitionKindTypeSystemExtension:
+		switch def.TypeSystemExtension.Kind {
+		case TypeSystemExtensionKindSchema:
+			return "extend schema"
+		case TypeSystemExtensionKindType:
+			te := def.TypeSystemExtension.TypeExtension
+			return "extend " + typeKeywords[TypeDefinitionKind(te.Kind)] + " " + te.Name
+		}
+	}
+
+	return "invalid"
+}
+
+// selectionKey returns the name used to match a selection with the same selection in another
+// selection set.
+func selectionKey(sel Selection) string {
+	switch sel.Kind {
+	case SelectionKindField:
+		if sel.Alias != "" {
+			return sel.Alias
+		}
+
+		return sel.Name
+	case SelectionKindFragmentSpread:
+		return "..." + sel.Name
+	case SelectionKindInlineFragment:
+		if sel.TypeCondition == nil {
+			return "..."
+		}
+
+		return "... on " + sel.TypeCondition.NamedType.NamedType
+	}
+
+	return "invalid"
+}
+
+// uniqueKeys suffixes any keys that appear more than once with their occurrence, e.g. for repeated
+// directives, or many extensions of the same type, so that they're matched in order.
+func uniqueKeys(keys []string) []string {
+	seen := make(map[string]int, len(keys))
+
+	for i, key := range keys {
+		seen[key]++
+
+		if n := seen[key]; n > 1 {
+			keys[i] = key + "#" + strconv.Itoa(n)
+		}
+	}
+
+	return keys
+}
+
+// appendPath returns a new path with the given part on the end of the given path, so that the paths
+// of different nodes never share memory.
+func appendPath(path []string, part string) []string {
+	newPath := make([]string, len(path), len(path)+1)
+	copy(newPath, path)
+
+	return append(newPath, part)
+}
+
+// sprintValue returns the given value printed as it would be in a document.
+func sprintValue(value Value) string {
+	buf := bytes.Buffer{}
+
+	d := dumper{w: &buf}
+	d.dumpValue(value)
+
+	return buf.String()
+}
+
+// sprintDirectiveLocations returns the names of the given directive locations, separated by pipes.
+func sprintDirectiveLocations(dls DirectiveLocation) string {
+	var locs []string
+	for i := 0; i < len(NamesByDirectiveLocations); i++ {
+		if bit := dls & (1 << uint(i)); bit != 0 {
+			locs = append(locs, NamesByDirectiveLocations[bit])
+		}
+	}
+
+	return strings.Join(locs, " | ")
+}
@@ -0,0 +1,177 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	parse := func(t *testing.T, query string) ast.Document {
+		doc, err := language.NewParser([]byte(query)).Parse()
+		require.NoError(t, err)
+		return doc
+	}
+
+	tests := []struct {
+		msg      string
+		a, b     string
+		expected []string
+	}{
+		{
+			msg:      "no changes",
+			a:        `query Foo($id: ID) { user(id: $id) { name } }`,
+			b:        `query Foo($id: ID) { user(id: $id) { name } }`,
+			expected: nil,
+		},
+		{
+			msg:      "reordered and reformatted nodes",
+			a:        `query Foo { a b } fragment Bar on Bar { c }`,
+			b:        "fragment Bar on Bar {\n  c\n}\n\nquery Foo {\n  b\n  a\n}",
+			expected: nil,
+		},
+		{
+			msg: "added and removed definitions",
+			a:   `query Foo { a } query Bar { b }`,
+			b:   `query Foo { a } mutation Bar { b } fragment Baz on Baz { c }`,
+			expected: []string{
+				"removed definition at query Bar",
+				"added definition at mutation Bar",
+				"added definition at fragment Baz",
+			},
+		},
+		{
+			msg: "changed selections",
+			a:   `{ user { id name friends { name } } ...Foo }`,
+			b:   `{ user { id email friends { name age } } ... on Bar { id } }`,
+			expected: []string{
+				"removed selection at query.user.name",
+				"added selection at query.user.friends.age",
+				"added selection at query.user.email",
+				"removed selection at query....Foo",
+				"added selection at query.... on Bar",
+			},
+		},
+		{
+			msg: "changed aliased fields",
+			a:   `{ user: me { id } }`,
+			b:   `{ user: viewer { id } }`,
+			expected: []string{
+				"modified name at query.user: me -> viewer",
+			},
+		},
+		{
+			msg: "changed arguments and values",
+			a:   `{ user(id: 1, filter: {name: "foo"}) { friends(first: 10) { id } } }`,
+			b:   `{ user(id: 2, filter: {name: "foo"}, limit: 3) { friends { id } } }`,
+			expected: []string{
+				"modified value at query.user(id:): 1 -> 2",
+				"added argument at query.user(limit:)",
+				"removed argument at query.user.friends(first:)",
+			},
+		},
+		{
+			msg: "changed directives",
+			a:   `{ a @include(if: $a) b @skip(if: true) @foo }`,
+			b:   `{ a @include(if: $b) b @skip(if: true) @bar }`,
+			expected: []string{
+				"modified value at query.a.@include(if:): $a -> $b",
+				"removed directive at query.b.@foo",
+				"added directive at query.b.@bar",
+			},
+		},
+		{
+			msg: "changed variable definitions",
+			a:   `query Foo($a: Int, $b: String = "b", $c: ID) { a }`,
+			b:   `query Foo($a: Int!, $b: String, $d: ID = 1) { a }`,
+			expected: []string{
+				"modified type at query Foo.$a: Int -> Int!",
+				`removed value at query Foo.$b: "b"`,
+				"removed variable definition at query Foo.$c",
+				"added variable definition at query Foo.$d",
+			},
+		},
+		{
+			msg: "changed type definitions",
+			a: `
+"A user."
+type User implements Node { id: ID! name(format: Boolean = false): String }
+enum Role { ADMIN USER }
+input Filter { name: String }
+union Result = User | Group`,
+			b: `
+"A person."
+type User implements Node & Entity { id: ID name(format: Boolean = true, upper: Boolean): String @deprecated }
+enum Role { ADMIN GUEST }
+input Filter { name: String, age: Int }
+union Result = User`,
+			expected: []string{
+				"modified description at type User: A user. -> A person.",
+				"added type at type User.Entity",
+				"modified type at type User.id: ID! -> ID",
+				"modified value at type User.name(format:): false -> true",
+				"added input value definition at type User.name(upper:)",
+				"added directive at type User.name.@deprecated",
+				"removed enum value definition at enum Role.USER",
+				"added enum value definition at enum Role.GUEST",
+				"added input value definition at input Filter.age",
+				"removed type at union Result.Group",
+			},
+		},
+		{
+			msg: "changed schema, extensions, and directive definitions",
+			a: `
+schema { query: Query mutation: Mutation }
+extend type Query { a: Int }
+extend type Query { b: Int }
+directive @foo on FIELD`,
+			b: `
+schema { query: RootQuery }
+extend type Query { a: Int }
+extend type Query { c: Int }
+directive @foo repeatable on FIELD | FRAGMENT_SPREAD`,
+			expected: []string{
+				"modified type at schema.query: Query -> RootQuery",
+				"removed operation type definition at schema.mutation",
+				"removed field definition at extend type Query#2.b",
+				"added field definition at extend type Query#2.c",
+				"modified repeatable at directive @foo: false -> true",
+				"modified directive locations at directive @foo: FIELD -> FIELD | FRAGMENT_SPREAD",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			var actual []string
+			for _, change := range ast.Diff(parse(t, test.a), parse(t, test.b)) {
+				actual = append(actual, change.String())
+			}
+
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	t.Run("should return structured changes", func(t *testing.T) {
+		changes := ast.Diff(parse(t, `{ user(id: 1) }`), parse(t, `{ user(id: 2) }`))
+
+		expected := []ast.Change{
+			{
+				Kind: ast.ChangeKindModified,
+				Node: ast.ChangeNodeKindValue,
+				Path: ast.PathNodesFromSlice([]ast.PathNode{
+					ast.NewStringPathNode("query"),
+					ast.NewStringPathNode("user"),
+					ast.NewStringPathNode("(id:)"),
+				}),
+				Before: "1",
+				After:  "2",
+			},
+		}
+
+		assert.Equal(t, expected, changes)
+	})
+}
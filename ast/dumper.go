@@ -12,7 +12,7 @@ import (
 
 // Fdump ...
 func Fdump(w io.Writer, doc Document) {
-	dmpr := dumper{defs: doc.Definitions.Len(), w: w}
+	dmpr := dumper{doc: doc, defs: doc.Definitions.Len(), w: w}
 	dmpr.dumpDefinitions(doc.Definitions)
 }
 
@@ -37,6 +37,7 @@ const indentation = "  "
 
 // dumper ...
 type dumper struct {
+	doc   Document
 	defs  int
 	depth int
 	w     io.Writer
@@ -58,6 +59,8 @@ func (d *dumper) dumpDefinitions(definitions *Definitions) {
 }
 
 func (d *dumper) dumpDefinition(definition Definition) {
+	d.dumpLeadingComments(definition.Comments, "")
+
 	switch definition.Kind {
 	case DefinitionKindExecutable:
 		d.dumpExecutableDefinition(definition.ExecutableDefinition)
@@ -66,6 +69,8 @@ func (d *dumper) dumpDefinition(definition Definition) {
 	case DefinitionKindTypeSystemExtension:
 		d.dumpTypeSystemExtension(definition.TypeSystemExtension)
 	}
+
+	d.dumpTrailingComments(definition.Comments, definition.Loc, "")
 }
 
 func (d *dumper) dumpExecutableDefinition(def *ExecutableDefinition) {
@@ -131,6 +136,9 @@ func (d *dumper) dumpSelections(selections *Selections) {
 func (d *dumper) dumpSelection(selection Selection) {
 	d.depth++
 
+	indent := strings.Repeat(indentation, d.depth)
+	d.dumpLeadingComments(selection.Comments, indent)
+
 	switch selection.Kind {
 	case SelectionKindField:
 		d.dumpFieldSelection(selection)
@@ -140,6 +148,8 @@ func (d *dumper) dumpSelection(selection Selection) {
 		d.dumpInlineFragment(selection)
 	}
 
+	d.dumpTrailingComments(selection.Comments, selection.Loc, indent)
+
 	d.depth--
 }
 
@@ -204,6 +214,8 @@ func (d *dumper) dumpFragmentSpread(selection Selection) {
 	io.WriteString(d.w, "...")
 	io.WriteString(d.w, selection.Name)
 
+	d.dumpArguments(selection.Arguments)
+
 	if selection.Directives != nil {
 		io.WriteString(d.w, " ")
 		d.dumpDirectives(selection.Directives)
@@ -239,6 +251,11 @@ func (d *dumper) dumpFragmentDefinition(def *FragmentDefinition) {
 	io.WriteString(d.w, " ")
 
 	io.WriteString(d.w, def.Name)
+
+	if def.VariableDefinitions != nil {
+		d.dumpVariableDefinitions(def.VariableDefinitions)
+	}
+
 	io.WriteString(d.w, " ")
 
 	io.WriteString(d.w, "on")
@@ -260,10 +277,14 @@ func (d *dumper) dumpValue(value Value) {
 	case ValueKindVariable:
 		io.WriteString(d.w, "$")
 		io.WriteString(d.w, value.StringValue)
-	case ValueKindInt:
-		io.WriteString(d.w, strconv.Itoa(value.IntValue))
-	case ValueKindFloat:
-		io.WriteString(d.w, fmt.Sprintf("%g", value.FloatValue))
+	case ValueKindInt, ValueKindFloat:
+		if value.RawValue != "" {
+			io.WriteString(d.w, value.RawValue)
+		} else if value.Kind == ValueKindInt {
+			io.WriteString(d.w, strconv.Itoa(value.IntValue))
+		} else {
+			io.WriteString(d.w, fmt.Sprintf("%g", value.FloatValue))
+		}
 	case ValueKindString:
 		hasLF := strings.Contains(value.StringValue, "\n")
 
@@ -618,13 +639,14 @@ func (d *dumper) dumpFieldsDefinition(fields *FieldDefinitions) {
 }
 
 func (d *dumper) dumpFieldDefinition(field FieldDefinition) {
+	d.dumpLeadingComments(field.Comments, indentation)
 	d.dumpDescription(field.Description)
 
 	io.WriteString(d.w, indentation)
 	io.WriteString(d.w, field.Name)
 
 	if field.ArgumentsDefinition != nil {
-		d.dumpArgumentsDefinition(field.ArgumentsDefinition)
+		d.dumpArgumentsDefinition(field.ArgumentsDefinition, indentation)
 	}
 
 	io.WriteString(d.w, ": ")
@@ -637,10 +659,38 @@ func (d *dumper) dumpFieldDefinition(field FieldDefinition) {
 		io.WriteString(d.w, " ")
 		d.dumpDirectives(field.Directives)
 	}
+
+	d.dumpTrailingComments(field.Comments, field.Loc, indentation)
 }
 
 // 3.6.1 Field Arguments
-func (d *dumper) dumpArgumentsDefinition(arguments *InputValueDefinitions) {
+func (d *dumper) dumpArgumentsDefinition(arguments *InputValueDefinitions, indent string) {
+	var hasComments bool
+	arguments.ForEach(func(ivd InputValueDefinition, _ int) {
+		hasComments = hasComments || ivd.Comments != nil
+	})
+
+	// Comments must be followed by a line break, so if there are any, each argument is placed on
+	// its own line.
+	if hasComments {
+		argIndent := indent + indentation
+
+		io.WriteString(d.w, "(\n")
+
+		arguments.ForEach(func(ivd InputValueDefinition, _ int) {
+			d.dumpLeadingComments(ivd.Comments, argIndent)
+			io.WriteString(d.w, argIndent)
+			d.dumpInputValueDefinition(ivd)
+			d.dumpTrailingComments(ivd.Comments, ivd.Loc, argIndent)
+			io.WriteString(d.w, "\n")
+		})
+
+		io.WriteString(d.w, indent)
+		io.WriteString(d.w, ")")
+
+		return
+	}
+
 	io.WriteString(d.w, "(")
 
 	arguments.ForEach(func(ivd InputValueDefinition, i int) {
@@ -703,6 +753,11 @@ func (d *dumper) dumpTypeDefinitionInterface(td *TypeDefinition) {
 	io.WriteString(d.w, "interface ")
 	io.WriteString(d.w, td.Name)
 
+	if td.ImplementsInterface != nil {
+		io.WriteString(d.w, " ")
+		d.dumpImplementsInterfaces(td.ImplementsInterface, td.FieldsDefinition != nil)
+	}
+
 	if td.Directives != nil {
 		io.WriteString(d.w, " ")
 		d.dumpDirectives(td.Directives)
@@ -719,6 +774,11 @@ func (d *dumper) dumpInterfaceTypeExtension(te *TypeExtension) {
 	io.WriteString(d.w, "extend interface ")
 	io.WriteString(d.w, te.Name)
 
+	if te.ImplementsInterface != nil {
+		io.WriteString(d.w, " ")
+		d.dumpImplementsInterfaces(te.ImplementsInterface, te.FieldsDefinition != nil)
+	}
+
 	if te.Directives != nil {
 		io.WriteString(d.w, " ")
 		d.dumpDirectives(te.Directives)
@@ -825,6 +885,7 @@ func (d *dumper) dumpEnumValuesDefinition(evds *EnumValueDefinitions) {
 }
 
 func (d *dumper) dumpEnumValueDefinition(evd EnumValueDefinition) {
+	d.dumpLeadingComments(evd.Comments, indentation)
 	d.dumpDescription(evd.Description)
 
 	io.WriteString(d.w, indentation)
@@ -834,6 +895,8 @@ func (d *dumper) dumpEnumValueDefinition(evd EnumValueDefinition) {
 		io.WriteString(d.w, " ")
 		d.dumpDirectives(evd.Directives)
 	}
+
+	d.dumpTrailingComments(evd.Comments, evd.Loc, indentation)
 }
 
 // 3.9.1 Enum Extensions
@@ -874,8 +937,10 @@ func (d *dumper) dumpInputFieldsDefinition(ivds *InputValueDefinitions) {
 	io.WriteString(d.w, "{\n")
 
 	ivds.ForEach(func(ivd InputValueDefinition, i int) {
+		d.dumpLeadingComments(ivd.Comments, indentation)
 		io.WriteString(d.w, indentation)
 		d.dumpInputValueDefinition(ivd)
+		d.dumpTrailingComments(ivd.Comments, ivd.Loc, indentation)
 		io.WriteString(d.w, "\n")
 	})
 
@@ -907,7 +972,11 @@ func (d *dumper) dumpDirectiveDefinition(def *DirectiveDefinition) {
 	io.WriteString(d.w, def.Name)
 
 	if def.ArgumentsDefinition != nil {
-		d.dumpArgumentsDefinition(def.ArgumentsDefinition)
+		d.dumpArgumentsDefinition(def.ArgumentsDefinition, "")
+	}
+
+	if def.Repeatable {
+		io.WriteString(d.w, " repeatable")
 	}
 
 	io.WriteString(d.w, " on")
@@ -959,6 +1028,49 @@ func (d *dumper) dumpDirectiveLocations(dls DirectiveLocation) {
  * Utility functions                                                         *
  *****************************************************************************/
 
+// dumpLeadingComments dumps the leading comments in the given list, each on their own line, with
+// the given indentation.
+func (d *dumper) dumpLeadingComments(comments *Comments, indent string) {
+	comments.ForEach(func(c Comment, _ int) {
+		if c.Kind != CommentKindLeading {
+			return
+		}
+
+		io.WriteString(d.w, indent)
+		io.WriteString(d.w, "#")
+		io.WriteString(d.w, c.Text)
+		io.WriteString(d.w, "\n")
+	})
+}
+
+// dumpTrailingComments dumps the trailing comments in the given list. A comment on the same line as
+// the end of the node it's attached to is kept on that line, others are placed on their own lines
+// after it, with the given indentation.
+func (d *dumper) dumpTrailingComments(comments *Comments, loc Location, indent string) {
+	if comments == nil {
+		return
+	}
+
+	// The line that the node ends on is the line of the position just past its end.
+	end := d.doc.Position(Location{Start: loc.End, End: loc.End}).Line
+
+	comments.ForEach(func(c Comment, _ int) {
+		if c.Kind != CommentKindTrailing {
+			return
+		}
+
+		if d.doc.Position(c.Loc).Line == end {
+			io.WriteString(d.w, " ")
+		} else {
+			io.WriteString(d.w, "\n")
+			io.WriteString(d.w, indent)
+		}
+
+		io.WriteString(d.w, "#")
+		io.WriteString(d.w, c.Text)
+	})
+}
+
 // escapeGraphQLString takes a single-line GraphQL string and escapes all special characters that
 // need to be escapes in it, returning the result.
 func escapeGraphQLString(in string) string {
@@ -228,6 +228,12 @@ interface ValuedEntity {
   value: Int
 }
 
+interface Resource implements NamedEntity & ValuedEntity @foo {
+  name: String
+  value: Int
+  url: String
+}
+
 union SmallestResult = DriftKing
 
 union SmallerResult = Donkey | Kong
@@ -251,6 +257,8 @@ input Point2D {
 
 directive @example on FIELD
 
+directive @example(arg: String) repeatable on FIELD | FRAGMENT_SPREAD
+
 directive @example on FIELD_DEFINITION | ARGUMENT_DEFINITION
 
 directive @example on
@@ -281,6 +289,10 @@ extend interface Transport {
   cost: Int
 }
 
+extend interface Resource implements Transport {
+  cost: Int
+}
+
 extend union SmallestResult = Nissan350z
 
 extend enum Direction {
@@ -294,6 +306,75 @@ extend input Point2D {
 `)
 )
 
+func TestSdump_Comments(t *testing.T) {
+	query := strings.TrimSpace(`
+# Owner: team-a
+type Query {
+  # TODO: remove.
+  foo(
+    # The bar.
+    bar: Int # Must be positive.
+    baz: String
+  ): String # Deprecated.
+  qux: Int
+  # Dangling.
+} # End of Query.
+
+enum Foo {
+  BAR # Bar.
+}
+
+input Baz {
+  # Qux.
+  qux: Int
+}
+
+query Hello {
+  # Hi.
+  hello # There.
+  world {
+    foo
+    # Bye.
+  }
+}
+# EOF.
+`)
+
+	psr := language.NewParserWithOptions([]byte(query), language.ParserOptions{
+		ParseComments: true,
+	})
+
+	doc, err := psr.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, query, ast.Sdump(doc))
+}
+
+func TestSdump_FragmentArguments(t *testing.T) {
+	query := strings.TrimSpace(`
+query User {
+  ...userFields(size: 20) @include(if: true)
+}
+
+fragment userFields($size: Int = 10) on User {
+  pic(size: $size)
+}
+`)
+
+	psr := language.NewParserWithOptions([]byte(query), language.ParserOptions{
+		ExperimentalFragmentArguments: true,
+	})
+
+	doc, err := psr.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, query, ast.Sdump(doc))
+}
+
 func TestSdump(t *testing.T) {
 	tt := []struct {
 		descr string
@@ -0,0 +1,368 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// EncodingVersion is the version of the binary encoding written by Encode. It changes whenever the
+// AST does, and Decode only accepts documents encoded with the same version, so cached documents
+// from older versions have to be parsed again.
+const EncodingVersion = 1
+
+// encodingMagic is written at the start of every encoded document.
+var encodingMagic = []byte("GQLD")
+
+// ErrEncodingVersion is returned by Decode when a document was encoded with a different version of
+// the binary encoding.
+var ErrEncodingVersion = errors.New("ast: unsupported encoding version")
+
+// Encode returns a compact binary encoding of the given document, which can be decoded with Decode
+// much faster than the original document can be parsed, e.g. to cache parsed persisted queries.
+// Every field of every node is encoded, including locations and comments. Strings are stored once,
+// in a table at the start of the encoding, no matter how many times they're used.
+//
+// The encoding starts with the magic bytes "GQLD", followed by the version as a uvarint, then the
+// number of strings in the table, the length of each string, and the bytes of all of the strings.
+// The document follows, with each field written in order, strings as indexes in the table, and all
+// other numbers as varints, other than floats, which are 8 bytes. Lists and slices start with their
+// length, and pointers with a boolean which is false if they're nil. The document's sources come
+// last.
+func Encode(doc Document) []byte {
+	e := encoder{
+		strings: make(map[string]uint64),
+	}
+
+	e.encodeDocument(&doc)
+	e.encodeSources(doc.Sources)
+
+	var header encoder
+	header.buf.Write(encodingMagic)
+	header.uint(EncodingVersion)
+	header.uint(uint64(len(e.table)))
+
+	for _, s := range e.table {
+		header.uint(uint64(len(s)))
+	}
+
+	for _, s := range e.table {
+		header.buf.WriteString(s)
+	}
+
+	out := make([]byte, 0, header.buf.Len()+e.buf.Len())
+	out = append(out, header.buf.Bytes()...)
+	out = append(out, e.buf.Bytes()...)
+
+	return out
+}
+
+// Decode decodes a document encoded by Encode. If the document was encoded with a different version
+// of the encoding, ErrEncodingVersion is returned.
+func Decode(data []byte) (Document, error) {
+	if !bytes.HasPrefix(data, encodingMagic) {
+		return Document{}, errors.New("ast: invalid encoding: missing magic bytes")
+	}
+
+	d := decoder{data: data, pos: len(encodingMagic)}
+
+	version := d.uint()
+	if d.err != nil {
+		return Document{}, d.err
+	}
+
+	if version != EncodingVersion {
+		return Document{}, ErrEncodingVersion
+	}
+
+	d.decodeTable()
+
+	var doc Document
+	d.decodeDocument(&doc)
+	doc.Sources = d.decodeSources()
+
+	if d.err == nil && d.pos != len(d.data) {
+		d.err = fmt.Errorf("ast: invalid encoding: %d unexpected trailing bytes", len(d.data)-d.pos)
+	}
+
+	if d.err != nil {
+		return Document{}, d.err
+	}
+
+	return doc, nil
+}
+
+// encodeSources writes the sources of a document, which aren't part of the generated encoding of
+// documents, as they're not AST nodes. Their bodies aren't kept, only the indexes of their lines and
+// wide characters, which is all that's needed to find positions in them.
+func (e *encoder) encodeSources(sources []*Source) {
+	e.uint(uint64(len(sources)))
+
+	for _, s := range sources {
+		s.index()
+
+		e.string(s.Name)
+		e.uint(uint64(s.Base))
+		e.uint(uint64(s.ColumnUnit))
+
+		// Offsets are in order, so each is written as the difference from the one before it.
+		var prev int
+
+		e.uint(uint64(len(s.lines)))
+		for _, line := range s.lines {
+			e.uint(uint64(line - prev))
+			prev = line
+		}
+
+		prev = 0
+
+		e.uint(uint64(len(s.wide)))
+		for _, r := range s.wide {
+			e.uint(uint64(r.offset - prev))
+			e.uint(uint64(r.skip))
+			prev = r.offset
+		}
+	}
+}
+
+// decodeSources reads the sources of a document.
+func (d *decoder) decodeSources() []*Source {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	sources := make([]*Source, n)
+	for i := range sources {
+		s := &Source{
+			Name:       d.string(),
+			Base:       int(d.uint()),
+			ColumnUnit: ColumnUnit(d.uint()),
+		}
+
+		var prev int
+
+		if n := d.length(); n > 0 {
+			s.lines = make([]int, n)
+			for j := range s.lines {
+				prev += int(d.uint())
+				s.lines[j] = prev
+			}
+		}
+
+		prev = 0
+
+		if n := d.length(); n > 0 {
+			s.wide = make([]sourceRune, n)
+			for j := range s.wide {
+				prev += int(d.uint())
+				s.wide[j] = sourceRune{offset: prev, skip: int(d.uint())}
+			}
+		}
+
+		sources[i] = s
+	}
+
+	return sources
+}
+
+// encoder writes the binary encoding of a document.
+type encoder struct {
+	buf     bytes.Buffer
+	scratch [binary.MaxVarintLen64]byte
+	strings map[string]uint64
+	table   []string
+}
+
+// uint writes an unsigned varint.
+func (e *encoder) uint(v uint64) {
+	n := binary.PutUvarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+// int writes a signed varint.
+func (e *encoder) int(v int64) {
+	n := binary.PutVarint(e.scratch[:], v)
+	e.buf.Write(e.scratch[:n])
+}
+
+// bool writes a boolean as a single byte.
+func (e *encoder) bool(v bool) {
+	if v {
+		e.buf.WriteByte(1)
+	} else {
+		e.buf.WriteByte(0)
+	}
+}
+
+// float writes the 8 bytes of a float.
+func (e *encoder) float(v float64) {
+	binary.LittleEndian.PutUint64(e.scratch[:8], math.Float64bits(v))
+	e.buf.Write(e.scratch[:8])
+}
+
+// string writes the index of a string in the string table, adding it to the table if it's not
+// already in it. The empty string is always index 0, and isn't stored in the table.
+func (e *encoder) string(s string) {
+	if s == "" {
+		e.uint(0)
+		return
+	}
+
+	idx, ok := e.strings[s]
+	if !ok {
+		e.table = append(e.table, s)
+		idx = uint64(len(e.table))
+		e.strings[s] = idx
+	}
+
+	e.uint(idx)
+}
+
+// decoder reads the binary encoding of a document. Once an error has occurred, it's recorded, and
+// every read after it returns a zero value, so that decoding can finish without checking for errors
+// after every read.
+type decoder struct {
+	data  []byte
+	pos   int
+	table []string
+	err   error
+}
+
+// fail records an error, if there isn't one already.
+func (d *decoder) fail(msg string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("ast: invalid encoding: %s at offset %d", msg, d.pos)
+	}
+}
+
+// uint reads an unsigned varint.
+func (d *decoder) uint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail("invalid uvarint")
+		return 0
+	}
+
+	d.pos += n
+
+	return v
+}
+
+// int reads a signed varint.
+func (d *decoder) int() int64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail("invalid varint")
+		return 0
+	}
+
+	d.pos += n
+
+	return v
+}
+
+// length reads the length of a list, slice, or string. Lengths longer than the remaining input are
+// invalid, as every item takes at least one byte, which stops corrupt input from causing huge
+// allocations.
+func (d *decoder) length() int {
+	n := d.uint()
+	if n > uint64(len(d.data)-d.pos) {
+		d.fail("length out of range")
+		return 0
+	}
+
+	return int(n)
+}
+
+// bool reads a boolean.
+func (d *decoder) bool() bool {
+	if d.err != nil {
+		return false
+	}
+
+	if d.pos >= len(d.data) {
+		d.fail("unexpected end of input")
+		return false
+	}
+
+	v := d.data[d.pos]
+	d.pos++
+
+	return v == 1
+}
+
+// float reads the 8 bytes of a float.
+func (d *decoder) float() float64 {
+	if d.err != nil {
+		return 0
+	}
+
+	if len(d.data)-d.pos < 8 {
+		d.fail("unexpected end of input")
+		return 0
+	}
+
+	v := binary.LittleEndian.Uint64(d.data[d.pos:])
+	d.pos += 8
+
+	return math.Float64frombits(v)
+}
+
+// string reads the index of a string in the string table, and returns that string.
+func (d *decoder) string() string {
+	idx := d.uint()
+	if idx == 0 {
+		return ""
+	}
+
+	if idx > uint64(len(d.table)) {
+		d.fail("string index out of range")
+		return ""
+	}
+
+	return d.table[idx-1]
+}
+
+// decodeTable reads the string table. All of the strings are copied out of the input at once, and
+// the strings in the table are slices of that one string.
+func (d *decoder) decodeTable() {
+	n := d.length()
+	lengths := make([]int, n)
+
+	var total int
+	for i := range lengths {
+		lengths[i] = d.length()
+		total += lengths[i]
+	}
+
+	if d.err != nil {
+		return
+	}
+
+	if total > len(d.data)-d.pos {
+		d.fail("string table out of range")
+		return
+	}
+
+	all := string(d.data[d.pos : d.pos+total])
+	d.pos += total
+
+	d.table = make([]string, n)
+
+	var start int
+	for i, l := range lengths {
+		d.table[i] = all[start : start+l]
+		start += l
+	}
+}
@@ -0,0 +1,214 @@
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var encodeQuery = []byte(`query Foo($a: [Int!]! = [1, 2], $b: Float = 1.5e3) @foo(b: {c: "d", e: [null, ENUM, true]}) {
+  # Comments are kept too.
+  alias: foo(a: $a) {
+    ... on Bar @include(if: false) {
+      bar
+    }
+    ... {
+      baz
+    }
+    ...Baz
+  }
+  user(id: "3931a3fc-d4f9-4faa-bcf5-882022617376") {
+    id
+    name
+    friends(first: 10) {
+      id
+      name
+    }
+  }
+}
+
+fragment Baz on Baz {
+  baz
+}`)
+
+var encodeTypeSystem = []byte(`schema @foo {
+  query: Query
+  mutation: Mutation
+}
+
+"""
+The query type.
+"""
+type Query implements Node & Entity @key(fields: "id") {
+  "The ID."
+  id: ID!
+  users(first: Int = 10, "Filters users." filter: Filter): [User!]! @deprecated
+}
+
+interface Node {
+  id: ID!
+}
+
+scalar Date @specifiedBy(url: "https://example.com")
+
+union Result = User | Group
+
+enum Role {
+  "Can do anything."
+  ADMIN
+  USER @deprecated(reason: "Use GUEST.")
+}
+
+input Filter {
+  name: String = "foo"
+}
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend schema @bar
+
+extend type Query {
+  me: User
+}
+
+extend enum Role {
+  GUEST
+}
+
+extend input Filter @foo`)
+
+func TestEncode(t *testing.T) {
+	t.Run("should round trip documents", func(t *testing.T) {
+		for _, query := range [][]byte{encodeQuery, encodeTypeSystem} {
+			doc, err := language.NewParserWithOptions(query, language.ParserOptions{ParseComments: true}).Parse()
+			require.NoError(t, err)
+
+			actual, err := ast.Decode(ast.Encode(doc))
+			require.NoError(t, err)
+
+			// Unlike JSON, every field is kept, including locations, sources, and definition counts.
+			assert.True(t, doc.Equal(actual))
+
+			// Positions can still be found, without the input.
+			doc.Definitions.ForEach(func(def ast.Definition, i int) {
+				assert.Equal(t, doc.Position(def.Loc), actual.Position(def.Loc))
+			})
+			assert.Equal(t, doc.OperationDefinitions, actual.OperationDefinitions)
+			assert.Equal(t, doc.FragmentDefinitions, actual.FragmentDefinitions)
+			assert.Equal(t, doc.TypeDefinitions, actual.TypeDefinitions)
+			assert.Equal(t, doc.TypeExtensions, actual.TypeExtensions)
+			assert.Equal(t, ast.Sdump(doc), ast.Sdump(actual))
+		}
+	})
+
+	t.Run("should round trip empty documents", func(t *testing.T) {
+		actual, err := ast.Decode(ast.Encode(ast.Document{}))
+		require.NoError(t, err)
+
+		assert.Equal(t, ast.Document{}, actual)
+	})
+
+	t.Run("should store repeated strings once", func(t *testing.T) {
+		name := "aVeryLongFieldNameThatShouldOnlyBeStoredOnce"
+
+		doc, err := language.NewParser([]byte(`{ ` + name + ` ` + name + ` ` + name + ` }`)).Parse()
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, bytes.Count(ast.Encode(doc), []byte(name)))
+	})
+}
+
+func TestDecode(t *testing.T) {
+	doc, err := language.NewParser(encodeQuery).Parse()
+	require.NoError(t, err)
+
+	data := ast.Encode(doc)
+
+	t.Run("should return an error for other versions", func(t *testing.T) {
+		other := append([]byte{}, data...)
+		other[4] = ast.EncodingVersion + 1
+
+		_, err := ast.Decode(other)
+		assert.Equal(t, ast.ErrEncodingVersion, err)
+	})
+
+	tests := []struct {
+		msg  string
+		data []byte
+	}{
+		{"empty input", nil},
+		{"missing magic bytes", []byte("{ foo }")},
+		{"missing version", data[:4]},
+		{"truncated string table", data[:8]},
+		{"truncated document", data[:len(data)-1]},
+		{"trailing bytes", append(append([]byte{}, data...), 0)},
+	}
+
+	for _, test := range tests {
+		t.Run("should return an error for "+test.msg, func(t *testing.T) {
+			_, err := ast.Decode(test.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	tt := []struct {
+		name  string
+		query []byte
+	}{
+		{name: "query", query: encodeQuery},
+		{name: "typeSystem", query: encodeTypeSystem},
+	}
+
+	for _, t := range tt {
+		doc, err := language.NewParser(t.query).Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		data := ast.Encode(doc)
+
+		b.Run(t.name, func(b *testing.B) {
+			b.Run("parse", func(b *testing.B) {
+				b.ReportAllocs()
+
+				for i := 0; i < b.N; i++ {
+					_, err := language.NewParser(t.query).Parse()
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			b.Run("decode", func(b *testing.B) {
+				b.ReportAllocs()
+
+				for i := 0; i < b.N; i++ {
+					_, err := ast.Decode(data)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	doc, err := language.NewParser(encodeQuery).Parse()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = ast.Encode(doc)
+	}
+}
@@ -0,0 +1,1481 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MarshalJSON encodes this document as JSON in the same shape as the AST produced by graphql-js,
+// e.g. {"kind":"Document","definitions":[...]}. Nodes with locations have a "loc" property holding
+// their start and end offsets. This AST records byte offsets, whereas graphql-js records character
+// offsets, so these only match for ASCII documents. Strings that contain new lines are encoded as
+// block strings, as they are when dumped.
+func (d Document) MarshalJSON() ([]byte, error) {
+	e := jsonEncoder{}
+
+	e.open("Document")
+	e.key("definitions")
+	e.buf.WriteByte('[')
+
+	d.Definitions.ForEach(func(def Definition, i int) {
+		e.sep(i)
+		e.encodeDefinition(def)
+	})
+
+	e.buf.WriteByte(']')
+	e.close(Location{})
+
+	return e.buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a document from JSON in the same shape as the AST produced by graphql-js,
+// as produced by MarshalJSON. The start and end offsets of nodes are decoded from "loc" properties,
+// but as graphql-js doesn't include lines and columns in them, those aren't set.
+func (d *Document) UnmarshalJSON(data []byte) error {
+	var n jsonNode
+
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return err
+	}
+
+	if n.Kind != "Document" {
+		return fmt.Errorf("ast: expected Document node, but got: %q", n.Kind)
+	}
+
+	var definitions *Definitions
+	for _, dn := range n.Definitions {
+		def, err := decodeDefinition(dn)
+		if err != nil {
+			return err
+		}
+
+		definitions = definitions.Add(def)
+	}
+
+	*d = Document{Definitions: definitions.Reverse()}
+	d.CountDefinitions()
+
+	return nil
+}
+
+/*****************************************************************************
+ * Encoding                                                                  *
+ *****************************************************************************/
+
+// jsonEncoder writes the nodes of an AST document as graphql-js JSON. Properties are written in the
+// same order as graphql-js, and properties that graphql-js leaves undefined are omitted.
+type jsonEncoder struct {
+	buf bytes.Buffer
+}
+
+// open starts a node of the given kind.
+func (e *jsonEncoder) open(kind string) {
+	e.buf.WriteString(`{"kind":`)
+	e.string(kind)
+}
+
+// close ends a node, adding its location if it has one.
+func (e *jsonEncoder) close(loc Location) {
+	if loc != (Location{}) {
+		fmt.Fprintf(&e.buf, `,"loc":{"start":%d,"end":%d}`, loc.Start, loc.End)
+	}
+
+	e.buf.WriteByte('}')
+}
+
+// key writes the key of the next property of a node.
+func (e *jsonEncoder) key(key string) {
+	e.buf.WriteString(`,"`)
+	e.buf.WriteString(key)
+	e.buf.WriteString(`":`)
+}
+
+// sep writes a separator before each item in an array, other than the first.
+func (e *jsonEncoder) sep(i int) {
+	if i > 0 {
+		e.buf.WriteByte(',')
+	}
+}
+
+// string writes a JSON string.
+func (e *jsonEncoder) string(s string) {
+	bs, _ := json.Marshal(s)
+	e.buf.Write(bs)
+}
+
+// name writes a Name node property with the given value.
+func (e *jsonEncoder) name(value string) {
+	e.key("name")
+	e.nameNode(value)
+}
+
+// nameNode writes a Name node.
+func (e *jsonEncoder) nameNode(value string) {
+	e.open("Name")
+	e.key("value")
+	e.string(value)
+	e.close(Location{})
+}
+
+// description writes a description property, if the given description isn't empty.
+func (e *jsonEncoder) description(description string) {
+	if description == "" {
+		return
+	}
+
+	e.key("description")
+	e.encodeString(description, Location{})
+}
+
+// 2.2 Document
+func (e *jsonEncoder) encodeDefinition(def Definition) {
+	switch def.Kind {
+	case DefinitionKindExecutable:
+		switch def.ExecutableDefinition.Kind {
+		case ExecutableDefinitionKindOperation:
+			e.encodeOperationDefinition(def.ExecutableDefinition.OperationDefinition, def.Loc)
+		case ExecutableDefinitionKindFragment:
+			e.encodeFragmentDefinition(def.ExecutableDefinition.FragmentDefinition, def.Loc)
+		}
+	case DefinitionKindTypeSystem:
+		switch def.TypeSystemDefinition.Kind {
+		case TypeSystemDefinitionKindSchema:
+			sd := def.TypeSystemDefinition.SchemaDefinition
+			e.encodeSchema("SchemaDefinition", sd.Directives, sd.OperationTypeDefinitions, def.Loc)
+		case TypeSystemDefinitionKindType:
+			e.encodeTypeDefinition(def.TypeSystemDefinition.TypeDefinition, def.Loc)
+		case TypeSystemDefinitionKindDirective:
+			e.encodeDirectiveDefinition(def.TypeSystemDefinition.DirectiveDefinition, def.Loc)
+		}
+	case DefinitionKindTypeSystemExtension:
+		switch def.TypeSystemExtension.Kind {
+		case TypeSystemExtensionKindSchema:
+			se := def.TypeSystemExtension.SchemaExtension
+			e.encodeSchema("SchemaExtension", se.Directives, se.OperationTypeDefinitions, def.Loc)
+		case TypeSystemExtensionKindType:
+			e.encodeTypeExtension(def.TypeSystemExtension.TypeExtension, def.Loc)
+		}
+	}
+}
+
+// 2.3 Operations
+func (e *jsonEncoder) encodeOperationDefinition(def *OperationDefinition, loc Location) {
+	e.open("OperationDefinition")
+	e.key("operation")
+	e.string(def.Kind.String())
+
+	if def.Name != "" {
+		e.name(def.Name)
+	}
+
+	e.key("variableDefinitions")
+	e.encodeVariableDefinitions(def.VariableDefinitions)
+	e.key("directives")
+	e.encodeDirectives(def.Directives)
+	e.key("selectionSet")
+	e.encodeSelectionSet(def.SelectionSet)
+	e.close(loc)
+}
+
+// 2.4 Selection Sets
+func (e *jsonEncoder) encodeSelectionSet(selections *Selections) {
+	e.open("SelectionSet")
+	e.key("selections")
+	e.buf.WriteByte('[')
+
+	selections.ForEach(func(selection Selection, i int) {
+		e.sep(i)
+		e.encodeSelection(selection)
+	})
+
+	e.buf.WriteByte(']')
+	e.close(Location{})
+}
+
+func (e *jsonEncoder) encodeSelection(selection Selection) {
+	switch selection.Kind {
+	case SelectionKindField:
+		e.open("Field")
+
+		if selection.Alias != "" {
+			e.key("alias")
+			e.nameNode(selection.Alias)
+		}
+
+		e.name(selection.Name)
+		e.key("arguments")
+		e.encodeArguments(selection.Arguments)
+		e.key("directives")
+		e.encodeDirectives(selection.Directives)
+
+		if selection.SelectionSet != nil {
+			e.key("selectionSet")
+			e.encodeSelectionSet(selection.SelectionSet)
+		}
+	case SelectionKindFragmentSpread:
+		e.open("FragmentSpread")
+		e.name(selection.Name)
+
+		// Only set when parsing with experimental fragment arguments enabled.
+		if selection.Arguments != nil {
+			e.key("arguments")
+			e.encodeArguments(selection.Arguments)
+		}
+
+		e.key("directives")
+		e.encodeDirectives(selection.Directives)
+	case SelectionKindInlineFragment:
+		e.open("InlineFragment")
+
+		if selection.TypeCondition != nil {
+			e.key("typeCondition")
+			e.encodeType(selection.TypeCondition.NamedType)
+		}
+
+		e.key("directives")
+		e.encodeDirectives(selection.Directives)
+		e.key("selectionSet")
+		e.encodeSelectionSet(selection.SelectionSet)
+	}
+
+	e.close(selection.Loc)
+}
+
+// 2.6 Arguments
+func (e *jsonEncoder) encodeArguments(arguments *Arguments) {
+	e.buf.WriteByte('[')
+
+	arguments.ForEach(func(argument Argument, i int) {
+		e.sep(i)
+		e.open("Argument")
+		e.name(argument.Name)
+		e.key("value")
+		e.encodeValue(argument.Value)
+		e.close(argument.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 2.8 Fragments
+func (e *jsonEncoder) encodeFragmentDefinition(def *FragmentDefinition, loc Location) {
+	e.open("FragmentDefinition")
+	e.name(def.Name)
+
+	// Only set when parsing with experimental fragment arguments enabled.
+	if def.VariableDefinitions != nil {
+		e.key("variableDefinitions")
+		e.encodeVariableDefinitions(def.VariableDefinitions)
+	}
+
+	e.key("typeCondition")
+	e.encodeType(def.TypeCondition.NamedType)
+	e.key("directives")
+	e.encodeDirectives(def.Directives)
+	e.key("selectionSet")
+	e.encodeSelectionSet(def.SelectionSet)
+	e.close(loc)
+}
+
+// 2.9 Input Values
+func (e *jsonEncoder) encodeValue(value Value) {
+	switch value.Kind {
+	case ValueKindVariable:
+		e.open("Variable")
+		e.name(value.StringValue)
+	case ValueKindInt:
+		e.open("IntValue")
+		e.key("value")
+
+		if value.RawValue != "" {
+			e.string(value.RawValue)
+		} else {
+			e.string(strconv.Itoa(value.IntValue))
+		}
+	case ValueKindFloat:
+		e.open("FloatValue")
+		e.key("value")
+
+		if value.RawValue != "" {
+			e.string(value.RawValue)
+		} else {
+			e.string(strconv.FormatFloat(value.FloatValue, 'g', -1, 64))
+		}
+	case ValueKindString:
+		e.encodeString(value.StringValue, value.Loc)
+		return
+	case ValueKindBoolean:
+		e.open("BooleanValue")
+		e.key("value")
+		e.buf.WriteString(strconv.FormatBool(value.BooleanValue))
+	case ValueKindNull:
+		e.open("NullValue")
+	case ValueKindEnum:
+		e.open("EnumValue")
+		e.key("value")
+		e.string(value.StringValue)
+	case ValueKindList:
+		e.open("ListValue")
+		e.key("values")
+		e.buf.WriteByte('[')
+
+		for i, v := range value.ListValue {
+			e.sep(i)
+			e.encodeValue(v)
+		}
+
+		e.buf.WriteByte(']')
+	case ValueKindObject:
+		e.open("ObjectValue")
+		e.key("fields")
+		e.buf.WriteByte('[')
+
+		for i, field := range value.ObjectValue {
+			e.sep(i)
+			e.open("ObjectField")
+			e.name(field.Name)
+			e.key("value")
+			e.encodeValue(field.Value)
+			e.close(field.Loc)
+		}
+
+		e.buf.WriteByte(']')
+	}
+
+	e.close(value.Loc)
+}
+
+// encodeString writes a StringValue node. Strings containing new lines are block strings.
+func (e *jsonEncoder) encodeString(value string, loc Location) {
+	e.open("StringValue")
+	e.key("value")
+	e.string(value)
+	e.key("block")
+	e.buf.WriteString(strconv.FormatBool(strings.Contains(value, "\n")))
+	e.close(loc)
+}
+
+// 2.10 Variables
+func (e *jsonEncoder) encodeVariableDefinitions(definitions *VariableDefinitions) {
+	e.buf.WriteByte('[')
+
+	definitions.ForEach(func(definition VariableDefinition, i int) {
+		e.sep(i)
+		e.open("VariableDefinition")
+		e.key("variable")
+		e.open("Variable")
+		e.name(definition.Name)
+		e.close(Location{})
+		e.key("type")
+		e.encodeType(definition.Type)
+
+		if definition.DefaultValue != nil {
+			e.key("defaultValue")
+			e.encodeValue(*definition.DefaultValue)
+		}
+
+		e.key("directives")
+		e.buf.WriteString("[]")
+		e.close(definition.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 2.11 Type References
+func (e *jsonEncoder) encodeType(t Type) {
+	if t.NonNullable {
+		e.open("NonNullType")
+		e.key("type")
+
+		t.NonNullable = false
+		e.encodeType(t)
+		e.close(Location{})
+		return
+	}
+
+	switch t.Kind {
+	case TypeKindNamed:
+		e.open("NamedType")
+		e.name(t.NamedType)
+	case TypeKindList:
+		e.open("ListType")
+		e.key("type")
+		e.encodeType(*t.ListType)
+	}
+
+	e.close(Location{})
+}
+
+// encodeTypes writes a list of named types, i.e. implemented interfaces, or union member types.
+func (e *jsonEncoder) encodeTypes(types *Types) {
+	e.buf.WriteByte('[')
+
+	types.ForEach(func(t Type, i int) {
+		e.sep(i)
+		e.encodeType(t)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 2.12 Directives
+func (e *jsonEncoder) encodeDirectives(directives *Directives) {
+	e.buf.WriteByte('[')
+
+	directives.ForEach(func(directive Directive, i int) {
+		e.sep(i)
+		e.open("Directive")
+		e.name(directive.Name)
+		e.key("arguments")
+		e.encodeArguments(directive.Arguments)
+		e.close(directive.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 3.2 Schema
+func (e *jsonEncoder) encodeSchema(kind string, directives *Directives, otds *OperationTypeDefinitions, loc Location) {
+	e.open(kind)
+	e.key("directives")
+	e.encodeDirectives(directives)
+	e.key("operationTypes")
+	e.buf.WriteByte('[')
+
+	otds.ForEach(func(otd OperationTypeDefinition, i int) {
+		e.sep(i)
+		e.open("OperationTypeDefinition")
+		e.key("operation")
+		e.string(otd.OperationType.String())
+		e.key("type")
+		e.encodeType(otd.NamedType)
+		e.close(Location{})
+	})
+
+	e.buf.WriteByte(']')
+	e.close(loc)
+}
+
+// 3.4 Types
+func (e *jsonEncoder) encodeTypeDefinition(def *TypeDefinition, loc Location) {
+	e.open(typeDefinitionJSONKinds[def.Kind] + "TypeDefinition")
+	e.description(def.Description)
+	e.name(def.Name)
+
+	e.encodeTypeMembers(
+		TypeExtensionKind(def.Kind),
+		def.ImplementsInterface,
+		def.Directives,
+		def.FieldsDefinition,
+		def.UnionMemberTypes,
+		def.EnumValuesDefinition,
+		def.InputFieldsDefinition,
+	)
+
+	e.close(loc)
+}
+
+// 3.4.3 Type Extensions
+func (e *jsonEncoder) encodeTypeExtension(ext *TypeExtension, loc Location) {
+	if loc == (Location{}) {
+		loc = ext.Loc
+	}
+
+	e.open(typeDefinitionJSONKinds[TypeDefinitionKind(ext.Kind)] + "TypeExtension")
+	e.name(ext.Name)
+
+	e.encodeTypeMembers(
+		ext.Kind,
+		ext.ImplementsInterface,
+		ext.Directives,
+		ext.FieldsDefinition,
+		ext.UnionMemberTypes,
+		ext.EnumValuesDefinition,
+		ext.InputFieldsDefinition,
+	)
+
+	e.close(loc)
+}
+
+// encodeTypeMembers writes the properties shared by type definitions and type extensions of the
+// given kind. The kinds of type extensions have the same values as the kinds of type definitions.
+func (e *jsonEncoder) encodeTypeMembers(
+	kind TypeExtensionKind,
+	interfaces *Types,
+	directives *Directives,
+	fields *FieldDefinitions,
+	types *Types,
+	values *EnumValueDefinitions,
+	inputFields *InputValueDefinitions,
+) {
+	if kind == TypeExtensionKindObject || kind == TypeExtensionKindInterface {
+		e.key("interfaces")
+		e.encodeTypes(interfaces)
+	}
+
+	e.key("directives")
+	e.encodeDirectives(directives)
+
+	switch kind {
+	case TypeExtensionKindObject, TypeExtensionKindInterface:
+		e.key("fields")
+		e.encodeFieldDefinitions(fields)
+	case TypeExtensionKindUnion:
+		e.key("types")
+		e.encodeTypes(types)
+	case TypeExtensionKindEnum:
+		e.key("values")
+		e.encodeEnumValueDefinitions(values)
+	case TypeExtensionKindInputObject:
+		e.key("fields")
+		e.encodeInputValueDefinitions(inputFields)
+	}
+}
+
+// 3.6 Objects
+func (e *jsonEncoder) encodeFieldDefinitions(definitions *FieldDefinitions) {
+	e.buf.WriteByte('[')
+
+	definitions.ForEach(func(definition FieldDefinition, i int) {
+		e.sep(i)
+		e.open("FieldDefinition")
+		e.description(definition.Description)
+		e.name(definition.Name)
+		e.key("arguments")
+		e.encodeInputValueDefinitions(definition.ArgumentsDefinition)
+		e.key("type")
+		e.encodeType(definition.Type)
+		e.key("directives")
+		e.encodeDirectives(definition.Directives)
+		e.close(definition.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+func (e *jsonEncoder) encodeInputValueDefinitions(definitions *InputValueDefinitions) {
+	e.buf.WriteByte('[')
+
+	definitions.ForEach(func(definition InputValueDefinition, i int) {
+		e.sep(i)
+		e.open("InputValueDefinition")
+		e.description(definition.Description)
+		e.name(definition.Name)
+		e.key("type")
+		e.encodeType(definition.Type)
+
+		if definition.DefaultValue != nil {
+			e.key("defaultValue")
+			e.encodeValue(*definition.DefaultValue)
+		}
+
+		e.key("directives")
+		e.encodeDirectives(definition.Directives)
+		e.close(definition.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 3.9 Enums
+func (e *jsonEncoder) encodeEnumValueDefinitions(definitions *EnumValueDefinitions) {
+	e.buf.WriteByte('[')
+
+	definitions.ForEach(func(definition EnumValueDefinition, i int) {
+		e.sep(i)
+		e.open("EnumValueDefinition")
+		e.description(definition.Description)
+		e.name(definition.EnumValue)
+		e.key("directives")
+		e.encodeDirectives(definition.Directives)
+		e.close(definition.Loc)
+	})
+
+	e.buf.WriteByte(']')
+}
+
+// 3.13 Directives
+func (e *jsonEncoder) encodeDirectiveDefinition(def *DirectiveDefinition, loc Location) {
+	e.open("DirectiveDefinition")
+	e.description(def.Description)
+	e.name(def.Name)
+	e.key("arguments")
+	e.encodeInputValueDefinitions(def.ArgumentsDefinition)
+	e.key("repeatable")
+	e.buf.WriteString(strconv.FormatBool(def.Repeatable))
+	e.key("locations")
+	e.buf.WriteByte('[')
+
+	var i int
+	for bit := DirectiveLocationKindQuery; bit <= DirectiveLocationKindInputFieldDefinition; bit <<= 1 {
+		if def.DirectiveLocations&bit == 0 {
+			continue
+		}
+
+		e.sep(i)
+		e.nameNode(NamesByDirectiveLocations[bit])
+		i++
+	}
+
+	e.buf.WriteByte(']')
+	e.close(loc)
+}
+
+// typeDefinitionJSONKinds are the prefixes of the graphql-js kinds of type definitions and type
+// extensions, e.g. "ObjectTypeDefinition", or "ObjectTypeExtension".
+var typeDefinitionJSONKinds = map[TypeDefinitionKind]string{
+	TypeDefinitionKindScalar:      "Scalar",
+	TypeDefinitionKindObject:      "Object",
+	TypeDefinitionKindInterface:   "Interface",
+	TypeDefinitionKindUnion:       "Union",
+	TypeDefinitionKindEnum:        "Enum",
+	TypeDefinitionKindInputObject: "InputObject",
+}
+
+/*****************************************************************************
+ * Decoding                                                                  *
+ *****************************************************************************/
+
+// jsonNode holds any graphql-js AST node. Each kind of node only uses some of these properties. The
+// "value" property is kept raw, as it's a string or boolean in scalar values, and a node elsewhere.
+type jsonNode struct {
+	Kind                string          `json:"kind"`
+	Loc                 *jsonLoc        `json:"loc"`
+	Operation           string          `json:"operation"`
+	Description         *jsonNode       `json:"description"`
+	Alias               *jsonNode       `json:"alias"`
+	Name                *jsonNode       `json:"name"`
+	Variable            *jsonNode       `json:"variable"`
+	Value               json.RawMessage `json:"value"`
+	Block               bool            `json:"block"`
+	Type                *jsonNode       `json:"type"`
+	TypeCondition       *jsonNode       `json:"typeCondition"`
+	DefaultValue        *jsonNode       `json:"defaultValue"`
+	SelectionSet        *jsonNode       `json:"selectionSet"`
+	Repeatable          bool            `json:"repeatable"`
+	Definitions         []jsonNode      `json:"definitions"`
+	VariableDefinitions []jsonNode      `json:"variableDefinitions"`
+	Selections          []jsonNode      `json:"selections"`
+	Arguments           []jsonNode      `json:"arguments"`
+	Directives          []jsonNode      `json:"directives"`
+	Interfaces          []jsonNode      `json:"interfaces"`
+	Fields              []jsonNode      `json:"fields"`
+	Types               []jsonNode      `json:"types"`
+	Values              []jsonNode      `json:"values"`
+	OperationTypes      []jsonNode      `json:"operationTypes"`
+	Locations           []jsonNode      `json:"locations"`
+}
+
+// jsonLoc is the location of a graphql-js AST node.
+type jsonLoc struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// location returns the location of this node, if it has one.
+func (n jsonNode) location() Location {
+	if n.Loc == nil {
+		return Location{}
+	}
+
+	return Location{Start: n.Loc.Start, End: n.Loc.End}
+}
+
+// name returns the value of the Name node of this node, which is empty if it doesn't have one.
+func (n jsonNode) name() string {
+	if n.Name == nil {
+		return ""
+	}
+
+	return n.Name.stringValue()
+}
+
+// stringValue returns the "value" property of this node as a string, or an empty string if it
+// isn't one.
+func (n jsonNode) stringValue() string {
+	var s string
+	_ = json.Unmarshal(n.Value, &s)
+	return s
+}
+
+// unknownKind returns an error for a node of a kind that isn't expected where it was found.
+func (n jsonNode) unknownKind(expected string) error {
+	return fmt.Errorf("ast: expected %s node, but got: %q", expected, n.Kind)
+}
+
+// 2.2 Document
+func decodeDefinition(n jsonNode) (Definition, error) {
+	def := Definition{Loc: n.location()}
+
+	switch {
+	case n.Kind == "OperationDefinition":
+		op, err := decodeOperationDefinition(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindExecutable
+		def.ExecutableDefinition = &ExecutableDefinition{
+			Kind:                ExecutableDefinitionKindOperation,
+			OperationDefinition: op,
+		}
+	case n.Kind == "FragmentDefinition":
+		fd, err := decodeFragmentDefinition(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindExecutable
+		def.ExecutableDefinition = &ExecutableDefinition{
+			Kind:               ExecutableDefinitionKindFragment,
+			FragmentDefinition: fd,
+		}
+	case n.Kind == "SchemaDefinition":
+		directives, otds, err := decodeSchema(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindTypeSystem
+		def.TypeSystemDefinition = &TypeSystemDefinition{
+			Kind: TypeSystemDefinitionKindSchema,
+			SchemaDefinition: &SchemaDefinition{
+				Directives:               directives,
+				OperationTypeDefinitions: otds,
+			},
+		}
+	case n.Kind == "SchemaExtension":
+		directives, otds, err := decodeSchema(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindTypeSystemExtension
+		def.TypeSystemExtension = &TypeSystemExtension{
+			Kind: TypeSystemExtensionKindSchema,
+			SchemaExtension: &SchemaExtension{
+				Directives:               directives,
+				OperationTypeDefinitions: otds,
+			},
+		}
+	case n.Kind == "DirectiveDefinition":
+		dd, err := decodeDirectiveDefinition(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindTypeSystem
+		def.TypeSystemDefinition = &TypeSystemDefinition{
+			Kind:                TypeSystemDefinitionKindDirective,
+			DirectiveDefinition: dd,
+		}
+	case strings.HasSuffix(n.Kind, "TypeDefinition"):
+		td, err := decodeTypeDefinition(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindTypeSystem
+		def.TypeSystemDefinition = &TypeSystemDefinition{
+			Kind:           TypeSystemDefinitionKindType,
+			TypeDefinition: td,
+		}
+	case strings.HasSuffix(n.Kind, "TypeExtension"):
+		te, err := decodeTypeExtension(n)
+		if err != nil {
+			return def, err
+		}
+
+		def.Kind = DefinitionKindTypeSystemExtension
+		def.TypeSystemExtension = &TypeSystemExtension{
+			Kind:          TypeSystemExtensionKindType,
+			TypeExtension: te,
+		}
+	default:
+		return def, n.unknownKind("definition")
+	}
+
+	return def, nil
+}
+
+// 2.3 Operations
+func decodeOperationDefinition(n jsonNode) (*OperationDefinition, error) {
+	op := &OperationDefinition{Name: n.name()}
+
+	switch n.Operation {
+	case "query":
+		op.Kind = OperationDefinitionKindQuery
+	case "mutation":
+		op.Kind = OperationDefinitionKindMutation
+	case "subscription":
+		op.Kind = OperationDefinitionKindSubscription
+	default:
+		return nil, fmt.Errorf("ast: unknown operation: %q", n.Operation)
+	}
+
+	var err error
+
+	op.VariableDefinitions, err = decodeVariableDefinitions(n.VariableDefinitions)
+	if err != nil {
+		return nil, err
+	}
+
+	op.Directives, err = decodeDirectives(n.Directives, operationDirectiveLocations[op.Kind])
+	if err != nil {
+		return nil, err
+	}
+
+	op.SelectionSet, err = decodeSelectionSet(n.SelectionSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// 2.4 Selection Sets
+func decodeSelectionSet(n *jsonNode) (*Selections, error) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if n.Kind != "SelectionSet" {
+		return nil, n.unknownKind("SelectionSet")
+	}
+
+	var selections *Selections
+	for _, sn := range n.Selections {
+		selection, err := decodeSelection(sn)
+		if err != nil {
+			return nil, err
+		}
+
+		selections = selections.Add(selection)
+	}
+
+	return selections.Reverse(), nil
+}
+
+func decodeSelection(n jsonNode) (Selection, error) {
+	selection := Selection{
+		Loc:  n.location(),
+		Name: n.name(),
+	}
+
+	var location DirectiveLocation
+
+	switch n.Kind {
+	case "Field":
+		selection.Kind = SelectionKindField
+		location = DirectiveLocationKindField
+
+		if n.Alias != nil {
+			selection.Alias = n.Alias.stringValue()
+		}
+	case "FragmentSpread":
+		selection.Kind = SelectionKindFragmentSpread
+		location = DirectiveLocationKindFragmentSpread
+	case "InlineFragment":
+		selection.Kind = SelectionKindInlineFragment
+		location = DirectiveLocationKindInlineFragment
+
+		if n.TypeCondition != nil {
+			t, err := decodeType(*n.TypeCondition)
+			if err != nil {
+				return selection, err
+			}
+
+			selection.TypeCondition = &TypeCondition{NamedType: t}
+		}
+	default:
+		return selection, n.unknownKind("selection")
+	}
+
+	var err error
+
+	selection.Arguments, err = decodeArguments(n.Arguments)
+	if err != nil {
+		return selection, err
+	}
+
+	selection.Directives, err = decodeDirectives(n.Directives, location)
+	if err != nil {
+		return selection, err
+	}
+
+	selection.SelectionSet, err = decodeSelectionSet(n.SelectionSet)
+	if err != nil {
+		return selection, err
+	}
+
+	return selection, nil
+}
+
+// 2.6 Arguments
+func decodeArguments(ns []jsonNode) (*Arguments, error) {
+	var arguments *Arguments
+	for _, n := range ns {
+		if n.Kind != "Argument" {
+			return nil, n.unknownKind("Argument")
+		}
+
+		value, err := decodeValueProperty(n)
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = arguments.Add(Argument{
+			Loc:   n.location(),
+			Name:  n.name(),
+			Value: value,
+		})
+	}
+
+	return arguments.Reverse(), nil
+}
+
+// 2.8 Fragments
+func decodeFragmentDefinition(n jsonNode) (*FragmentDefinition, error) {
+	fd := &FragmentDefinition{Name: n.name()}
+
+	if n.TypeCondition == nil {
+		return nil, fmt.Errorf("ast: missing type condition of fragment %q", fd.Name)
+	}
+
+	t, err := decodeType(*n.TypeCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	fd.TypeCondition = &TypeCondition{NamedType: t}
+
+	fd.VariableDefinitions, err = decodeVariableDefinitions(n.VariableDefinitions)
+	if err != nil {
+		return nil, err
+	}
+
+	fd.Directives, err = decodeDirectives(n.Directives, DirectiveLocationKindFragmentDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	fd.SelectionSet, err = decodeSelectionSet(n.SelectionSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+// 2.9 Input Values
+
+// decodeValueProperty decodes the "value" property of a node, which holds a value node.
+func decodeValueProperty(n jsonNode) (Value, error) {
+	var vn jsonNode
+
+	err := json.Unmarshal(n.Value, &vn)
+	if err != nil {
+		return Value{}, fmt.Errorf("ast: invalid value of %s %q: %v", n.Kind, n.name(), err)
+	}
+
+	return decodeValue(vn)
+}
+
+func decodeValue(n jsonNode) (Value, error) {
+	value := Value{Loc: n.location()}
+
+	var err error
+
+	switch n.Kind {
+	case "Variable":
+		value.Kind = ValueKindVariable
+		value.StringValue = n.name()
+	case "IntValue":
+		value.Kind = ValueKindInt
+		value.RawValue = n.stringValue()
+
+		value.IntValue, err = strconv.Atoi(value.RawValue)
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			// Values that are out of range are clamped, as they are by the parser.
+			err = nil
+		}
+	case "FloatValue":
+		value.Kind = ValueKindFloat
+		value.RawValue = n.stringValue()
+
+		value.FloatValue, err = strconv.ParseFloat(value.RawValue, 64)
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			err = nil
+		}
+	case "StringValue":
+		value.Kind = ValueKindString
+		value.StringValue = n.stringValue()
+	case "BooleanValue":
+		value.Kind = ValueKindBoolean
+		err = json.Unmarshal(n.Value, &value.BooleanValue)
+	case "NullValue":
+		value.Kind = ValueKindNull
+	case "EnumValue":
+		value.Kind = ValueKindEnum
+		value.StringValue = n.stringValue()
+	case "ListValue":
+		value.Kind = ValueKindList
+		value.ListValue = make([]Value, 0, len(n.Values))
+
+		for _, vn := range n.Values {
+			v, err := decodeValue(vn)
+			if err != nil {
+				return value, err
+			}
+
+			value.ListValue = append(value.ListValue, v)
+		}
+	case "ObjectValue":
+		value.Kind = ValueKindObject
+		value.ObjectValue = make([]ObjectField, 0, len(n.Fields))
+
+		for _, fn := range n.Fields {
+			if fn.Kind != "ObjectField" {
+				return value, fn.unknownKind("ObjectField")
+			}
+
+			v, err := decodeValueProperty(fn)
+			if err != nil {
+				return value, err
+			}
+
+			value.ObjectValue = append(value.ObjectValue, ObjectField{
+				Loc:   fn.location(),
+				Name:  fn.name(),
+				Value: v,
+			})
+		}
+	default:
+		return value, n.unknownKind("value")
+	}
+
+	if err != nil {
+		return value, fmt.Errorf("ast: invalid %s: %v", n.Kind, err)
+	}
+
+	return value, nil
+}
+
+// decodeOptionalValue decodes a value that may not be set, e.g. a default value.
+func decodeOptionalValue(n *jsonNode) (*Value, error) {
+	if n == nil {
+		return nil, nil
+	}
+
+	value, err := decodeValue(*n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// 2.10 Variables
+func decodeVariableDefinitions(ns []jsonNode) (*VariableDefinitions, error) {
+	var definitions *VariableDefinitions
+	for _, n := range ns {
+		if n.Kind != "VariableDefinition" {
+			return nil, n.unknownKind("VariableDefinition")
+		}
+
+		if n.Variable == nil || n.Type == nil {
+			return nil, fmt.Errorf("ast: missing variable or type of variable definition")
+		}
+
+		t, err := decodeType(*n.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		defaultValue, err := decodeOptionalValue(n.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = definitions.Add(VariableDefinition{
+			Loc:          n.location(),
+			Name:         n.Variable.name(),
+			Type:         t,
+			DefaultValue: defaultValue,
+		})
+	}
+
+	return definitions.Reverse(), nil
+}
+
+// 2.11 Type References
+func decodeType(n jsonNode) (Type, error) {
+	switch n.Kind {
+	case "NamedType":
+		return Type{Kind: TypeKindNamed, NamedType: n.name()}, nil
+	case "ListType", "NonNullType":
+		if n.Type == nil {
+			return Type{}, fmt.Errorf("ast: missing type of %s", n.Kind)
+		}
+
+		t, err := decodeType(*n.Type)
+		if err != nil {
+			return Type{}, err
+		}
+
+		if n.Kind == "NonNullType" {
+			t.NonNullable = true
+			return t, nil
+		}
+
+		return Type{Kind: TypeKindList, ListType: &t}, nil
+	}
+
+	return Type{}, n.unknownKind("type")
+}
+
+// decodeTypes decodes a list of named types, i.e. implemented interfaces, or union member types.
+func decodeTypes(ns []jsonNode) (*Types, error) {
+	var types *Types
+	for _, n := range ns {
+		t, err := decodeType(n)
+		if err != nil {
+			return nil, err
+		}
+
+		types = types.Add(t)
+	}
+
+	return types.Reverse(), nil
+}
+
+// 2.12 Directives
+func decodeDirectives(ns []jsonNode, location DirectiveLocation) (*Directives, error) {
+	var directives *Directives
+	for _, n := range ns {
+		if n.Kind != "Directive" {
+			return nil, n.unknownKind("Directive")
+		}
+
+		arguments, err := decodeArguments(n.Arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		directives = directives.Add(Directive{
+			Loc:       n.location(),
+			Name:      n.name(),
+			Arguments: arguments,
+			Location:  location,
+		})
+	}
+
+	return directives.Reverse(), nil
+}
+
+// 3.2 Schema
+func decodeSchema(n jsonNode) (*Directives, *OperationTypeDefinitions, error) {
+	directives, err := decodeDirectives(n.Directives, DirectiveLocationKindSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var otds *OperationTypeDefinitions
+	for _, otn := range n.OperationTypes {
+		otd := OperationTypeDefinition{}
+
+		switch otn.Operation {
+		case "query":
+			otd.OperationType = OperationDefinitionKindQuery
+		case "mutation":
+			otd.OperationType = OperationDefinitionKindMutation
+		case "subscription":
+			otd.OperationType = OperationDefinitionKindSubscription
+		default:
+			return nil, nil, fmt.Errorf("ast: unknown operation: %q", otn.Operation)
+		}
+
+		if otn.Type == nil {
+			return nil, nil, fmt.Errorf("ast: missing type of %s operation type", otn.Operation)
+		}
+
+		otd.NamedType, err = decodeType(*otn.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		otds = otds.Add(otd)
+	}
+
+	return directives, otds.Reverse(), nil
+}
+
+// 3.4 Types
+func decodeTypeDefinition(n jsonNode) (*TypeDefinition, error) {
+	kind, ok := decodeTypeKind(strings.TrimSuffix(n.Kind, "TypeDefinition"))
+	if !ok {
+		return nil, n.unknownKind("type definition")
+	}
+
+	td := &TypeDefinition{
+		Kind: kind,
+		Name: n.name(),
+	}
+
+	if n.Description != nil {
+		td.Description = n.Description.stringValue()
+	}
+
+	var err error
+
+	td.ImplementsInterface, td.Directives, td.FieldsDefinition, td.UnionMemberTypes, td.EnumValuesDefinition, td.InputFieldsDefinition, err = decodeTypeMembers(n, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return td, nil
+}
+
+// 3.4.3 Type Extensions
+func decodeTypeExtension(n jsonNode) (*TypeExtension, error) {
+	kind, ok := decodeTypeKind(strings.TrimSuffix(n.Kind, "TypeExtension"))
+	if !ok {
+		return nil, n.unknownKind("type extension")
+	}
+
+	te := &TypeExtension{
+		Loc:  n.location(),
+		Kind: TypeExtensionKind(kind),
+		Name: n.name(),
+	}
+
+	var err error
+
+	te.ImplementsInterface, te.Directives, te.FieldsDefinition, te.UnionMemberTypes, te.EnumValuesDefinition, te.InputFieldsDefinition, err = decodeTypeMembers(n, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return te, nil
+}
+
+// operationDirectiveLocations are the locations of directives on each kind of operation.
+var operationDirectiveLocations = map[OperationDefinitionKind]DirectiveLocation{
+	OperationDefinitionKindQuery:        DirectiveLocationKindQuery,
+	OperationDefinitionKindMutation:     DirectiveLocationKindMutation,
+	OperationDefinitionKindSubscription: DirectiveLocationKindSubscription,
+}
+
+// typeDirectiveLocations are the locations of directives on each kind of type.
+var typeDirectiveLocations = map[TypeDefinitionKind]DirectiveLocation{
+	TypeDefinitionKindScalar:      DirectiveLocationKindScalar,
+	TypeDefinitionKindObject:      DirectiveLocationKindObject,
+	TypeDefinitionKindInterface:   DirectiveLocationKindInterface,
+	TypeDefinitionKindUnion:       DirectiveLocationKindUnion,
+	TypeDefinitionKindEnum:        DirectiveLocationKindEnum,
+	TypeDefinitionKindInputObject: DirectiveLocationKindInputObject,
+}
+
+// decodeTypeKind returns the kind of type with the given graphql-js kind prefix.
+func decodeTypeKind(prefix string) (TypeDefinitionKind, bool) {
+	for kind, p := range typeDefinitionJSONKinds {
+		if p == prefix {
+			return kind, true
+		}
+	}
+
+	return 0, false
+}
+
+// decodeTypeMembers decodes the properties shared by type definitions and type extensions.
+func decodeTypeMembers(n jsonNode, kind TypeDefinitionKind) (
+	interfaces *Types,
+	directives *Directives,
+	fields *FieldDefinitions,
+	types *Types,
+	values *EnumValueDefinitions,
+	inputFields *InputValueDefinitions,
+	err error,
+) {
+	interfaces, err = decodeTypes(n.Interfaces)
+	if err != nil {
+		return
+	}
+
+	directives, err = decodeDirectives(n.Directives, typeDirectiveLocations[kind])
+	if err != nil {
+		return
+	}
+
+	types, err = decodeTypes(n.Types)
+	if err != nil {
+		return
+	}
+
+	values, err = decodeEnumValueDefinitions(n.Values)
+	if err != nil {
+		return
+	}
+
+	// The fields of input objects are input values.
+	if kind == TypeDefinitionKindInputObject {
+		inputFields, err = decodeInputValueDefinitions(n.Fields, DirectiveLocationKindInputFieldDefinition)
+	} else {
+		fields, err = decodeFieldDefinitions(n.Fields)
+	}
+
+	return
+}
+
+// 3.6 Objects
+func decodeFieldDefinitions(ns []jsonNode) (*FieldDefinitions, error) {
+	var definitions *FieldDefinitions
+	for _, n := range ns {
+		if n.Kind != "FieldDefinition" {
+			return nil, n.unknownKind("FieldDefinition")
+		}
+
+		if n.Type == nil {
+			return nil, fmt.Errorf("ast: missing type of field %q", n.name())
+		}
+
+		fd := FieldDefinition{
+			Loc:  n.location(),
+			Name: n.name(),
+		}
+
+		if n.Description != nil {
+			fd.Description = n.Description.stringValue()
+		}
+
+		var err error
+
+		fd.ArgumentsDefinition, err = decodeInputValueDefinitions(n.Arguments, DirectiveLocationKindArgumentDefinition)
+		if err != nil {
+			return nil, err
+		}
+
+		fd.Type, err = decodeType(*n.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		fd.Directives, err = decodeDirectives(n.Directives, DirectiveLocationKindFieldDefinition)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = definitions.Add(fd)
+	}
+
+	return definitions.Reverse(), nil
+}
+
+// decodeInputValueDefinitions decodes argument definitions, or input field definitions, depending on
+// the given location of their directives.
+func decodeInputValueDefinitions(ns []jsonNode, location DirectiveLocation) (*InputValueDefinitions, error) {
+	var definitions *InputValueDefinitions
+	for _, n := range ns {
+		if n.Kind != "InputValueDefinition" {
+			return nil, n.unknownKind("InputValueDefinition")
+		}
+
+		if n.Type == nil {
+			return nil, fmt.Errorf("ast: missing type of input value %q", n.name())
+		}
+
+		ivd := InputValueDefinition{
+			Loc:  n.location(),
+			Name: n.name(),
+		}
+
+		if n.Description != nil {
+			ivd.Description = n.Description.stringValue()
+		}
+
+		var err error
+
+		ivd.Type, err = decodeType(*n.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		ivd.DefaultValue, err = decodeOptionalValue(n.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+
+		ivd.Directives, err = decodeDirectives(n.Directives, location)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = definitions.Add(ivd)
+	}
+
+	return definitions.Reverse(), nil
+}
+
+// 3.9 Enums
+func decodeEnumValueDefinitions(ns []jsonNode) (*EnumValueDefinitions, error) {
+	var definitions *EnumValueDefinitions
+	for _, n := range ns {
+		if n.Kind != "EnumValueDefinition" {
+			return nil, n.unknownKind("EnumValueDefinition")
+		}
+
+		directives, err := decodeDirectives(n.Directives, DirectiveLocationKindEnumValue)
+		if err != nil {
+			return nil, err
+		}
+
+		evd := EnumValueDefinition{
+			Loc:        n.location(),
+			EnumValue:  n.name(),
+			Directives: directives,
+		}
+
+		if n.Description != nil {
+			evd.Description = n.Description.stringValue()
+		}
+
+		definitions = definitions.Add(evd)
+	}
+
+	return definitions.Reverse(), nil
+}
+
+// 3.13 Directives
+func decodeDirectiveDefinition(n jsonNode) (*DirectiveDefinition, error) {
+	dd := &DirectiveDefinition{
+		Name:       n.name(),
+		Repeatable: n.Repeatable,
+	}
+
+	if n.Description != nil {
+		dd.Description = n.Description.stringValue()
+	}
+
+	var err error
+
+	dd.ArgumentsDefinition, err = decodeInputValueDefinitions(n.Arguments, DirectiveLocationKindArgumentDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ln := range n.Locations {
+		loc, ok := DirectiveLocationsByName[ln.stringValue()]
+		if !ok {
+			return nil, fmt.Errorf("ast: unknown location %q of directive %q", ln.stringValue(), dd.Name)
+		}
+
+		dd.DirectiveLocations |= loc
+	}
+
+	return dd, nil
+}
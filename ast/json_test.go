@@ -0,0 +1,206 @@
+package ast_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_MarshalJSON(t *testing.T) {
+	doc, err := language.NewParser([]byte(`{ foo(a: 1) @bar }`)).Parse()
+	require.NoError(t, err)
+
+	bs, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	expected := `{
+  "kind": "Document",
+  "definitions": [{
+    "kind": "OperationDefinition",
+    "operation": "query",
+    "variableDefinitions": [],
+    "directives": [],
+    "selectionSet": {
+      "kind": "SelectionSet",
+      "selections": [{
+        "kind": "Field",
+        "name": {"kind": "Name", "value": "foo"},
+        "arguments": [{
+          "kind": "Argument",
+          "name": {"kind": "Name", "value": "a"},
+          "value": {"kind": "IntValue", "value": "1", "loc": {"start": 9, "end": 10}},
+          "loc": {"start": 6, "end": 10}
+        }],
+        "directives": [{
+          "kind": "Directive",
+          "name": {"kind": "Name", "value": "bar"},
+          "arguments": [],
+          "loc": {"start": 12, "end": 16}
+        }],
+        "loc": {"start": 2, "end": 16}
+      }]
+    },
+    "loc": {"start": 0, "end": 18}
+  }]
+}`
+
+	assert.JSONEq(t, expected, string(bs))
+}
+
+func TestDocument_UnmarshalJSON(t *testing.T) {
+	t.Run("should round trip documents", func(t *testing.T) {
+		query := `query Foo($a: [Int!]! = [1, 2], $b: Float = 1.5e3) @foo(b: {c: "d", e: [null, ENUM, true]}) {
+  alias: foo(a: $a) {
+    ... on Bar @include(if: false) {
+      bar
+    }
+    ... {
+      baz
+    }
+    ...Baz
+  }
+}
+
+fragment Baz on Baz {
+  baz
+}
+
+schema @foo {
+  query: Query
+  mutation: Mutation
+}
+
+"""
+The query type.
+"""
+type Query implements Node & Entity @key(fields: "id") {
+  "The ID."
+  id: ID!
+  users(first: Int = 10, "Filters users." filter: Filter): [User!]! @deprecated
+}
+
+interface Node {
+  id: ID!
+}
+
+scalar Date @specifiedBy(url: "https://example.com")
+
+union Result = User | Group
+
+enum Role {
+  "Can do anything."
+  ADMIN
+  USER @deprecated(reason: "Use GUEST.")
+}
+
+input Filter {
+  name: String = "foo"
+}
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend schema @bar
+
+extend type Query {
+  me: User
+}
+
+extend enum Role {
+  GUEST
+}
+
+extend input Filter @foo`
+
+		doc, err := language.NewParser([]byte(query)).Parse()
+		require.NoError(t, err)
+
+		bs, err := json.Marshal(doc)
+		require.NoError(t, err)
+
+		var actual ast.Document
+		require.NoError(t, json.Unmarshal(bs, &actual))
+
+		assert.True(t, doc.EqualIgnoringLocations(actual))
+		assert.Equal(t, ast.Sdump(doc), ast.Sdump(actual))
+
+		// Offsets are kept, but sources aren't part of graphql-js ASTs, so lines and columns are lost.
+		assert.Equal(t, doc.Definitions.Data.Loc.Start, actual.Definitions.Data.Loc.Start)
+		assert.Equal(t, doc.Definitions.Data.Loc.End, actual.Definitions.Data.Loc.End)
+		assert.Empty(t, actual.Sources)
+
+		// Definitions are counted, as they are by the parser.
+		assert.Equal(t, int32(1), actual.OperationDefinitions)
+		assert.Equal(t, int32(1), actual.FragmentDefinitions)
+		assert.Equal(t, int32(6), actual.TypeDefinitions)
+		assert.Equal(t, int32(3), actual.TypeExtensions)
+	})
+
+	t.Run("should decode graphql-js documents", func(t *testing.T) {
+		input := `{
+  "kind": "Document",
+  "definitions": [{
+    "kind": "OperationDefinition",
+    "operation": "mutation",
+    "name": {"kind": "Name", "value": "Foo", "loc": {"start": 9, "end": 12}},
+    "variableDefinitions": [],
+    "directives": [],
+    "selectionSet": {
+      "kind": "SelectionSet",
+      "selections": [{
+        "kind": "Field",
+        "alias": {"kind": "Name", "value": "a"},
+        "name": {"kind": "Name", "value": "foo"},
+        "arguments": [{
+          "kind": "Argument",
+          "name": {"kind": "Name", "value": "b"},
+          "value": {"kind": "ListValue", "values": [
+            {"kind": "FloatValue", "value": "1.5"},
+            {"kind": "StringValue", "value": "c", "block": false},
+            {"kind": "BooleanValue", "value": true}
+          ]}
+        }],
+        "directives": []
+      }]
+    },
+    "loc": {"start": 0, "end": 35}
+  }],
+  "loc": {"start": 0, "end": 35}
+}`
+
+		var actual ast.Document
+		require.NoError(t, json.Unmarshal([]byte(input), &actual))
+
+		expected, err := language.NewParser([]byte(`mutation Foo { a: foo(b: [1.5, "c", true]) }`)).Parse()
+		require.NoError(t, err)
+
+		assert.True(t, expected.EqualIgnoringLocations(actual))
+		assert.Equal(t, ast.Location{Start: 0, End: 35}, actual.Definitions.Data.Loc)
+	})
+
+	t.Run("should return errors for invalid documents", func(t *testing.T) {
+		tests := []struct {
+			msg   string
+			input string
+		}{
+			{"invalid JSON", `{"kind": `},
+			{"not a document", `{"kind": "Field"}`},
+			{"unknown definition", `{"kind": "Document", "definitions": [{"kind": "Foo"}]}`},
+			{"unknown operation", `{"kind": "Document", "definitions": [{"kind": "OperationDefinition", "operation": "foo"}]}`},
+			{"unknown value", `{"kind": "Document", "definitions": [{"kind": "OperationDefinition", "operation": "query", "selectionSet": {"kind": "SelectionSet", "selections": [{"kind": "Field", "name": {"kind": "Name", "value": "a"}, "arguments": [{"kind": "Argument", "value": {"kind": "Foo"}}]}]}}]}`},
+			{"invalid int", `{"kind": "Document", "definitions": [{"kind": "OperationDefinition", "operation": "query", "selectionSet": {"kind": "SelectionSet", "selections": [{"kind": "Field", "name": {"kind": "Name", "value": "a"}, "arguments": [{"kind": "Argument", "value": {"kind": "IntValue", "value": "a"}}]}]}}]}`},
+			{"missing type", `{"kind": "Document", "definitions": [{"kind": "ObjectTypeDefinition", "fields": [{"kind": "FieldDefinition"}]}]}`},
+			{"unknown directive location", `{"kind": "Document", "definitions": [{"kind": "DirectiveDefinition", "locations": [{"kind": "Name", "value": "FOO"}]}]}`},
+		}
+
+		for _, test := range tests {
+			t.Run(test.msg, func(t *testing.T) {
+				var doc ast.Document
+				assert.Error(t, json.Unmarshal([]byte(test.input), &doc))
+			})
+		}
+	})
+}
@@ -173,6 +173,177 @@ func ArgumentsFromSlice(sl []Argument) *Arguments {
 	return list.Reverse()
 }
 
+// Comments is a linked list that contains Comment values.
+type Comments struct {
+	Data Comment
+	next *Comments
+	pos  int
+}
+
+// Add appends a Comment to this linked list and returns this new head.
+func (cs *Comments) Add(data Comment) *Comments {
+	var pos int
+
+	if cs != nil {
+		pos = cs.pos + 1
+	}
+
+	return &Comments{
+		Data: data,
+		next: cs,
+		pos:  pos,
+	}
+}
+
+// ForEach applies the given map function to each item in this linked list.
+func (cs *Comments) ForEach(fn func(c Comment, i int)) {
+	if cs == nil {
+		return
+	}
+
+	iter := 0
+	current := cs
+
+	for {
+		fn(current.Data, iter)
+
+		if current.next == nil {
+			break
+		}
+
+		iter++
+		current = current.next
+	}
+}
+
+// CommentsGenerator is a type used to iterate efficiently over Comments.
+// @wg:ignore
+type CommentsGenerator struct {
+	original *Comments
+	current  *Comments
+	iter     int
+	length   int
+}
+
+// Next returns the current value, and it's index in the list, and sets up the next value to be
+// returned.
+func (g *CommentsGenerator) Next() (Comment, int) {
+	if g.current == nil {
+		return Comment{}, -1
+	}
+
+	retv := g.current.Data
+	reti := g.iter
+
+	g.current = g.current.next
+	g.iter++
+
+	return retv, reti
+}
+
+// Reset returns this generator to it's initial state, allowing it to be used again to iterate over
+// this linked list.
+func (g *CommentsGenerator) Reset() {
+	g.current = g.original
+	g.iter = 0
+}
+
+// Generator returns a "Generator" type for this list, allowing for much more efficient iteration
+// over items within this linked list than using ForEach, though ForEach may still be more
+// convenient, because ForEach is a high order function, it's slower.
+func (cs *Comments) Generator() CommentsGenerator {
+	return CommentsGenerator{
+		current: cs,
+		iter:    0,
+		length:  cs.Len(),
+	}
+}
+
+// Insert places the Comment in the position given by pos.
+// The method will insert at top if pos is greater than or equal to list length.
+// The method will insert at bottom if the pos is less than 0.
+func (cs *Comments) Insert(c Comment, pos int) *Comments {
+	if pos >= cs.Len() || cs == nil {
+		return cs.Add(c)
+	}
+
+	if pos < 0 {
+		pos = 0
+	}
+
+	mid := cs
+	for mid.pos != pos {
+		mid = mid.next
+	}
+
+	bot := mid.next
+	mid.next = nil
+	cs.pos -= mid.pos
+
+	bot = bot.Add(c)
+	cs.Join(bot)
+
+	return cs
+}
+
+// Join attaches the tail of the receiver list "cs" to the head of the otherList.
+func (cs *Comments) Join(otherList *Comments) {
+	if cs == nil {
+		return
+	}
+
+	pos := cs.Len() + otherList.Len() - 1
+
+	last := cs
+	for cs != nil {
+		cs.pos = pos
+		pos--
+		last = cs
+		cs = cs.next
+	}
+
+	last.next = otherList
+}
+
+// Len returns the length of this linked list.
+func (cs *Comments) Len() int {
+	if cs == nil {
+		return 0
+	}
+	return cs.pos + 1
+}
+
+// Reverse reverses this linked list of Comment. Usually when the linked list is being
+// constructed the result will be last-to-first, so we'll want to reverse it to get it in the
+// "right" order.
+func (cs *Comments) Reverse() *Comments {
+	current := cs
+
+	var prev *Comments
+	var pos int
+
+	for current != nil {
+		current.pos = pos
+		pos++
+
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+
+	return prev
+}
+
+// CommentsFromSlice returns a Comments list from a slice of Comment.
+func CommentsFromSlice(sl []Comment) *Comments {
+	var list *Comments
+	for _, v := range sl {
+		list = list.Add(v)
+	}
+	return list.Reverse()
+}
+
 // Definitions is a linked list that contains Definition values.
 type Definitions struct {
 	Data Definition
@@ -1028,36 +1199,36 @@ func InputValueDefinitionsFromSlice(sl []InputValueDefinition) *InputValueDefini
 	return list.Reverse()
 }
 
-// Locations is a linked list that contains Location values.
-type Locations struct {
-	Data Location
-	next *Locations
+// OperationTypeDefinitions is a linked list that contains OperationTypeDefinition values.
+type OperationTypeDefinitions struct {
+	Data OperationTypeDefinition
+	next *OperationTypeDefinitions
 	pos  int
 }
 
-// Add appends a Location to this linked list and returns this new head.
-func (ls *Locations) Add(data Location) *Locations {
+// Add appends a OperationTypeDefinition to this linked list and returns this new head.
+func (otds *OperationTypeDefinitions) Add(data OperationTypeDefinition) *OperationTypeDefinitions {
 	var pos int
 
-	if ls != nil {
-		pos = ls.pos + 1
+	if otds != nil {
+		pos = otds.pos + 1
 	}
 
-	return &Locations{
+	return &OperationTypeDefinitions{
 		Data: data,
-		next: ls,
+		next: otds,
 		pos:  pos,
 	}
 }
 
 // ForEach applies the given map function to each item in this linked list.
-func (ls *Locations) ForEach(fn func(l Location, i int)) {
-	if ls == nil {
+func (otds *OperationTypeDefinitions) ForEach(fn func(otd OperationTypeDefinition, i int)) {
+	if otds == nil {
 		return
 	}
 
 	iter := 0
-	current := ls
+	current := otds
 
 	for {
 		fn(current.Data, iter)
@@ -1071,20 +1242,20 @@ func (ls *Locations) ForEach(fn func(l Location, i int)) {
 	}
 }
 
-// LocationsGenerator is a type used to iterate efficiently over Locations.
+// OperationTypeDefinitionsGenerator is a type used to iterate efficiently over OperationTypeDefinitions.
 // @wg:ignore
-type LocationsGenerator struct {
-	original *Locations
-	current  *Locations
+type OperationTypeDefinitionsGenerator struct {
+	original *OperationTypeDefinitions
+	current  *OperationTypeDefinitions
 	iter     int
 	length   int
 }
 
 // Next returns the current value, and it's index in the list, and sets up the next value to be
 // returned.
-func (g *LocationsGenerator) Next() (Location, int) {
+func (g *OperationTypeDefinitionsGenerator) Next() (OperationTypeDefinition, int) {
 	if g.current == nil {
-		return Location{}, -1
+		return OperationTypeDefinition{}, -1
 	}
 
 	retv := g.current.Data
@@ -1098,7 +1269,7 @@ func (g *LocationsGenerator) Next() (Location, int) {
 
 // Reset returns this generator to it's initial state, allowing it to be used again to iterate over
 // this linked list.
-func (g *LocationsGenerator) Reset() {
+func (g *OperationTypeDefinitionsGenerator) Reset() {
 	g.current = g.original
 	g.iter = 0
 }
@@ -1106,75 +1277,75 @@ func (g *LocationsGenerator) Reset() {
 // Generator returns a "Generator" type for this list, allowing for much more efficient iteration
 // over items within this linked list than using ForEach, though ForEach may still be more
 // convenient, because ForEach is a high order function, it's slower.
-func (ls *Locations) Generator() LocationsGenerator {
-	return LocationsGenerator{
-		current: ls,
+func (otds *OperationTypeDefinitions) Generator() OperationTypeDefinitionsGenerator {
+	return OperationTypeDefinitionsGenerator{
+		current: otds,
 		iter:    0,
-		length:  ls.Len(),
+		length:  otds.Len(),
 	}
 }
 
-// Insert places the Location in the position given by pos.
+// Insert places the OperationTypeDefinition in the position given by pos.
 // The method will insert at top if pos is greater than or equal to list length.
 // The method will insert at bottom if the pos is less than 0.
-func (ls *Locations) Insert(l Location, pos int) *Locations {
-	if pos >= ls.Len() || ls == nil {
-		return ls.Add(l)
+func (otds *OperationTypeDefinitions) Insert(otd OperationTypeDefinition, pos int) *OperationTypeDefinitions {
+	if pos >= otds.Len() || otds == nil {
+		return otds.Add(otd)
 	}
 
 	if pos < 0 {
 		pos = 0
 	}
 
-	mid := ls
+	mid := otds
 	for mid.pos != pos {
 		mid = mid.next
 	}
 
 	bot := mid.next
 	mid.next = nil
-	ls.pos -= mid.pos
+	otds.pos -= mid.pos
 
-	bot = bot.Add(l)
-	ls.Join(bot)
+	bot = bot.Add(otd)
+	otds.Join(bot)
 
-	return ls
+	return otds
 }
 
-// Join attaches the tail of the receiver list "ls" to the head of the otherList.
-func (ls *Locations) Join(otherList *Locations) {
-	if ls == nil {
+// Join attaches the tail of the receiver list "otds" to the head of the otherList.
+func (otds *OperationTypeDefinitions) Join(otherList *OperationTypeDefinitions) {
+	if otds == nil {
 		return
 	}
 
-	pos := ls.Len() + otherList.Len() - 1
+	pos := otds.Len() + otherList.Len() - 1
 
-	last := ls
-	for ls != nil {
-		ls.pos = pos
+	last := otds
+	for otds != nil {
+		otds.pos = pos
 		pos--
-		last = ls
-		ls = ls.next
+		last = otds
+		otds = otds.next
 	}
 
 	last.next = otherList
 }
 
 // Len returns the length of this linked list.
-func (ls *Locations) Len() int {
-	if ls == nil {
+func (otds *OperationTypeDefinitions) Len() int {
+	if otds == nil {
 		return 0
 	}
-	return ls.pos + 1
+	return otds.pos + 1
 }
 
-// Reverse reverses this linked list of Location. Usually when the linked list is being
+// Reverse reverses this linked list of OperationTypeDefinition. Usually when the linked list is being
 // constructed the result will be last-to-first, so we'll want to reverse it to get it in the
 // "right" order.
-func (ls *Locations) Reverse() *Locations {
-	current := ls
+func (otds *OperationTypeDefinitions) Reverse() *OperationTypeDefinitions {
+	current := otds
 
-	var prev *Locations
+	var prev *OperationTypeDefinitions
 	var pos int
 
 	for current != nil {
@@ -1190,45 +1361,45 @@ func (ls *Locations) Reverse() *Locations {
 	return prev
 }
 
-// LocationsFromSlice returns a Locations list from a slice of Location.
-func LocationsFromSlice(sl []Location) *Locations {
-	var list *Locations
+// OperationTypeDefinitionsFromSlice returns a OperationTypeDefinitions list from a slice of OperationTypeDefinition.
+func OperationTypeDefinitionsFromSlice(sl []OperationTypeDefinition) *OperationTypeDefinitions {
+	var list *OperationTypeDefinitions
 	for _, v := range sl {
 		list = list.Add(v)
 	}
 	return list.Reverse()
 }
 
-// OperationTypeDefinitions is a linked list that contains OperationTypeDefinition values.
-type OperationTypeDefinitions struct {
-	Data OperationTypeDefinition
-	next *OperationTypeDefinitions
+// PathNodes is a linked list that contains PathNode values.
+type PathNodes struct {
+	Data PathNode
+	next *PathNodes
 	pos  int
 }
 
-// Add appends a OperationTypeDefinition to this linked list and returns this new head.
-func (otds *OperationTypeDefinitions) Add(data OperationTypeDefinition) *OperationTypeDefinitions {
+// Add appends a PathNode to this linked list and returns this new head.
+func (pns *PathNodes) Add(data PathNode) *PathNodes {
 	var pos int
 
-	if otds != nil {
-		pos = otds.pos + 1
+	if pns != nil {
+		pos = pns.pos + 1
 	}
 
-	return &OperationTypeDefinitions{
+	return &PathNodes{
 		Data: data,
-		next: otds,
+		next: pns,
 		pos:  pos,
 	}
 }
 
 // ForEach applies the given map function to each item in this linked list.
-func (otds *OperationTypeDefinitions) ForEach(fn func(otd OperationTypeDefinition, i int)) {
-	if otds == nil {
+func (pns *PathNodes) ForEach(fn func(pn PathNode, i int)) {
+	if pns == nil {
 		return
 	}
 
 	iter := 0
-	current := otds
+	current := pns
 
 	for {
 		fn(current.Data, iter)
@@ -1242,20 +1413,20 @@ func (otds *OperationTypeDefinitions) ForEach(fn func(otd OperationTypeDefinitio
 	}
 }
 
-// OperationTypeDefinitionsGenerator is a type used to iterate efficiently over OperationTypeDefinitions.
+// PathNodesGenerator is a type used to iterate efficiently over PathNodes.
 // @wg:ignore
-type OperationTypeDefinitionsGenerator struct {
-	original *OperationTypeDefinitions
-	current  *OperationTypeDefinitions
+type PathNodesGenerator struct {
+	original *PathNodes
+	current  *PathNodes
 	iter     int
 	length   int
 }
 
 // Next returns the current value, and it's index in the list, and sets up the next value to be
 // returned.
-func (g *OperationTypeDefinitionsGenerator) Next() (OperationTypeDefinition, int) {
+func (g *PathNodesGenerator) Next() (PathNode, int) {
 	if g.current == nil {
-		return OperationTypeDefinition{}, -1
+		return PathNode{}, -1
 	}
 
 	retv := g.current.Data
@@ -1269,7 +1440,7 @@ func (g *OperationTypeDefinitionsGenerator) Next() (OperationTypeDefinition, int
 
 // Reset returns this generator to it's initial state, allowing it to be used again to iterate over
 // this linked list.
-func (g *OperationTypeDefinitionsGenerator) Reset() {
+func (g *PathNodesGenerator) Reset() {
 	g.current = g.original
 	g.iter = 0
 }
@@ -1277,75 +1448,75 @@ func (g *OperationTypeDefinitionsGenerator) Reset() {
 // Generator returns a "Generator" type for this list, allowing for much more efficient iteration
 // over items within this linked list than using ForEach, though ForEach may still be more
 // convenient, because ForEach is a high order function, it's slower.
-func (otds *OperationTypeDefinitions) Generator() OperationTypeDefinitionsGenerator {
-	return OperationTypeDefinitionsGenerator{
-		current: otds,
+func (pns *PathNodes) Generator() PathNodesGenerator {
+	return PathNodesGenerator{
+		current: pns,
 		iter:    0,
-		length:  otds.Len(),
+		length:  pns.Len(),
 	}
 }
 
-// Insert places the OperationTypeDefinition in the position given by pos.
+// Insert places the PathNode in the position given by pos.
 // The method will insert at top if pos is greater than or equal to list length.
 // The method will insert at bottom if the pos is less than 0.
-func (otds *OperationTypeDefinitions) Insert(otd OperationTypeDefinition, pos int) *OperationTypeDefinitions {
-	if pos >= otds.Len() || otds == nil {
-		return otds.Add(otd)
+func (pns *PathNodes) Insert(pn PathNode, pos int) *PathNodes {
+	if pos >= pns.Len() || pns == nil {
+		return pns.Add(pn)
 	}
 
 	if pos < 0 {
 		pos = 0
 	}
 
-	mid := otds
+	mid := pns
 	for mid.pos != pos {
 		mid = mid.next
 	}
 
 	bot := mid.next
 	mid.next = nil
-	otds.pos -= mid.pos
+	pns.pos -= mid.pos
 
-	bot = bot.Add(otd)
-	otds.Join(bot)
+	bot = bot.Add(pn)
+	pns.Join(bot)
 
-	return otds
+	return pns
 }
 
-// Join attaches the tail of the receiver list "otds" to the head of the otherList.
-func (otds *OperationTypeDefinitions) Join(otherList *OperationTypeDefinitions) {
-	if otds == nil {
+// Join attaches the tail of the receiver list "pns" to the head of the otherList.
+func (pns *PathNodes) Join(otherList *PathNodes) {
+	if pns == nil {
 		return
 	}
 
-	pos := otds.Len() + otherList.Len() - 1
+	pos := pns.Len() + otherList.Len() - 1
 
-	last := otds
-	for otds != nil {
-		otds.pos = pos
+	last := pns
+	for pns != nil {
+		pns.pos = pos
 		pos--
-		last = otds
-		otds = otds.next
+		last = pns
+		pns = pns.next
 	}
 
 	last.next = otherList
 }
 
 // Len returns the length of this linked list.
-func (otds *OperationTypeDefinitions) Len() int {
-	if otds == nil {
+func (pns *PathNodes) Len() int {
+	if pns == nil {
 		return 0
 	}
-	return otds.pos + 1
+	return pns.pos + 1
 }
 
-// Reverse reverses this linked list of OperationTypeDefinition. Usually when the linked list is being
+// Reverse reverses this linked list of PathNode. Usually when the linked list is being
 // constructed the result will be last-to-first, so we'll want to reverse it to get it in the
 // "right" order.
-func (otds *OperationTypeDefinitions) Reverse() *OperationTypeDefinitions {
-	current := otds
+func (pns *PathNodes) Reverse() *PathNodes {
+	current := pns
 
-	var prev *OperationTypeDefinitions
+	var prev *PathNodes
 	var pos int
 
 	for current != nil {
@@ -1361,45 +1532,45 @@ func (otds *OperationTypeDefinitions) Reverse() *OperationTypeDefinitions {
 	return prev
 }
 
-// OperationTypeDefinitionsFromSlice returns a OperationTypeDefinitions list from a slice of OperationTypeDefinition.
-func OperationTypeDefinitionsFromSlice(sl []OperationTypeDefinition) *OperationTypeDefinitions {
-	var list *OperationTypeDefinitions
+// PathNodesFromSlice returns a PathNodes list from a slice of PathNode.
+func PathNodesFromSlice(sl []PathNode) *PathNodes {
+	var list *PathNodes
 	for _, v := range sl {
 		list = list.Add(v)
 	}
 	return list.Reverse()
 }
 
-// PathNodes is a linked list that contains PathNode values.
-type PathNodes struct {
-	Data PathNode
-	next *PathNodes
+// Positions is a linked list that contains Position values.
+type Positions struct {
+	Data Position
+	next *Positions
 	pos  int
 }
 
-// Add appends a PathNode to this linked list and returns this new head.
-func (pns *PathNodes) Add(data PathNode) *PathNodes {
+// Add appends a Position to this linked list and returns this new head.
+func (ps *Positions) Add(data Position) *Positions {
 	var pos int
 
-	if pns != nil {
-		pos = pns.pos + 1
+	if ps != nil {
+		pos = ps.pos + 1
 	}
 
-	return &PathNodes{
+	return &Positions{
 		Data: data,
-		next: pns,
+		next: ps,
 		pos:  pos,
 	}
 }
 
 // ForEach applies the given map function to each item in this linked list.
-func (pns *PathNodes) ForEach(fn func(pn PathNode, i int)) {
-	if pns == nil {
+func (ps *Positions) ForEach(fn func(p Position, i int)) {
+	if ps == nil {
 		return
 	}
 
 	iter := 0
-	current := pns
+	current := ps
 
 	for {
 		fn(current.Data, iter)
@@ -1413,20 +1584,20 @@ func (pns *PathNodes) ForEach(fn func(pn PathNode, i int)) {
 	}
 }
 
-// PathNodesGenerator is a type used to iterate efficiently over PathNodes.
+// PositionsGenerator is a type used to iterate efficiently over Positions.
 // @wg:ignore
-type PathNodesGenerator struct {
-	original *PathNodes
-	current  *PathNodes
+type PositionsGenerator struct {
+	original *Positions
+	current  *Positions
 	iter     int
 	length   int
 }
 
 // Next returns the current value, and it's index in the list, and sets up the next value to be
 // returned.
-func (g *PathNodesGenerator) Next() (PathNode, int) {
+func (g *PositionsGenerator) Next() (Position, int) {
 	if g.current == nil {
-		return PathNode{}, -1
+		return Position{}, -1
 	}
 
 	retv := g.current.Data
@@ -1440,7 +1611,7 @@ func (g *PathNodesGenerator) Next() (PathNode, int) {
 
 // Reset returns this generator to it's initial state, allowing it to be used again to iterate over
 // this linked list.
-func (g *PathNodesGenerator) Reset() {
+func (g *PositionsGenerator) Reset() {
 	g.current = g.original
 	g.iter = 0
 }
@@ -1448,75 +1619,75 @@ func (g *PathNodesGenerator) Reset() {
 // Generator returns a "Generator" type for this list, allowing for much more efficient iteration
 // over items within this linked list than using ForEach, though ForEach may still be more
 // convenient, because ForEach is a high order function, it's slower.
-func (pns *PathNodes) Generator() PathNodesGenerator {
-	return PathNodesGenerator{
-		current: pns,
+func (ps *Positions) Generator() PositionsGenerator {
+	return PositionsGenerator{
+		current: ps,
 		iter:    0,
-		length:  pns.Len(),
+		length:  ps.Len(),
 	}
 }
 
-// Insert places the PathNode in the position given by pos.
+// Insert places the Position in the position given by pos.
 // The method will insert at top if pos is greater than or equal to list length.
 // The method will insert at bottom if the pos is less than 0.
-func (pns *PathNodes) Insert(pn PathNode, pos int) *PathNodes {
-	if pos >= pns.Len() || pns == nil {
-		return pns.Add(pn)
+func (ps *Positions) Insert(p Position, pos int) *Positions {
+	if pos >= ps.Len() || ps == nil {
+		return ps.Add(p)
 	}
 
 	if pos < 0 {
 		pos = 0
 	}
 
-	mid := pns
+	mid := ps
 	for mid.pos != pos {
 		mid = mid.next
 	}
 
 	bot := mid.next
 	mid.next = nil
-	pns.pos -= mid.pos
+	ps.pos -= mid.pos
 
-	bot = bot.Add(pn)
-	pns.Join(bot)
+	bot = bot.Add(p)
+	ps.Join(bot)
 
-	return pns
+	return ps
 }
 
-// Join attaches the tail of the receiver list "pns" to the head of the otherList.
-func (pns *PathNodes) Join(otherList *PathNodes) {
-	if pns == nil {
+// Join attaches the tail of the receiver list "ps" to the head of the otherList.
+func (ps *Positions) Join(otherList *Positions) {
+	if ps == nil {
 		return
 	}
 
-	pos := pns.Len() + otherList.Len() - 1
+	pos := ps.Len() + otherList.Len() - 1
 
-	last := pns
-	for pns != nil {
-		pns.pos = pos
+	last := ps
+	for ps != nil {
+		ps.pos = pos
 		pos--
-		last = pns
-		pns = pns.next
+		last = ps
+		ps = ps.next
 	}
 
 	last.next = otherList
 }
 
 // Len returns the length of this linked list.
-func (pns *PathNodes) Len() int {
-	if pns == nil {
+func (ps *Positions) Len() int {
+	if ps == nil {
 		return 0
 	}
-	return pns.pos + 1
+	return ps.pos + 1
 }
 
-// Reverse reverses this linked list of PathNode. Usually when the linked list is being
+// Reverse reverses this linked list of Position. Usually when the linked list is being
 // constructed the result will be last-to-first, so we'll want to reverse it to get it in the
 // "right" order.
-func (pns *PathNodes) Reverse() *PathNodes {
-	current := pns
+func (ps *Positions) Reverse() *Positions {
+	current := ps
 
-	var prev *PathNodes
+	var prev *Positions
 	var pos int
 
 	for current != nil {
@@ -1532,9 +1703,9 @@ func (pns *PathNodes) Reverse() *PathNodes {
 	return prev
 }
 
-// PathNodesFromSlice returns a PathNodes list from a slice of PathNode.
-func PathNodesFromSlice(sl []PathNode) *PathNodes {
-	var list *PathNodes
+// PositionsFromSlice returns a Positions list from a slice of Position.
+func PositionsFromSlice(sl []Position) *Positions {
+	var list *Positions
 	for _, v := range sl {
 		list = list.Add(v)
 	}
@@ -0,0 +1,3412 @@
+// Code generated by tools/walkergen
+// DO NOT EDIT!
+package ast
+
+import "fmt"
+
+// Rewriter holds handlers for entering and leaving AST nodes, which may modify those nodes. Unlike
+// the handlers of a walker, which receive copies of nodes, rewrite handlers receive pointers to the
+// nodes themselves, so any changes they make are made to the AST in place.
+//
+// Nodes in lists, e.g. Selections, or Arguments, may also be deleted, or have nodes inserted before
+// or after them, using the Cursor given to their handlers. The zero value is ready to use.
+type Rewriter struct {
+	argumentEnter                        []ArgumentRewriteHandler
+	argumentLeave                        []ArgumentRewriteHandler
+	argumentsEnter                       []ArgumentsRewriteHandler
+	argumentsLeave                       []ArgumentsRewriteHandler
+	booleanValueEnter                    []BooleanValueRewriteHandler
+	booleanValueLeave                    []BooleanValueRewriteHandler
+	commentEnter                         []CommentRewriteHandler
+	commentLeave                         []CommentRewriteHandler
+	commentsEnter                        []CommentsRewriteHandler
+	commentsLeave                        []CommentsRewriteHandler
+	definitionEnter                      []DefinitionRewriteHandler
+	definitionLeave                      []DefinitionRewriteHandler
+	definitionsEnter                     []DefinitionsRewriteHandler
+	definitionsLeave                     []DefinitionsRewriteHandler
+	directiveEnter                       []DirectiveRewriteHandler
+	directiveLeave                       []DirectiveRewriteHandler
+	directiveDefinitionEnter             []DirectiveDefinitionRewriteHandler
+	directiveDefinitionLeave             []DirectiveDefinitionRewriteHandler
+	directivesEnter                      []DirectivesRewriteHandler
+	directivesLeave                      []DirectivesRewriteHandler
+	documentEnter                        []DocumentRewriteHandler
+	documentLeave                        []DocumentRewriteHandler
+	enumTypeDefinitionEnter              []EnumTypeDefinitionRewriteHandler
+	enumTypeDefinitionLeave              []EnumTypeDefinitionRewriteHandler
+	enumTypeExtensionEnter               []EnumTypeExtensionRewriteHandler
+	enumTypeExtensionLeave               []EnumTypeExtensionRewriteHandler
+	enumValueEnter                       []EnumValueRewriteHandler
+	enumValueLeave                       []EnumValueRewriteHandler
+	enumValueDefinitionEnter             []EnumValueDefinitionRewriteHandler
+	enumValueDefinitionLeave             []EnumValueDefinitionRewriteHandler
+	enumValueDefinitionsEnter            []EnumValueDefinitionsRewriteHandler
+	enumValueDefinitionsLeave            []EnumValueDefinitionsRewriteHandler
+	executableDefinitionEnter            []ExecutableDefinitionRewriteHandler
+	executableDefinitionLeave            []ExecutableDefinitionRewriteHandler
+	fieldDefinitionEnter                 []FieldDefinitionRewriteHandler
+	fieldDefinitionLeave                 []FieldDefinitionRewriteHandler
+	fieldDefinitionsEnter                []FieldDefinitionsRewriteHandler
+	fieldDefinitionsLeave                []FieldDefinitionsRewriteHandler
+	fieldSelectionEnter                  []FieldSelectionRewriteHandler
+	fieldSelectionLeave                  []FieldSelectionRewriteHandler
+	floatValueEnter                      []FloatValueRewriteHandler
+	floatValueLeave                      []FloatValueRewriteHandler
+	fragmentDefinitionEnter              []FragmentDefinitionRewriteHandler
+	fragmentDefinitionLeave              []FragmentDefinitionRewriteHandler
+	fragmentSpreadSelectionEnter         []FragmentSpreadSelectionRewriteHandler
+	fragmentSpreadSelectionLeave         []FragmentSpreadSelectionRewriteHandler
+	inlineFragmentSelectionEnter         []InlineFragmentSelectionRewriteHandler
+	inlineFragmentSelectionLeave         []InlineFragmentSelectionRewriteHandler
+	inputObjectTypeDefinitionEnter       []InputObjectTypeDefinitionRewriteHandler
+	inputObjectTypeDefinitionLeave       []InputObjectTypeDefinitionRewriteHandler
+	inputObjectTypeExtensionEnter        []InputObjectTypeExtensionRewriteHandler
+	inputObjectTypeExtensionLeave        []InputObjectTypeExtensionRewriteHandler
+	inputValueDefinitionEnter            []InputValueDefinitionRewriteHandler
+	inputValueDefinitionLeave            []InputValueDefinitionRewriteHandler
+	inputValueDefinitionsEnter           []InputValueDefinitionsRewriteHandler
+	inputValueDefinitionsLeave           []InputValueDefinitionsRewriteHandler
+	intPathNodeEnter                     []IntPathNodeRewriteHandler
+	intPathNodeLeave                     []IntPathNodeRewriteHandler
+	intValueEnter                        []IntValueRewriteHandler
+	intValueLeave                        []IntValueRewriteHandler
+	interfaceTypeDefinitionEnter         []InterfaceTypeDefinitionRewriteHandler
+	interfaceTypeDefinitionLeave         []InterfaceTypeDefinitionRewriteHandler
+	interfaceTypeExtensionEnter          []InterfaceTypeExtensionRewriteHandler
+	interfaceTypeExtensionLeave          []InterfaceTypeExtensionRewriteHandler
+	listTypeEnter                        []ListTypeRewriteHandler
+	listTypeLeave                        []ListTypeRewriteHandler
+	listValueEnter                       []ListValueRewriteHandler
+	listValueLeave                       []ListValueRewriteHandler
+	locationEnter                        []LocationRewriteHandler
+	locationLeave                        []LocationRewriteHandler
+	mutationOperationDefinitionEnter     []MutationOperationDefinitionRewriteHandler
+	mutationOperationDefinitionLeave     []MutationOperationDefinitionRewriteHandler
+	namedTypeEnter                       []NamedTypeRewriteHandler
+	namedTypeLeave                       []NamedTypeRewriteHandler
+	nullValueEnter                       []NullValueRewriteHandler
+	nullValueLeave                       []NullValueRewriteHandler
+	objectFieldEnter                     []ObjectFieldRewriteHandler
+	objectFieldLeave                     []ObjectFieldRewriteHandler
+	objectTypeDefinitionEnter            []ObjectTypeDefinitionRewriteHandler
+	objectTypeDefinitionLeave            []ObjectTypeDefinitionRewriteHandler
+	objectTypeExtensionEnter             []ObjectTypeExtensionRewriteHandler
+	objectTypeExtensionLeave             []ObjectTypeExtensionRewriteHandler
+	objectValueEnter                     []ObjectValueRewriteHandler
+	objectValueLeave                     []ObjectValueRewriteHandler
+	operationDefinitionEnter             []OperationDefinitionRewriteHandler
+	operationDefinitionLeave             []OperationDefinitionRewriteHandler
+	operationTypeDefinitionEnter         []OperationTypeDefinitionRewriteHandler
+	operationTypeDefinitionLeave         []OperationTypeDefinitionRewriteHandler
+	operationTypeDefinitionsEnter        []OperationTypeDefinitionsRewriteHandler
+	operationTypeDefinitionsLeave        []OperationTypeDefinitionsRewriteHandler
+	pathNodeEnter                        []PathNodeRewriteHandler
+	pathNodeLeave                        []PathNodeRewriteHandler
+	pathNodesEnter                       []PathNodesRewriteHandler
+	pathNodesLeave                       []PathNodesRewriteHandler
+	positionEnter                        []PositionRewriteHandler
+	positionLeave                        []PositionRewriteHandler
+	positionsEnter                       []PositionsRewriteHandler
+	positionsLeave                       []PositionsRewriteHandler
+	queryOperationDefinitionEnter        []QueryOperationDefinitionRewriteHandler
+	queryOperationDefinitionLeave        []QueryOperationDefinitionRewriteHandler
+	scalarTypeDefinitionEnter            []ScalarTypeDefinitionRewriteHandler
+	scalarTypeDefinitionLeave            []ScalarTypeDefinitionRewriteHandler
+	scalarTypeExtensionEnter             []ScalarTypeExtensionRewriteHandler
+	scalarTypeExtensionLeave             []ScalarTypeExtensionRewriteHandler
+	schemaDefinitionEnter                []SchemaDefinitionRewriteHandler
+	schemaDefinitionLeave                []SchemaDefinitionRewriteHandler
+	schemaExtensionEnter                 []SchemaExtensionRewriteHandler
+	schemaExtensionLeave                 []SchemaExtensionRewriteHandler
+	selectionEnter                       []SelectionRewriteHandler
+	selectionLeave                       []SelectionRewriteHandler
+	selectionsEnter                      []SelectionsRewriteHandler
+	selectionsLeave                      []SelectionsRewriteHandler
+	stringPathNodeEnter                  []StringPathNodeRewriteHandler
+	stringPathNodeLeave                  []StringPathNodeRewriteHandler
+	stringValueEnter                     []StringValueRewriteHandler
+	stringValueLeave                     []StringValueRewriteHandler
+	subscriptionOperationDefinitionEnter []SubscriptionOperationDefinitionRewriteHandler
+	subscriptionOperationDefinitionLeave []SubscriptionOperationDefinitionRewriteHandler
+	typeEnter                            []TypeRewriteHandler
+	typeLeave                            []TypeRewriteHandler
+	typeConditionEnter                   []TypeConditionRewriteHandler
+	typeConditionLeave                   []TypeConditionRewriteHandler
+	typeDefinitionEnter                  []TypeDefinitionRewriteHandler
+	typeDefinitionLeave                  []TypeDefinitionRewriteHandler
+	typeExtensionEnter                   []TypeExtensionRewriteHandler
+	typeExtensionLeave                   []TypeExtensionRewriteHandler
+	typeSystemDefinitionEnter            []TypeSystemDefinitionRewriteHandler
+	typeSystemDefinitionLeave            []TypeSystemDefinitionRewriteHandler
+	typeSystemExtensionEnter             []TypeSystemExtensionRewriteHandler
+	typeSystemExtensionLeave             []TypeSystemExtensionRewriteHandler
+	typesEnter                           []TypesRewriteHandler
+	typesLeave                           []TypesRewriteHandler
+	unionTypeDefinitionEnter             []UnionTypeDefinitionRewriteHandler
+	unionTypeDefinitionLeave             []UnionTypeDefinitionRewriteHandler
+	unionTypeExtensionEnter              []UnionTypeExtensionRewriteHandler
+	unionTypeExtensionLeave              []UnionTypeExtensionRewriteHandler
+	valueEnter                           []ValueRewriteHandler
+	valueLeave                           []ValueRewriteHandler
+	variableDefinitionEnter              []VariableDefinitionRewriteHandler
+	variableDefinitionLeave              []VariableDefinitionRewriteHandler
+	variableDefinitionsEnter             []VariableDefinitionsRewriteHandler
+	variableDefinitionsLeave             []VariableDefinitionsRewriteHandler
+	variableValueEnter                   []VariableValueRewriteHandler
+	variableValueLeave                   []VariableValueRewriteHandler
+}
+
+// Rewrite traverses an entire AST document, calling any handlers for each node, and returns the
+// rewritten document. The given document is modified in place, and must not be used afterwards.
+// The counts of each kind of definition on the document aren't updated, see CountDefinitions.
+func (r *Rewriter) Rewrite(doc Document) Document {
+	r.rewriteDocument(nil, &doc)
+	return doc
+}
+
+// Cursor describes the position of a node that's being rewritten, allowing a handler to delete the
+// node, or insert nodes around it, if it's in a list. Nodes that aren't in a list are given a nil
+// Cursor. Nodes are only removed from, and inserted into, their list once the node has been left.
+type Cursor struct {
+	elem    string // The name of the type of the nodes in the list, e.g. "Selection".
+	before  []interface{}
+	after   []interface{}
+	deleted bool
+}
+
+// InList returns true if the node that this cursor is for is in a list, and so may be deleted, or
+// have nodes inserted around it.
+func (c *Cursor) InList() bool {
+	return c != nil
+}
+
+// Delete removes the current node from its list. No more handlers are called for the node, or any
+// of the nodes within it.
+func (c *Cursor) Delete() {
+	c.mustBeInList("Delete")
+	c.deleted = true
+}
+
+// InsertBefore inserts the given node into the current node's list, before the current node. The
+// given node must be of the same type as the nodes in the list, e.g. Selection, not *Selection,
+// or it panics. The inserted node isn't rewritten itself.
+func (c *Cursor) InsertBefore(node interface{}) {
+	c.mustBeInList("InsertBefore")
+	c.mustBeElem("InsertBefore", node)
+	c.before = append(c.before, node)
+}
+
+// InsertAfter inserts the given node into the current node's list, after the current node. The
+// given node must be of the same type as the nodes in the list, e.g. Selection, not *Selection,
+// or it panics. The inserted node isn't rewritten itself.
+func (c *Cursor) InsertAfter(node interface{}) {
+	c.mustBeInList("InsertAfter")
+	c.mustBeElem("InsertAfter", node)
+	c.after = append(c.after, node)
+}
+
+// changed returns true if the list containing the current node needs to be changed.
+func (c *Cursor) changed() bool {
+	return c.deleted || len(c.before) > 0 || len(c.after) > 0
+}
+
+// isDeleted returns true if the current node has been deleted.
+func (c *Cursor) isDeleted() bool {
+	return c != nil && c.deleted
+}
+
+// mustBeInList panics if the current node isn't in a list.
+func (c *Cursor) mustBeInList(method string) {
+	if c == nil {
+		panic("ast: Cursor." + method + " called for a node that isn't in a list")
+	}
+}
+
+// mustBeElem panics if the given node isn't of the same type as the nodes in the current list, as
+// it couldn't be inserted into it.
+func (c *Cursor) mustBeElem(method string, node interface{}) {
+	if typ := fmt.Sprintf("%T", node); typ != "ast."+c.elem {
+		panic("ast: Cursor." + method + " called with a " + typ + ", in a list of ast." + c.elem)
+	}
+}
+
+// ArgumentRewriteHandler function can handle enter/leave events for Argument.
+type ArgumentRewriteHandler func(*Cursor, *Argument)
+
+// AddArgumentEnterHandler adds a handler to be called when entering Argument nodes.
+func (r *Rewriter) AddArgumentEnterHandler(h ArgumentRewriteHandler) {
+	r.argumentEnter = append(r.argumentEnter, h)
+}
+
+// AddArgumentLeaveHandler adds a handler to be called when leaving Argument nodes.
+func (r *Rewriter) AddArgumentLeaveHandler(h ArgumentRewriteHandler) {
+	r.argumentLeave = append(r.argumentLeave, h)
+}
+
+// rewriteArgument is a function that rewrites Argument type's AST node.
+func (r *Rewriter) rewriteArgument(cursor *Cursor, a *Argument) {
+	for _, handler := range r.argumentEnter {
+		if handler(cursor, a); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteValue(nil, &a.Value)
+
+	for _, handler := range r.argumentLeave {
+		if handler(cursor, a); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ArgumentsRewriteHandler function can handle enter/leave events for Arguments.
+type ArgumentsRewriteHandler func(*Cursor, *Arguments)
+
+// AddArgumentsEnterHandler adds a handler to be called when entering Arguments nodes.
+func (r *Rewriter) AddArgumentsEnterHandler(h ArgumentsRewriteHandler) {
+	r.argumentsEnter = append(r.argumentsEnter, h)
+}
+
+// AddArgumentsLeaveHandler adds a handler to be called when leaving Arguments nodes.
+func (r *Rewriter) AddArgumentsLeaveHandler(h ArgumentsRewriteHandler) {
+	r.argumentsLeave = append(r.argumentsLeave, h)
+}
+
+// rewriteArguments is a function that rewrites Arguments type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteArguments(as *Arguments) *Arguments {
+	for _, handler := range r.argumentsEnter {
+		handler(nil, as)
+	}
+
+	var rewritten *Arguments
+	var changed bool
+
+	for current := as; current != nil; current = current.next {
+		cursor := Cursor{elem: "Argument"}
+		r.rewriteArgument(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := as; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Argument))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Argument))
+		}
+	}
+
+	if changed {
+		as = rewritten.Reverse()
+	}
+
+	for _, handler := range r.argumentsLeave {
+		handler(nil, as)
+	}
+
+	return as
+}
+
+// BooleanValueRewriteHandler function can handle enter/leave events for BooleanValue.
+type BooleanValueRewriteHandler func(*Cursor, *Value)
+
+// AddBooleanValueEnterHandler adds a handler to be called when entering BooleanValue nodes.
+func (r *Rewriter) AddBooleanValueEnterHandler(h BooleanValueRewriteHandler) {
+	r.booleanValueEnter = append(r.booleanValueEnter, h)
+}
+
+// AddBooleanValueLeaveHandler adds a handler to be called when leaving BooleanValue nodes.
+func (r *Rewriter) AddBooleanValueLeaveHandler(h BooleanValueRewriteHandler) {
+	r.booleanValueLeave = append(r.booleanValueLeave, h)
+}
+
+// rewriteBooleanValue is a function that rewrites BooleanValue type's AST node.
+func (r *Rewriter) rewriteBooleanValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.booleanValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.booleanValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// CommentRewriteHandler function can handle enter/leave events for Comment.
+type CommentRewriteHandler func(*Cursor, *Comment)
+
+// AddCommentEnterHandler adds a handler to be called when entering Comment nodes.
+func (r *Rewriter) AddCommentEnterHandler(h CommentRewriteHandler) {
+	r.commentEnter = append(r.commentEnter, h)
+}
+
+// AddCommentLeaveHandler adds a handler to be called when leaving Comment nodes.
+func (r *Rewriter) AddCommentLeaveHandler(h CommentRewriteHandler) {
+	r.commentLeave = append(r.commentLeave, h)
+}
+
+// rewriteComment is a function that rewrites Comment type's AST node.
+func (r *Rewriter) rewriteComment(cursor *Cursor, c *Comment) {
+	for _, handler := range r.commentEnter {
+		if handler(cursor, c); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteLocation(nil, &c.Loc)
+
+	for _, handler := range r.commentLeave {
+		if handler(cursor, c); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// CommentsRewriteHandler function can handle enter/leave events for Comments.
+type CommentsRewriteHandler func(*Cursor, *Comments)
+
+// AddCommentsEnterHandler adds a handler to be called when entering Comments nodes.
+func (r *Rewriter) AddCommentsEnterHandler(h CommentsRewriteHandler) {
+	r.commentsEnter = append(r.commentsEnter, h)
+}
+
+// AddCommentsLeaveHandler adds a handler to be called when leaving Comments nodes.
+func (r *Rewriter) AddCommentsLeaveHandler(h CommentsRewriteHandler) {
+	r.commentsLeave = append(r.commentsLeave, h)
+}
+
+// rewriteComments is a function that rewrites Comments type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteComments(cs *Comments) *Comments {
+	for _, handler := range r.commentsEnter {
+		handler(nil, cs)
+	}
+
+	var rewritten *Comments
+	var changed bool
+
+	for current := cs; current != nil; current = current.next {
+		cursor := Cursor{elem: "Comment"}
+		r.rewriteComment(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := cs; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Comment))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Comment))
+		}
+	}
+
+	if changed {
+		cs = rewritten.Reverse()
+	}
+
+	for _, handler := range r.commentsLeave {
+		handler(nil, cs)
+	}
+
+	return cs
+}
+
+// DefinitionRewriteHandler function can handle enter/leave events for Definition.
+type DefinitionRewriteHandler func(*Cursor, *Definition)
+
+// AddDefinitionEnterHandler adds a handler to be called when entering Definition nodes.
+func (r *Rewriter) AddDefinitionEnterHandler(h DefinitionRewriteHandler) {
+	r.definitionEnter = append(r.definitionEnter, h)
+}
+
+// AddDefinitionLeaveHandler adds a handler to be called when leaving Definition nodes.
+func (r *Rewriter) AddDefinitionLeaveHandler(h DefinitionRewriteHandler) {
+	r.definitionLeave = append(r.definitionLeave, h)
+}
+
+// rewriteDefinition is a function that rewrites Definition type's AST node.
+func (r *Rewriter) rewriteDefinition(cursor *Cursor, d *Definition) {
+	for _, handler := range r.definitionEnter {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch d.Kind {
+	case DefinitionKindExecutable:
+		r.rewriteExecutableDefinition(cursor, d.ExecutableDefinition)
+	case DefinitionKindTypeSystem:
+		r.rewriteTypeSystemDefinition(cursor, d.TypeSystemDefinition)
+	case DefinitionKindTypeSystemExtension:
+		r.rewriteTypeSystemExtension(cursor, d.TypeSystemExtension)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.definitionLeave {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// DefinitionsRewriteHandler function can handle enter/leave events for Definitions.
+type DefinitionsRewriteHandler func(*Cursor, *Definitions)
+
+// AddDefinitionsEnterHandler adds a handler to be called when entering Definitions nodes.
+func (r *Rewriter) AddDefinitionsEnterHandler(h DefinitionsRewriteHandler) {
+	r.definitionsEnter = append(r.definitionsEnter, h)
+}
+
+// AddDefinitionsLeaveHandler adds a handler to be called when leaving Definitions nodes.
+func (r *Rewriter) AddDefinitionsLeaveHandler(h DefinitionsRewriteHandler) {
+	r.definitionsLeave = append(r.definitionsLeave, h)
+}
+
+// rewriteDefinitions is a function that rewrites Definitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteDefinitions(ds *Definitions) *Definitions {
+	for _, handler := range r.definitionsEnter {
+		handler(nil, ds)
+	}
+
+	var rewritten *Definitions
+	var changed bool
+
+	for current := ds; current != nil; current = current.next {
+		cursor := Cursor{elem: "Definition"}
+		r.rewriteDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Definition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Definition))
+		}
+	}
+
+	if changed {
+		ds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.definitionsLeave {
+		handler(nil, ds)
+	}
+
+	return ds
+}
+
+// DirectiveRewriteHandler function can handle enter/leave events for Directive.
+type DirectiveRewriteHandler func(*Cursor, *Directive)
+
+// AddDirectiveEnterHandler adds a handler to be called when entering Directive nodes.
+func (r *Rewriter) AddDirectiveEnterHandler(h DirectiveRewriteHandler) {
+	r.directiveEnter = append(r.directiveEnter, h)
+}
+
+// AddDirectiveLeaveHandler adds a handler to be called when leaving Directive nodes.
+func (r *Rewriter) AddDirectiveLeaveHandler(h DirectiveRewriteHandler) {
+	r.directiveLeave = append(r.directiveLeave, h)
+}
+
+// rewriteDirective is a function that rewrites Directive type's AST node.
+func (r *Rewriter) rewriteDirective(cursor *Cursor, d *Directive) {
+	for _, handler := range r.directiveEnter {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if d.Arguments != nil {
+		d.Arguments = r.rewriteArguments(d.Arguments)
+	}
+
+	for _, handler := range r.directiveLeave {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// DirectiveDefinitionRewriteHandler function can handle enter/leave events for DirectiveDefinition.
+type DirectiveDefinitionRewriteHandler func(*Cursor, *DirectiveDefinition)
+
+// AddDirectiveDefinitionEnterHandler adds a handler to be called when entering DirectiveDefinition nodes.
+func (r *Rewriter) AddDirectiveDefinitionEnterHandler(h DirectiveDefinitionRewriteHandler) {
+	r.directiveDefinitionEnter = append(r.directiveDefinitionEnter, h)
+}
+
+// AddDirectiveDefinitionLeaveHandler adds a handler to be called when leaving DirectiveDefinition nodes.
+func (r *Rewriter) AddDirectiveDefinitionLeaveHandler(h DirectiveDefinitionRewriteHandler) {
+	r.directiveDefinitionLeave = append(r.directiveDefinitionLeave, h)
+}
+
+// rewriteDirectiveDefinition is a function that rewrites DirectiveDefinition type's AST node.
+func (r *Rewriter) rewriteDirectiveDefinition(cursor *Cursor, dd *DirectiveDefinition) {
+	for _, handler := range r.directiveDefinitionEnter {
+		if handler(cursor, dd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if dd.ArgumentsDefinition != nil {
+		dd.ArgumentsDefinition = r.rewriteInputValueDefinitions(dd.ArgumentsDefinition)
+	}
+
+	for _, handler := range r.directiveDefinitionLeave {
+		if handler(cursor, dd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// DirectivesRewriteHandler function can handle enter/leave events for Directives.
+type DirectivesRewriteHandler func(*Cursor, *Directives)
+
+// AddDirectivesEnterHandler adds a handler to be called when entering Directives nodes.
+func (r *Rewriter) AddDirectivesEnterHandler(h DirectivesRewriteHandler) {
+	r.directivesEnter = append(r.directivesEnter, h)
+}
+
+// AddDirectivesLeaveHandler adds a handler to be called when leaving Directives nodes.
+func (r *Rewriter) AddDirectivesLeaveHandler(h DirectivesRewriteHandler) {
+	r.directivesLeave = append(r.directivesLeave, h)
+}
+
+// rewriteDirectives is a function that rewrites Directives type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteDirectives(ds *Directives) *Directives {
+	for _, handler := range r.directivesEnter {
+		handler(nil, ds)
+	}
+
+	var rewritten *Directives
+	var changed bool
+
+	for current := ds; current != nil; current = current.next {
+		cursor := Cursor{elem: "Directive"}
+		r.rewriteDirective(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Directive))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Directive))
+		}
+	}
+
+	if changed {
+		ds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.directivesLeave {
+		handler(nil, ds)
+	}
+
+	return ds
+}
+
+// DocumentRewriteHandler function can handle enter/leave events for Document.
+type DocumentRewriteHandler func(*Cursor, *Document)
+
+// AddDocumentEnterHandler adds a handler to be called when entering Document nodes.
+func (r *Rewriter) AddDocumentEnterHandler(h DocumentRewriteHandler) {
+	r.documentEnter = append(r.documentEnter, h)
+}
+
+// AddDocumentLeaveHandler adds a handler to be called when leaving Document nodes.
+func (r *Rewriter) AddDocumentLeaveHandler(h DocumentRewriteHandler) {
+	r.documentLeave = append(r.documentLeave, h)
+}
+
+// rewriteDocument is a function that rewrites Document type's AST node.
+func (r *Rewriter) rewriteDocument(cursor *Cursor, d *Document) {
+	for _, handler := range r.documentEnter {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if d.Definitions != nil {
+		d.Definitions = r.rewriteDefinitions(d.Definitions)
+	}
+
+	for _, handler := range r.documentLeave {
+		if handler(cursor, d); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// EnumTypeDefinitionRewriteHandler function can handle enter/leave events for EnumTypeDefinition.
+type EnumTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddEnumTypeDefinitionEnterHandler adds a handler to be called when entering EnumTypeDefinition nodes.
+func (r *Rewriter) AddEnumTypeDefinitionEnterHandler(h EnumTypeDefinitionRewriteHandler) {
+	r.enumTypeDefinitionEnter = append(r.enumTypeDefinitionEnter, h)
+}
+
+// AddEnumTypeDefinitionLeaveHandler adds a handler to be called when leaving EnumTypeDefinition nodes.
+func (r *Rewriter) AddEnumTypeDefinitionLeaveHandler(h EnumTypeDefinitionRewriteHandler) {
+	r.enumTypeDefinitionLeave = append(r.enumTypeDefinitionLeave, h)
+}
+
+// rewriteEnumTypeDefinition is a function that rewrites EnumTypeDefinition type's AST node.
+func (r *Rewriter) rewriteEnumTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.enumTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.enumTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// EnumTypeExtensionRewriteHandler function can handle enter/leave events for EnumTypeExtension.
+type EnumTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddEnumTypeExtensionEnterHandler adds a handler to be called when entering EnumTypeExtension nodes.
+func (r *Rewriter) AddEnumTypeExtensionEnterHandler(h EnumTypeExtensionRewriteHandler) {
+	r.enumTypeExtensionEnter = append(r.enumTypeExtensionEnter, h)
+}
+
+// AddEnumTypeExtensionLeaveHandler adds a handler to be called when leaving EnumTypeExtension nodes.
+func (r *Rewriter) AddEnumTypeExtensionLeaveHandler(h EnumTypeExtensionRewriteHandler) {
+	r.enumTypeExtensionLeave = append(r.enumTypeExtensionLeave, h)
+}
+
+// rewriteEnumTypeExtension is a function that rewrites EnumTypeExtension type's AST node.
+func (r *Rewriter) rewriteEnumTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.enumTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.enumTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// EnumValueRewriteHandler function can handle enter/leave events for EnumValue.
+type EnumValueRewriteHandler func(*Cursor, *Value)
+
+// AddEnumValueEnterHandler adds a handler to be called when entering EnumValue nodes.
+func (r *Rewriter) AddEnumValueEnterHandler(h EnumValueRewriteHandler) {
+	r.enumValueEnter = append(r.enumValueEnter, h)
+}
+
+// AddEnumValueLeaveHandler adds a handler to be called when leaving EnumValue nodes.
+func (r *Rewriter) AddEnumValueLeaveHandler(h EnumValueRewriteHandler) {
+	r.enumValueLeave = append(r.enumValueLeave, h)
+}
+
+// rewriteEnumValue is a function that rewrites EnumValue type's AST node.
+func (r *Rewriter) rewriteEnumValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.enumValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.enumValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// EnumValueDefinitionRewriteHandler function can handle enter/leave events for EnumValueDefinition.
+type EnumValueDefinitionRewriteHandler func(*Cursor, *EnumValueDefinition)
+
+// AddEnumValueDefinitionEnterHandler adds a handler to be called when entering EnumValueDefinition nodes.
+func (r *Rewriter) AddEnumValueDefinitionEnterHandler(h EnumValueDefinitionRewriteHandler) {
+	r.enumValueDefinitionEnter = append(r.enumValueDefinitionEnter, h)
+}
+
+// AddEnumValueDefinitionLeaveHandler adds a handler to be called when leaving EnumValueDefinition nodes.
+func (r *Rewriter) AddEnumValueDefinitionLeaveHandler(h EnumValueDefinitionRewriteHandler) {
+	r.enumValueDefinitionLeave = append(r.enumValueDefinitionLeave, h)
+}
+
+// rewriteEnumValueDefinition is a function that rewrites EnumValueDefinition type's AST node.
+func (r *Rewriter) rewriteEnumValueDefinition(cursor *Cursor, evd *EnumValueDefinition) {
+	for _, handler := range r.enumValueDefinitionEnter {
+		if handler(cursor, evd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if evd.Directives != nil {
+		evd.Directives = r.rewriteDirectives(evd.Directives)
+	}
+
+	for _, handler := range r.enumValueDefinitionLeave {
+		if handler(cursor, evd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// EnumValueDefinitionsRewriteHandler function can handle enter/leave events for EnumValueDefinitions.
+type EnumValueDefinitionsRewriteHandler func(*Cursor, *EnumValueDefinitions)
+
+// AddEnumValueDefinitionsEnterHandler adds a handler to be called when entering EnumValueDefinitions nodes.
+func (r *Rewriter) AddEnumValueDefinitionsEnterHandler(h EnumValueDefinitionsRewriteHandler) {
+	r.enumValueDefinitionsEnter = append(r.enumValueDefinitionsEnter, h)
+}
+
+// AddEnumValueDefinitionsLeaveHandler adds a handler to be called when leaving EnumValueDefinitions nodes.
+func (r *Rewriter) AddEnumValueDefinitionsLeaveHandler(h EnumValueDefinitionsRewriteHandler) {
+	r.enumValueDefinitionsLeave = append(r.enumValueDefinitionsLeave, h)
+}
+
+// rewriteEnumValueDefinitions is a function that rewrites EnumValueDefinitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteEnumValueDefinitions(evds *EnumValueDefinitions) *EnumValueDefinitions {
+	for _, handler := range r.enumValueDefinitionsEnter {
+		handler(nil, evds)
+	}
+
+	var rewritten *EnumValueDefinitions
+	var changed bool
+
+	for current := evds; current != nil; current = current.next {
+		cursor := Cursor{elem: "EnumValueDefinition"}
+		r.rewriteEnumValueDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := evds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(EnumValueDefinition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(EnumValueDefinition))
+		}
+	}
+
+	if changed {
+		evds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.enumValueDefinitionsLeave {
+		handler(nil, evds)
+	}
+
+	return evds
+}
+
+// ExecutableDefinitionRewriteHandler function can handle enter/leave events for ExecutableDefinition.
+type ExecutableDefinitionRewriteHandler func(*Cursor, *ExecutableDefinition)
+
+// AddExecutableDefinitionEnterHandler adds a handler to be called when entering ExecutableDefinition nodes.
+func (r *Rewriter) AddExecutableDefinitionEnterHandler(h ExecutableDefinitionRewriteHandler) {
+	r.executableDefinitionEnter = append(r.executableDefinitionEnter, h)
+}
+
+// AddExecutableDefinitionLeaveHandler adds a handler to be called when leaving ExecutableDefinition nodes.
+func (r *Rewriter) AddExecutableDefinitionLeaveHandler(h ExecutableDefinitionRewriteHandler) {
+	r.executableDefinitionLeave = append(r.executableDefinitionLeave, h)
+}
+
+// rewriteExecutableDefinition is a function that rewrites ExecutableDefinition type's AST node.
+func (r *Rewriter) rewriteExecutableDefinition(cursor *Cursor, ed *ExecutableDefinition) {
+	for _, handler := range r.executableDefinitionEnter {
+		if handler(cursor, ed); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch ed.Kind {
+	case ExecutableDefinitionKindFragment:
+		r.rewriteFragmentDefinition(cursor, ed.FragmentDefinition)
+	case ExecutableDefinitionKindOperation:
+		r.rewriteOperationDefinition(cursor, ed.OperationDefinition)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.executableDefinitionLeave {
+		if handler(cursor, ed); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// FieldDefinitionRewriteHandler function can handle enter/leave events for FieldDefinition.
+type FieldDefinitionRewriteHandler func(*Cursor, *FieldDefinition)
+
+// AddFieldDefinitionEnterHandler adds a handler to be called when entering FieldDefinition nodes.
+func (r *Rewriter) AddFieldDefinitionEnterHandler(h FieldDefinitionRewriteHandler) {
+	r.fieldDefinitionEnter = append(r.fieldDefinitionEnter, h)
+}
+
+// AddFieldDefinitionLeaveHandler adds a handler to be called when leaving FieldDefinition nodes.
+func (r *Rewriter) AddFieldDefinitionLeaveHandler(h FieldDefinitionRewriteHandler) {
+	r.fieldDefinitionLeave = append(r.fieldDefinitionLeave, h)
+}
+
+// rewriteFieldDefinition is a function that rewrites FieldDefinition type's AST node.
+func (r *Rewriter) rewriteFieldDefinition(cursor *Cursor, fd *FieldDefinition) {
+	for _, handler := range r.fieldDefinitionEnter {
+		if handler(cursor, fd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if fd.ArgumentsDefinition != nil {
+		fd.ArgumentsDefinition = r.rewriteInputValueDefinitions(fd.ArgumentsDefinition)
+	}
+
+	r.rewriteType(nil, &fd.Type)
+
+	if fd.Directives != nil {
+		fd.Directives = r.rewriteDirectives(fd.Directives)
+	}
+
+	for _, handler := range r.fieldDefinitionLeave {
+		if handler(cursor, fd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// FieldDefinitionsRewriteHandler function can handle enter/leave events for FieldDefinitions.
+type FieldDefinitionsRewriteHandler func(*Cursor, *FieldDefinitions)
+
+// AddFieldDefinitionsEnterHandler adds a handler to be called when entering FieldDefinitions nodes.
+func (r *Rewriter) AddFieldDefinitionsEnterHandler(h FieldDefinitionsRewriteHandler) {
+	r.fieldDefinitionsEnter = append(r.fieldDefinitionsEnter, h)
+}
+
+// AddFieldDefinitionsLeaveHandler adds a handler to be called when leaving FieldDefinitions nodes.
+func (r *Rewriter) AddFieldDefinitionsLeaveHandler(h FieldDefinitionsRewriteHandler) {
+	r.fieldDefinitionsLeave = append(r.fieldDefinitionsLeave, h)
+}
+
+// rewriteFieldDefinitions is a function that rewrites FieldDefinitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteFieldDefinitions(fds *FieldDefinitions) *FieldDefinitions {
+	for _, handler := range r.fieldDefinitionsEnter {
+		handler(nil, fds)
+	}
+
+	var rewritten *FieldDefinitions
+	var changed bool
+
+	for current := fds; current != nil; current = current.next {
+		cursor := Cursor{elem: "FieldDefinition"}
+		r.rewriteFieldDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := fds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(FieldDefinition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(FieldDefinition))
+		}
+	}
+
+	if changed {
+		fds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.fieldDefinitionsLeave {
+		handler(nil, fds)
+	}
+
+	return fds
+}
+
+// FieldSelectionRewriteHandler function can handle enter/leave events for FieldSelection.
+type FieldSelectionRewriteHandler func(*Cursor, *Selection)
+
+// AddFieldSelectionEnterHandler adds a handler to be called when entering FieldSelection nodes.
+func (r *Rewriter) AddFieldSelectionEnterHandler(h FieldSelectionRewriteHandler) {
+	r.fieldSelectionEnter = append(r.fieldSelectionEnter, h)
+}
+
+// AddFieldSelectionLeaveHandler adds a handler to be called when leaving FieldSelection nodes.
+func (r *Rewriter) AddFieldSelectionLeaveHandler(h FieldSelectionRewriteHandler) {
+	r.fieldSelectionLeave = append(r.fieldSelectionLeave, h)
+}
+
+// rewriteFieldSelection is a function that rewrites FieldSelection type's AST node.
+func (r *Rewriter) rewriteFieldSelection(cursor *Cursor, s *Selection) {
+	for _, handler := range r.fieldSelectionEnter {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if s.Arguments != nil {
+		s.Arguments = r.rewriteArguments(s.Arguments)
+	}
+
+	if s.Directives != nil {
+		s.Directives = r.rewriteDirectives(s.Directives)
+	}
+
+	if s.SelectionSet != nil {
+		s.SelectionSet = r.rewriteSelections(s.SelectionSet)
+	}
+
+	if s.Path != nil {
+		s.Path = r.rewritePathNodes(s.Path)
+	}
+
+	for _, handler := range r.fieldSelectionLeave {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// FloatValueRewriteHandler function can handle enter/leave events for FloatValue.
+type FloatValueRewriteHandler func(*Cursor, *Value)
+
+// AddFloatValueEnterHandler adds a handler to be called when entering FloatValue nodes.
+func (r *Rewriter) AddFloatValueEnterHandler(h FloatValueRewriteHandler) {
+	r.floatValueEnter = append(r.floatValueEnter, h)
+}
+
+// AddFloatValueLeaveHandler adds a handler to be called when leaving FloatValue nodes.
+func (r *Rewriter) AddFloatValueLeaveHandler(h FloatValueRewriteHandler) {
+	r.floatValueLeave = append(r.floatValueLeave, h)
+}
+
+// rewriteFloatValue is a function that rewrites FloatValue type's AST node.
+func (r *Rewriter) rewriteFloatValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.floatValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.floatValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// FragmentDefinitionRewriteHandler function can handle enter/leave events for FragmentDefinition.
+type FragmentDefinitionRewriteHandler func(*Cursor, *FragmentDefinition)
+
+// AddFragmentDefinitionEnterHandler adds a handler to be called when entering FragmentDefinition nodes.
+func (r *Rewriter) AddFragmentDefinitionEnterHandler(h FragmentDefinitionRewriteHandler) {
+	r.fragmentDefinitionEnter = append(r.fragmentDefinitionEnter, h)
+}
+
+// AddFragmentDefinitionLeaveHandler adds a handler to be called when leaving FragmentDefinition nodes.
+func (r *Rewriter) AddFragmentDefinitionLeaveHandler(h FragmentDefinitionRewriteHandler) {
+	r.fragmentDefinitionLeave = append(r.fragmentDefinitionLeave, h)
+}
+
+// rewriteFragmentDefinition is a function that rewrites FragmentDefinition type's AST node.
+func (r *Rewriter) rewriteFragmentDefinition(cursor *Cursor, fd *FragmentDefinition) {
+	for _, handler := range r.fragmentDefinitionEnter {
+		if handler(cursor, fd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if fd.VariableDefinitions != nil {
+		fd.VariableDefinitions = r.rewriteVariableDefinitions(fd.VariableDefinitions)
+	}
+
+	if fd.TypeCondition != nil {
+		r.rewriteTypeCondition(nil, fd.TypeCondition)
+	}
+
+	if fd.Directives != nil {
+		fd.Directives = r.rewriteDirectives(fd.Directives)
+	}
+
+	if fd.SelectionSet != nil {
+		fd.SelectionSet = r.rewriteSelections(fd.SelectionSet)
+	}
+
+	for _, handler := range r.fragmentDefinitionLeave {
+		if handler(cursor, fd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// FragmentSpreadSelectionRewriteHandler function can handle enter/leave events for FragmentSpreadSelection.
+type FragmentSpreadSelectionRewriteHandler func(*Cursor, *Selection)
+
+// AddFragmentSpreadSelectionEnterHandler adds a handler to be called when entering FragmentSpreadSelection nodes.
+func (r *Rewriter) AddFragmentSpreadSelectionEnterHandler(h FragmentSpreadSelectionRewriteHandler) {
+	r.fragmentSpreadSelectionEnter = append(r.fragmentSpreadSelectionEnter, h)
+}
+
+// AddFragmentSpreadSelectionLeaveHandler adds a handler to be called when leaving FragmentSpreadSelection nodes.
+func (r *Rewriter) AddFragmentSpreadSelectionLeaveHandler(h FragmentSpreadSelectionRewriteHandler) {
+	r.fragmentSpreadSelectionLeave = append(r.fragmentSpreadSelectionLeave, h)
+}
+
+// rewriteFragmentSpreadSelection is a function that rewrites FragmentSpreadSelection type's AST node.
+func (r *Rewriter) rewriteFragmentSpreadSelection(cursor *Cursor, s *Selection) {
+	for _, handler := range r.fragmentSpreadSelectionEnter {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if s.Arguments != nil {
+		s.Arguments = r.rewriteArguments(s.Arguments)
+	}
+
+	if s.Directives != nil {
+		s.Directives = r.rewriteDirectives(s.Directives)
+	}
+
+	if s.SelectionSet != nil {
+		s.SelectionSet = r.rewriteSelections(s.SelectionSet)
+	}
+
+	if s.Path != nil {
+		s.Path = r.rewritePathNodes(s.Path)
+	}
+
+	for _, handler := range r.fragmentSpreadSelectionLeave {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InlineFragmentSelectionRewriteHandler function can handle enter/leave events for InlineFragmentSelection.
+type InlineFragmentSelectionRewriteHandler func(*Cursor, *Selection)
+
+// AddInlineFragmentSelectionEnterHandler adds a handler to be called when entering InlineFragmentSelection nodes.
+func (r *Rewriter) AddInlineFragmentSelectionEnterHandler(h InlineFragmentSelectionRewriteHandler) {
+	r.inlineFragmentSelectionEnter = append(r.inlineFragmentSelectionEnter, h)
+}
+
+// AddInlineFragmentSelectionLeaveHandler adds a handler to be called when leaving InlineFragmentSelection nodes.
+func (r *Rewriter) AddInlineFragmentSelectionLeaveHandler(h InlineFragmentSelectionRewriteHandler) {
+	r.inlineFragmentSelectionLeave = append(r.inlineFragmentSelectionLeave, h)
+}
+
+// rewriteInlineFragmentSelection is a function that rewrites InlineFragmentSelection type's AST node.
+func (r *Rewriter) rewriteInlineFragmentSelection(cursor *Cursor, s *Selection) {
+	for _, handler := range r.inlineFragmentSelectionEnter {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if s.TypeCondition != nil {
+		r.rewriteTypeCondition(nil, s.TypeCondition)
+	}
+
+	if s.Arguments != nil {
+		s.Arguments = r.rewriteArguments(s.Arguments)
+	}
+
+	if s.Directives != nil {
+		s.Directives = r.rewriteDirectives(s.Directives)
+	}
+
+	if s.SelectionSet != nil {
+		s.SelectionSet = r.rewriteSelections(s.SelectionSet)
+	}
+
+	if s.Path != nil {
+		s.Path = r.rewritePathNodes(s.Path)
+	}
+
+	for _, handler := range r.inlineFragmentSelectionLeave {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InputObjectTypeDefinitionRewriteHandler function can handle enter/leave events for InputObjectTypeDefinition.
+type InputObjectTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddInputObjectTypeDefinitionEnterHandler adds a handler to be called when entering InputObjectTypeDefinition nodes.
+func (r *Rewriter) AddInputObjectTypeDefinitionEnterHandler(h InputObjectTypeDefinitionRewriteHandler) {
+	r.inputObjectTypeDefinitionEnter = append(r.inputObjectTypeDefinitionEnter, h)
+}
+
+// AddInputObjectTypeDefinitionLeaveHandler adds a handler to be called when leaving InputObjectTypeDefinition nodes.
+func (r *Rewriter) AddInputObjectTypeDefinitionLeaveHandler(h InputObjectTypeDefinitionRewriteHandler) {
+	r.inputObjectTypeDefinitionLeave = append(r.inputObjectTypeDefinitionLeave, h)
+}
+
+// rewriteInputObjectTypeDefinition is a function that rewrites InputObjectTypeDefinition type's AST node.
+func (r *Rewriter) rewriteInputObjectTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.inputObjectTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.inputObjectTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InputObjectTypeExtensionRewriteHandler function can handle enter/leave events for InputObjectTypeExtension.
+type InputObjectTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddInputObjectTypeExtensionEnterHandler adds a handler to be called when entering InputObjectTypeExtension nodes.
+func (r *Rewriter) AddInputObjectTypeExtensionEnterHandler(h InputObjectTypeExtensionRewriteHandler) {
+	r.inputObjectTypeExtensionEnter = append(r.inputObjectTypeExtensionEnter, h)
+}
+
+// AddInputObjectTypeExtensionLeaveHandler adds a handler to be called when leaving InputObjectTypeExtension nodes.
+func (r *Rewriter) AddInputObjectTypeExtensionLeaveHandler(h InputObjectTypeExtensionRewriteHandler) {
+	r.inputObjectTypeExtensionLeave = append(r.inputObjectTypeExtensionLeave, h)
+}
+
+// rewriteInputObjectTypeExtension is a function that rewrites InputObjectTypeExtension type's AST node.
+func (r *Rewriter) rewriteInputObjectTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.inputObjectTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.inputObjectTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InputValueDefinitionRewriteHandler function can handle enter/leave events for InputValueDefinition.
+type InputValueDefinitionRewriteHandler func(*Cursor, *InputValueDefinition)
+
+// AddInputValueDefinitionEnterHandler adds a handler to be called when entering InputValueDefinition nodes.
+func (r *Rewriter) AddInputValueDefinitionEnterHandler(h InputValueDefinitionRewriteHandler) {
+	r.inputValueDefinitionEnter = append(r.inputValueDefinitionEnter, h)
+}
+
+// AddInputValueDefinitionLeaveHandler adds a handler to be called when leaving InputValueDefinition nodes.
+func (r *Rewriter) AddInputValueDefinitionLeaveHandler(h InputValueDefinitionRewriteHandler) {
+	r.inputValueDefinitionLeave = append(r.inputValueDefinitionLeave, h)
+}
+
+// rewriteInputValueDefinition is a function that rewrites InputValueDefinition type's AST node.
+func (r *Rewriter) rewriteInputValueDefinition(cursor *Cursor, ivd *InputValueDefinition) {
+	for _, handler := range r.inputValueDefinitionEnter {
+		if handler(cursor, ivd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteType(nil, &ivd.Type)
+
+	if ivd.Directives != nil {
+		ivd.Directives = r.rewriteDirectives(ivd.Directives)
+	}
+
+	if ivd.DefaultValue != nil {
+		r.rewriteValue(nil, ivd.DefaultValue)
+	}
+
+	for _, handler := range r.inputValueDefinitionLeave {
+		if handler(cursor, ivd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InputValueDefinitionsRewriteHandler function can handle enter/leave events for InputValueDefinitions.
+type InputValueDefinitionsRewriteHandler func(*Cursor, *InputValueDefinitions)
+
+// AddInputValueDefinitionsEnterHandler adds a handler to be called when entering InputValueDefinitions nodes.
+func (r *Rewriter) AddInputValueDefinitionsEnterHandler(h InputValueDefinitionsRewriteHandler) {
+	r.inputValueDefinitionsEnter = append(r.inputValueDefinitionsEnter, h)
+}
+
+// AddInputValueDefinitionsLeaveHandler adds a handler to be called when leaving InputValueDefinitions nodes.
+func (r *Rewriter) AddInputValueDefinitionsLeaveHandler(h InputValueDefinitionsRewriteHandler) {
+	r.inputValueDefinitionsLeave = append(r.inputValueDefinitionsLeave, h)
+}
+
+// rewriteInputValueDefinitions is a function that rewrites InputValueDefinitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteInputValueDefinitions(ivds *InputValueDefinitions) *InputValueDefinitions {
+	for _, handler := range r.inputValueDefinitionsEnter {
+		handler(nil, ivds)
+	}
+
+	var rewritten *InputValueDefinitions
+	var changed bool
+
+	for current := ivds; current != nil; current = current.next {
+		cursor := Cursor{elem: "InputValueDefinition"}
+		r.rewriteInputValueDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ivds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(InputValueDefinition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(InputValueDefinition))
+		}
+	}
+
+	if changed {
+		ivds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.inputValueDefinitionsLeave {
+		handler(nil, ivds)
+	}
+
+	return ivds
+}
+
+// IntPathNodeRewriteHandler function can handle enter/leave events for IntPathNode.
+type IntPathNodeRewriteHandler func(*Cursor, *PathNode)
+
+// AddIntPathNodeEnterHandler adds a handler to be called when entering IntPathNode nodes.
+func (r *Rewriter) AddIntPathNodeEnterHandler(h IntPathNodeRewriteHandler) {
+	r.intPathNodeEnter = append(r.intPathNodeEnter, h)
+}
+
+// AddIntPathNodeLeaveHandler adds a handler to be called when leaving IntPathNode nodes.
+func (r *Rewriter) AddIntPathNodeLeaveHandler(h IntPathNodeRewriteHandler) {
+	r.intPathNodeLeave = append(r.intPathNodeLeave, h)
+}
+
+// rewriteIntPathNode is a function that rewrites IntPathNode type's AST node.
+func (r *Rewriter) rewriteIntPathNode(cursor *Cursor, pn *PathNode) {
+	for _, handler := range r.intPathNodeEnter {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.intPathNodeLeave {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// IntValueRewriteHandler function can handle enter/leave events for IntValue.
+type IntValueRewriteHandler func(*Cursor, *Value)
+
+// AddIntValueEnterHandler adds a handler to be called when entering IntValue nodes.
+func (r *Rewriter) AddIntValueEnterHandler(h IntValueRewriteHandler) {
+	r.intValueEnter = append(r.intValueEnter, h)
+}
+
+// AddIntValueLeaveHandler adds a handler to be called when leaving IntValue nodes.
+func (r *Rewriter) AddIntValueLeaveHandler(h IntValueRewriteHandler) {
+	r.intValueLeave = append(r.intValueLeave, h)
+}
+
+// rewriteIntValue is a function that rewrites IntValue type's AST node.
+func (r *Rewriter) rewriteIntValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.intValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.intValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InterfaceTypeDefinitionRewriteHandler function can handle enter/leave events for InterfaceTypeDefinition.
+type InterfaceTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddInterfaceTypeDefinitionEnterHandler adds a handler to be called when entering InterfaceTypeDefinition nodes.
+func (r *Rewriter) AddInterfaceTypeDefinitionEnterHandler(h InterfaceTypeDefinitionRewriteHandler) {
+	r.interfaceTypeDefinitionEnter = append(r.interfaceTypeDefinitionEnter, h)
+}
+
+// AddInterfaceTypeDefinitionLeaveHandler adds a handler to be called when leaving InterfaceTypeDefinition nodes.
+func (r *Rewriter) AddInterfaceTypeDefinitionLeaveHandler(h InterfaceTypeDefinitionRewriteHandler) {
+	r.interfaceTypeDefinitionLeave = append(r.interfaceTypeDefinitionLeave, h)
+}
+
+// rewriteInterfaceTypeDefinition is a function that rewrites InterfaceTypeDefinition type's AST node.
+func (r *Rewriter) rewriteInterfaceTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.interfaceTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.interfaceTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// InterfaceTypeExtensionRewriteHandler function can handle enter/leave events for InterfaceTypeExtension.
+type InterfaceTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddInterfaceTypeExtensionEnterHandler adds a handler to be called when entering InterfaceTypeExtension nodes.
+func (r *Rewriter) AddInterfaceTypeExtensionEnterHandler(h InterfaceTypeExtensionRewriteHandler) {
+	r.interfaceTypeExtensionEnter = append(r.interfaceTypeExtensionEnter, h)
+}
+
+// AddInterfaceTypeExtensionLeaveHandler adds a handler to be called when leaving InterfaceTypeExtension nodes.
+func (r *Rewriter) AddInterfaceTypeExtensionLeaveHandler(h InterfaceTypeExtensionRewriteHandler) {
+	r.interfaceTypeExtensionLeave = append(r.interfaceTypeExtensionLeave, h)
+}
+
+// rewriteInterfaceTypeExtension is a function that rewrites InterfaceTypeExtension type's AST node.
+func (r *Rewriter) rewriteInterfaceTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.interfaceTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.interfaceTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ListTypeRewriteHandler function can handle enter/leave events for ListType.
+type ListTypeRewriteHandler func(*Cursor, *Type)
+
+// AddListTypeEnterHandler adds a handler to be called when entering ListType nodes.
+func (r *Rewriter) AddListTypeEnterHandler(h ListTypeRewriteHandler) {
+	r.listTypeEnter = append(r.listTypeEnter, h)
+}
+
+// AddListTypeLeaveHandler adds a handler to be called when leaving ListType nodes.
+func (r *Rewriter) AddListTypeLeaveHandler(h ListTypeRewriteHandler) {
+	r.listTypeLeave = append(r.listTypeLeave, h)
+}
+
+// rewriteListType is a function that rewrites ListType type's AST node.
+func (r *Rewriter) rewriteListType(cursor *Cursor, t *Type) {
+	for _, handler := range r.listTypeEnter {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if t.ListType != nil {
+		r.rewriteType(nil, t.ListType)
+	}
+
+	for _, handler := range r.listTypeLeave {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ListValueRewriteHandler function can handle enter/leave events for ListValue.
+type ListValueRewriteHandler func(*Cursor, *Value)
+
+// AddListValueEnterHandler adds a handler to be called when entering ListValue nodes.
+func (r *Rewriter) AddListValueEnterHandler(h ListValueRewriteHandler) {
+	r.listValueEnter = append(r.listValueEnter, h)
+}
+
+// AddListValueLeaveHandler adds a handler to be called when leaving ListValue nodes.
+func (r *Rewriter) AddListValueLeaveHandler(h ListValueRewriteHandler) {
+	r.listValueLeave = append(r.listValueLeave, h)
+}
+
+// rewriteListValue is a function that rewrites ListValue type's AST node.
+func (r *Rewriter) rewriteListValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.listValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	v.ListValue = r.rewriteValueSlice(v.ListValue)
+
+	for _, handler := range r.listValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// LocationRewriteHandler function can handle enter/leave events for Location.
+type LocationRewriteHandler func(*Cursor, *Location)
+
+// AddLocationEnterHandler adds a handler to be called when entering Location nodes.
+func (r *Rewriter) AddLocationEnterHandler(h LocationRewriteHandler) {
+	r.locationEnter = append(r.locationEnter, h)
+}
+
+// AddLocationLeaveHandler adds a handler to be called when leaving Location nodes.
+func (r *Rewriter) AddLocationLeaveHandler(h LocationRewriteHandler) {
+	r.locationLeave = append(r.locationLeave, h)
+}
+
+// rewriteLocation is a function that rewrites Location type's AST node.
+func (r *Rewriter) rewriteLocation(cursor *Cursor, l *Location) {
+	for _, handler := range r.locationEnter {
+		if handler(cursor, l); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.locationLeave {
+		if handler(cursor, l); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// MutationOperationDefinitionRewriteHandler function can handle enter/leave events for MutationOperationDefinition.
+type MutationOperationDefinitionRewriteHandler func(*Cursor, *OperationDefinition)
+
+// AddMutationOperationDefinitionEnterHandler adds a handler to be called when entering MutationOperationDefinition nodes.
+func (r *Rewriter) AddMutationOperationDefinitionEnterHandler(h MutationOperationDefinitionRewriteHandler) {
+	r.mutationOperationDefinitionEnter = append(r.mutationOperationDefinitionEnter, h)
+}
+
+// AddMutationOperationDefinitionLeaveHandler adds a handler to be called when leaving MutationOperationDefinition nodes.
+func (r *Rewriter) AddMutationOperationDefinitionLeaveHandler(h MutationOperationDefinitionRewriteHandler) {
+	r.mutationOperationDefinitionLeave = append(r.mutationOperationDefinitionLeave, h)
+}
+
+// rewriteMutationOperationDefinition is a function that rewrites MutationOperationDefinition type's AST node.
+func (r *Rewriter) rewriteMutationOperationDefinition(cursor *Cursor, od *OperationDefinition) {
+	for _, handler := range r.mutationOperationDefinitionEnter {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if od.VariableDefinitions != nil {
+		od.VariableDefinitions = r.rewriteVariableDefinitions(od.VariableDefinitions)
+	}
+
+	if od.Directives != nil {
+		od.Directives = r.rewriteDirectives(od.Directives)
+	}
+
+	if od.SelectionSet != nil {
+		od.SelectionSet = r.rewriteSelections(od.SelectionSet)
+	}
+
+	for _, handler := range r.mutationOperationDefinitionLeave {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// NamedTypeRewriteHandler function can handle enter/leave events for NamedType.
+type NamedTypeRewriteHandler func(*Cursor, *Type)
+
+// AddNamedTypeEnterHandler adds a handler to be called when entering NamedType nodes.
+func (r *Rewriter) AddNamedTypeEnterHandler(h NamedTypeRewriteHandler) {
+	r.namedTypeEnter = append(r.namedTypeEnter, h)
+}
+
+// AddNamedTypeLeaveHandler adds a handler to be called when leaving NamedType nodes.
+func (r *Rewriter) AddNamedTypeLeaveHandler(h NamedTypeRewriteHandler) {
+	r.namedTypeLeave = append(r.namedTypeLeave, h)
+}
+
+// rewriteNamedType is a function that rewrites NamedType type's AST node.
+func (r *Rewriter) rewriteNamedType(cursor *Cursor, t *Type) {
+	for _, handler := range r.namedTypeEnter {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if t.ListType != nil {
+		r.rewriteType(nil, t.ListType)
+	}
+
+	for _, handler := range r.namedTypeLeave {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// NullValueRewriteHandler function can handle enter/leave events for NullValue.
+type NullValueRewriteHandler func(*Cursor, *Value)
+
+// AddNullValueEnterHandler adds a handler to be called when entering NullValue nodes.
+func (r *Rewriter) AddNullValueEnterHandler(h NullValueRewriteHandler) {
+	r.nullValueEnter = append(r.nullValueEnter, h)
+}
+
+// AddNullValueLeaveHandler adds a handler to be called when leaving NullValue nodes.
+func (r *Rewriter) AddNullValueLeaveHandler(h NullValueRewriteHandler) {
+	r.nullValueLeave = append(r.nullValueLeave, h)
+}
+
+// rewriteNullValue is a function that rewrites NullValue type's AST node.
+func (r *Rewriter) rewriteNullValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.nullValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.nullValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ObjectFieldRewriteHandler function can handle enter/leave events for ObjectField.
+type ObjectFieldRewriteHandler func(*Cursor, *ObjectField)
+
+// AddObjectFieldEnterHandler adds a handler to be called when entering ObjectField nodes.
+func (r *Rewriter) AddObjectFieldEnterHandler(h ObjectFieldRewriteHandler) {
+	r.objectFieldEnter = append(r.objectFieldEnter, h)
+}
+
+// AddObjectFieldLeaveHandler adds a handler to be called when leaving ObjectField nodes.
+func (r *Rewriter) AddObjectFieldLeaveHandler(h ObjectFieldRewriteHandler) {
+	r.objectFieldLeave = append(r.objectFieldLeave, h)
+}
+
+// rewriteObjectField is a function that rewrites ObjectField type's AST node.
+func (r *Rewriter) rewriteObjectField(cursor *Cursor, of *ObjectField) {
+	for _, handler := range r.objectFieldEnter {
+		if handler(cursor, of); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteValue(nil, &of.Value)
+
+	for _, handler := range r.objectFieldLeave {
+		if handler(cursor, of); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ObjectTypeDefinitionRewriteHandler function can handle enter/leave events for ObjectTypeDefinition.
+type ObjectTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddObjectTypeDefinitionEnterHandler adds a handler to be called when entering ObjectTypeDefinition nodes.
+func (r *Rewriter) AddObjectTypeDefinitionEnterHandler(h ObjectTypeDefinitionRewriteHandler) {
+	r.objectTypeDefinitionEnter = append(r.objectTypeDefinitionEnter, h)
+}
+
+// AddObjectTypeDefinitionLeaveHandler adds a handler to be called when leaving ObjectTypeDefinition nodes.
+func (r *Rewriter) AddObjectTypeDefinitionLeaveHandler(h ObjectTypeDefinitionRewriteHandler) {
+	r.objectTypeDefinitionLeave = append(r.objectTypeDefinitionLeave, h)
+}
+
+// rewriteObjectTypeDefinition is a function that rewrites ObjectTypeDefinition type's AST node.
+func (r *Rewriter) rewriteObjectTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.objectTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.objectTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ObjectTypeExtensionRewriteHandler function can handle enter/leave events for ObjectTypeExtension.
+type ObjectTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddObjectTypeExtensionEnterHandler adds a handler to be called when entering ObjectTypeExtension nodes.
+func (r *Rewriter) AddObjectTypeExtensionEnterHandler(h ObjectTypeExtensionRewriteHandler) {
+	r.objectTypeExtensionEnter = append(r.objectTypeExtensionEnter, h)
+}
+
+// AddObjectTypeExtensionLeaveHandler adds a handler to be called when leaving ObjectTypeExtension nodes.
+func (r *Rewriter) AddObjectTypeExtensionLeaveHandler(h ObjectTypeExtensionRewriteHandler) {
+	r.objectTypeExtensionLeave = append(r.objectTypeExtensionLeave, h)
+}
+
+// rewriteObjectTypeExtension is a function that rewrites ObjectTypeExtension type's AST node.
+func (r *Rewriter) rewriteObjectTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.objectTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.objectTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ObjectValueRewriteHandler function can handle enter/leave events for ObjectValue.
+type ObjectValueRewriteHandler func(*Cursor, *Value)
+
+// AddObjectValueEnterHandler adds a handler to be called when entering ObjectValue nodes.
+func (r *Rewriter) AddObjectValueEnterHandler(h ObjectValueRewriteHandler) {
+	r.objectValueEnter = append(r.objectValueEnter, h)
+}
+
+// AddObjectValueLeaveHandler adds a handler to be called when leaving ObjectValue nodes.
+func (r *Rewriter) AddObjectValueLeaveHandler(h ObjectValueRewriteHandler) {
+	r.objectValueLeave = append(r.objectValueLeave, h)
+}
+
+// rewriteObjectValue is a function that rewrites ObjectValue type's AST node.
+func (r *Rewriter) rewriteObjectValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.objectValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	v.ObjectValue = r.rewriteObjectFieldSlice(v.ObjectValue)
+
+	for _, handler := range r.objectValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// OperationDefinitionRewriteHandler function can handle enter/leave events for OperationDefinition.
+type OperationDefinitionRewriteHandler func(*Cursor, *OperationDefinition)
+
+// AddOperationDefinitionEnterHandler adds a handler to be called when entering OperationDefinition nodes.
+func (r *Rewriter) AddOperationDefinitionEnterHandler(h OperationDefinitionRewriteHandler) {
+	r.operationDefinitionEnter = append(r.operationDefinitionEnter, h)
+}
+
+// AddOperationDefinitionLeaveHandler adds a handler to be called when leaving OperationDefinition nodes.
+func (r *Rewriter) AddOperationDefinitionLeaveHandler(h OperationDefinitionRewriteHandler) {
+	r.operationDefinitionLeave = append(r.operationDefinitionLeave, h)
+}
+
+// rewriteOperationDefinition is a function that rewrites OperationDefinition type's AST node.
+func (r *Rewriter) rewriteOperationDefinition(cursor *Cursor, od *OperationDefinition) {
+	for _, handler := range r.operationDefinitionEnter {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch od.Kind {
+	case OperationDefinitionKindMutation:
+		r.rewriteMutationOperationDefinition(cursor, od)
+	case OperationDefinitionKindQuery:
+		r.rewriteQueryOperationDefinition(cursor, od)
+	case OperationDefinitionKindSubscription:
+		r.rewriteSubscriptionOperationDefinition(cursor, od)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.operationDefinitionLeave {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// OperationTypeDefinitionRewriteHandler function can handle enter/leave events for OperationTypeDefinition.
+type OperationTypeDefinitionRewriteHandler func(*Cursor, *OperationTypeDefinition)
+
+// AddOperationTypeDefinitionEnterHandler adds a handler to be called when entering OperationTypeDefinition nodes.
+func (r *Rewriter) AddOperationTypeDefinitionEnterHandler(h OperationTypeDefinitionRewriteHandler) {
+	r.operationTypeDefinitionEnter = append(r.operationTypeDefinitionEnter, h)
+}
+
+// AddOperationTypeDefinitionLeaveHandler adds a handler to be called when leaving OperationTypeDefinition nodes.
+func (r *Rewriter) AddOperationTypeDefinitionLeaveHandler(h OperationTypeDefinitionRewriteHandler) {
+	r.operationTypeDefinitionLeave = append(r.operationTypeDefinitionLeave, h)
+}
+
+// rewriteOperationTypeDefinition is a function that rewrites OperationTypeDefinition type's AST node.
+func (r *Rewriter) rewriteOperationTypeDefinition(cursor *Cursor, otd *OperationTypeDefinition) {
+	for _, handler := range r.operationTypeDefinitionEnter {
+		if handler(cursor, otd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteType(nil, &otd.NamedType)
+
+	for _, handler := range r.operationTypeDefinitionLeave {
+		if handler(cursor, otd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// OperationTypeDefinitionsRewriteHandler function can handle enter/leave events for OperationTypeDefinitions.
+type OperationTypeDefinitionsRewriteHandler func(*Cursor, *OperationTypeDefinitions)
+
+// AddOperationTypeDefinitionsEnterHandler adds a handler to be called when entering OperationTypeDefinitions nodes.
+func (r *Rewriter) AddOperationTypeDefinitionsEnterHandler(h OperationTypeDefinitionsRewriteHandler) {
+	r.operationTypeDefinitionsEnter = append(r.operationTypeDefinitionsEnter, h)
+}
+
+// AddOperationTypeDefinitionsLeaveHandler adds a handler to be called when leaving OperationTypeDefinitions nodes.
+func (r *Rewriter) AddOperationTypeDefinitionsLeaveHandler(h OperationTypeDefinitionsRewriteHandler) {
+	r.operationTypeDefinitionsLeave = append(r.operationTypeDefinitionsLeave, h)
+}
+
+// rewriteOperationTypeDefinitions is a function that rewrites OperationTypeDefinitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteOperationTypeDefinitions(otds *OperationTypeDefinitions) *OperationTypeDefinitions {
+	for _, handler := range r.operationTypeDefinitionsEnter {
+		handler(nil, otds)
+	}
+
+	var rewritten *OperationTypeDefinitions
+	var changed bool
+
+	for current := otds; current != nil; current = current.next {
+		cursor := Cursor{elem: "OperationTypeDefinition"}
+		r.rewriteOperationTypeDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := otds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(OperationTypeDefinition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(OperationTypeDefinition))
+		}
+	}
+
+	if changed {
+		otds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.operationTypeDefinitionsLeave {
+		handler(nil, otds)
+	}
+
+	return otds
+}
+
+// PathNodeRewriteHandler function can handle enter/leave events for PathNode.
+type PathNodeRewriteHandler func(*Cursor, *PathNode)
+
+// AddPathNodeEnterHandler adds a handler to be called when entering PathNode nodes.
+func (r *Rewriter) AddPathNodeEnterHandler(h PathNodeRewriteHandler) {
+	r.pathNodeEnter = append(r.pathNodeEnter, h)
+}
+
+// AddPathNodeLeaveHandler adds a handler to be called when leaving PathNode nodes.
+func (r *Rewriter) AddPathNodeLeaveHandler(h PathNodeRewriteHandler) {
+	r.pathNodeLeave = append(r.pathNodeLeave, h)
+}
+
+// rewritePathNode is a function that rewrites PathNode type's AST node.
+func (r *Rewriter) rewritePathNode(cursor *Cursor, pn *PathNode) {
+	for _, handler := range r.pathNodeEnter {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch pn.Kind {
+	case PathNodeKindInt:
+		r.rewriteIntPathNode(cursor, pn)
+	case PathNodeKindString:
+		r.rewriteStringPathNode(cursor, pn)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.pathNodeLeave {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// PathNodesRewriteHandler function can handle enter/leave events for PathNodes.
+type PathNodesRewriteHandler func(*Cursor, *PathNodes)
+
+// AddPathNodesEnterHandler adds a handler to be called when entering PathNodes nodes.
+func (r *Rewriter) AddPathNodesEnterHandler(h PathNodesRewriteHandler) {
+	r.pathNodesEnter = append(r.pathNodesEnter, h)
+}
+
+// AddPathNodesLeaveHandler adds a handler to be called when leaving PathNodes nodes.
+func (r *Rewriter) AddPathNodesLeaveHandler(h PathNodesRewriteHandler) {
+	r.pathNodesLeave = append(r.pathNodesLeave, h)
+}
+
+// rewritePathNodes is a function that rewrites PathNodes type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewritePathNodes(pns *PathNodes) *PathNodes {
+	for _, handler := range r.pathNodesEnter {
+		handler(nil, pns)
+	}
+
+	var rewritten *PathNodes
+	var changed bool
+
+	for current := pns; current != nil; current = current.next {
+		cursor := Cursor{elem: "PathNode"}
+		r.rewritePathNode(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := pns; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(PathNode))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(PathNode))
+		}
+	}
+
+	if changed {
+		pns = rewritten.Reverse()
+	}
+
+	for _, handler := range r.pathNodesLeave {
+		handler(nil, pns)
+	}
+
+	return pns
+}
+
+// PositionRewriteHandler function can handle enter/leave events for Position.
+type PositionRewriteHandler func(*Cursor, *Position)
+
+// AddPositionEnterHandler adds a handler to be called when entering Position nodes.
+func (r *Rewriter) AddPositionEnterHandler(h PositionRewriteHandler) {
+	r.positionEnter = append(r.positionEnter, h)
+}
+
+// AddPositionLeaveHandler adds a handler to be called when leaving Position nodes.
+func (r *Rewriter) AddPositionLeaveHandler(h PositionRewriteHandler) {
+	r.positionLeave = append(r.positionLeave, h)
+}
+
+// rewritePosition is a function that rewrites Position type's AST node.
+func (r *Rewriter) rewritePosition(cursor *Cursor, p *Position) {
+	for _, handler := range r.positionEnter {
+		if handler(cursor, p); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.positionLeave {
+		if handler(cursor, p); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// PositionsRewriteHandler function can handle enter/leave events for Positions.
+type PositionsRewriteHandler func(*Cursor, *Positions)
+
+// AddPositionsEnterHandler adds a handler to be called when entering Positions nodes.
+func (r *Rewriter) AddPositionsEnterHandler(h PositionsRewriteHandler) {
+	r.positionsEnter = append(r.positionsEnter, h)
+}
+
+// AddPositionsLeaveHandler adds a handler to be called when leaving Positions nodes.
+func (r *Rewriter) AddPositionsLeaveHandler(h PositionsRewriteHandler) {
+	r.positionsLeave = append(r.positionsLeave, h)
+}
+
+// rewritePositions is a function that rewrites Positions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewritePositions(ps *Positions) *Positions {
+	for _, handler := range r.positionsEnter {
+		handler(nil, ps)
+	}
+
+	var rewritten *Positions
+	var changed bool
+
+	for current := ps; current != nil; current = current.next {
+		cursor := Cursor{elem: "Position"}
+		r.rewritePosition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ps; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Position))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Position))
+		}
+	}
+
+	if changed {
+		ps = rewritten.Reverse()
+	}
+
+	for _, handler := range r.positionsLeave {
+		handler(nil, ps)
+	}
+
+	return ps
+}
+
+// QueryOperationDefinitionRewriteHandler function can handle enter/leave events for QueryOperationDefinition.
+type QueryOperationDefinitionRewriteHandler func(*Cursor, *OperationDefinition)
+
+// AddQueryOperationDefinitionEnterHandler adds a handler to be called when entering QueryOperationDefinition nodes.
+func (r *Rewriter) AddQueryOperationDefinitionEnterHandler(h QueryOperationDefinitionRewriteHandler) {
+	r.queryOperationDefinitionEnter = append(r.queryOperationDefinitionEnter, h)
+}
+
+// AddQueryOperationDefinitionLeaveHandler adds a handler to be called when leaving QueryOperationDefinition nodes.
+func (r *Rewriter) AddQueryOperationDefinitionLeaveHandler(h QueryOperationDefinitionRewriteHandler) {
+	r.queryOperationDefinitionLeave = append(r.queryOperationDefinitionLeave, h)
+}
+
+// rewriteQueryOperationDefinition is a function that rewrites QueryOperationDefinition type's AST node.
+func (r *Rewriter) rewriteQueryOperationDefinition(cursor *Cursor, od *OperationDefinition) {
+	for _, handler := range r.queryOperationDefinitionEnter {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if od.VariableDefinitions != nil {
+		od.VariableDefinitions = r.rewriteVariableDefinitions(od.VariableDefinitions)
+	}
+
+	if od.Directives != nil {
+		od.Directives = r.rewriteDirectives(od.Directives)
+	}
+
+	if od.SelectionSet != nil {
+		od.SelectionSet = r.rewriteSelections(od.SelectionSet)
+	}
+
+	for _, handler := range r.queryOperationDefinitionLeave {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ScalarTypeDefinitionRewriteHandler function can handle enter/leave events for ScalarTypeDefinition.
+type ScalarTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddScalarTypeDefinitionEnterHandler adds a handler to be called when entering ScalarTypeDefinition nodes.
+func (r *Rewriter) AddScalarTypeDefinitionEnterHandler(h ScalarTypeDefinitionRewriteHandler) {
+	r.scalarTypeDefinitionEnter = append(r.scalarTypeDefinitionEnter, h)
+}
+
+// AddScalarTypeDefinitionLeaveHandler adds a handler to be called when leaving ScalarTypeDefinition nodes.
+func (r *Rewriter) AddScalarTypeDefinitionLeaveHandler(h ScalarTypeDefinitionRewriteHandler) {
+	r.scalarTypeDefinitionLeave = append(r.scalarTypeDefinitionLeave, h)
+}
+
+// rewriteScalarTypeDefinition is a function that rewrites ScalarTypeDefinition type's AST node.
+func (r *Rewriter) rewriteScalarTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.scalarTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.scalarTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ScalarTypeExtensionRewriteHandler function can handle enter/leave events for ScalarTypeExtension.
+type ScalarTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddScalarTypeExtensionEnterHandler adds a handler to be called when entering ScalarTypeExtension nodes.
+func (r *Rewriter) AddScalarTypeExtensionEnterHandler(h ScalarTypeExtensionRewriteHandler) {
+	r.scalarTypeExtensionEnter = append(r.scalarTypeExtensionEnter, h)
+}
+
+// AddScalarTypeExtensionLeaveHandler adds a handler to be called when leaving ScalarTypeExtension nodes.
+func (r *Rewriter) AddScalarTypeExtensionLeaveHandler(h ScalarTypeExtensionRewriteHandler) {
+	r.scalarTypeExtensionLeave = append(r.scalarTypeExtensionLeave, h)
+}
+
+// rewriteScalarTypeExtension is a function that rewrites ScalarTypeExtension type's AST node.
+func (r *Rewriter) rewriteScalarTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.scalarTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.scalarTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// SchemaDefinitionRewriteHandler function can handle enter/leave events for SchemaDefinition.
+type SchemaDefinitionRewriteHandler func(*Cursor, *SchemaDefinition)
+
+// AddSchemaDefinitionEnterHandler adds a handler to be called when entering SchemaDefinition nodes.
+func (r *Rewriter) AddSchemaDefinitionEnterHandler(h SchemaDefinitionRewriteHandler) {
+	r.schemaDefinitionEnter = append(r.schemaDefinitionEnter, h)
+}
+
+// AddSchemaDefinitionLeaveHandler adds a handler to be called when leaving SchemaDefinition nodes.
+func (r *Rewriter) AddSchemaDefinitionLeaveHandler(h SchemaDefinitionRewriteHandler) {
+	r.schemaDefinitionLeave = append(r.schemaDefinitionLeave, h)
+}
+
+// rewriteSchemaDefinition is a function that rewrites SchemaDefinition type's AST node.
+func (r *Rewriter) rewriteSchemaDefinition(cursor *Cursor, sd *SchemaDefinition) {
+	for _, handler := range r.schemaDefinitionEnter {
+		if handler(cursor, sd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if sd.Directives != nil {
+		sd.Directives = r.rewriteDirectives(sd.Directives)
+	}
+
+	if sd.OperationTypeDefinitions != nil {
+		sd.OperationTypeDefinitions = r.rewriteOperationTypeDefinitions(sd.OperationTypeDefinitions)
+	}
+
+	for _, handler := range r.schemaDefinitionLeave {
+		if handler(cursor, sd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// SchemaExtensionRewriteHandler function can handle enter/leave events for SchemaExtension.
+type SchemaExtensionRewriteHandler func(*Cursor, *SchemaExtension)
+
+// AddSchemaExtensionEnterHandler adds a handler to be called when entering SchemaExtension nodes.
+func (r *Rewriter) AddSchemaExtensionEnterHandler(h SchemaExtensionRewriteHandler) {
+	r.schemaExtensionEnter = append(r.schemaExtensionEnter, h)
+}
+
+// AddSchemaExtensionLeaveHandler adds a handler to be called when leaving SchemaExtension nodes.
+func (r *Rewriter) AddSchemaExtensionLeaveHandler(h SchemaExtensionRewriteHandler) {
+	r.schemaExtensionLeave = append(r.schemaExtensionLeave, h)
+}
+
+// rewriteSchemaExtension is a function that rewrites SchemaExtension type's AST node.
+func (r *Rewriter) rewriteSchemaExtension(cursor *Cursor, se *SchemaExtension) {
+	for _, handler := range r.schemaExtensionEnter {
+		if handler(cursor, se); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if se.Directives != nil {
+		se.Directives = r.rewriteDirectives(se.Directives)
+	}
+
+	if se.OperationTypeDefinitions != nil {
+		se.OperationTypeDefinitions = r.rewriteOperationTypeDefinitions(se.OperationTypeDefinitions)
+	}
+
+	for _, handler := range r.schemaExtensionLeave {
+		if handler(cursor, se); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// SelectionRewriteHandler function can handle enter/leave events for Selection.
+type SelectionRewriteHandler func(*Cursor, *Selection)
+
+// AddSelectionEnterHandler adds a handler to be called when entering Selection nodes.
+func (r *Rewriter) AddSelectionEnterHandler(h SelectionRewriteHandler) {
+	r.selectionEnter = append(r.selectionEnter, h)
+}
+
+// AddSelectionLeaveHandler adds a handler to be called when leaving Selection nodes.
+func (r *Rewriter) AddSelectionLeaveHandler(h SelectionRewriteHandler) {
+	r.selectionLeave = append(r.selectionLeave, h)
+}
+
+// rewriteSelection is a function that rewrites Selection type's AST node.
+func (r *Rewriter) rewriteSelection(cursor *Cursor, s *Selection) {
+	for _, handler := range r.selectionEnter {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch s.Kind {
+	case SelectionKindField:
+		r.rewriteFieldSelection(cursor, s)
+	case SelectionKindFragmentSpread:
+		r.rewriteFragmentSpreadSelection(cursor, s)
+	case SelectionKindInlineFragment:
+		r.rewriteInlineFragmentSelection(cursor, s)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.selectionLeave {
+		if handler(cursor, s); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// SelectionsRewriteHandler function can handle enter/leave events for Selections.
+type SelectionsRewriteHandler func(*Cursor, *Selections)
+
+// AddSelectionsEnterHandler adds a handler to be called when entering Selections nodes.
+func (r *Rewriter) AddSelectionsEnterHandler(h SelectionsRewriteHandler) {
+	r.selectionsEnter = append(r.selectionsEnter, h)
+}
+
+// AddSelectionsLeaveHandler adds a handler to be called when leaving Selections nodes.
+func (r *Rewriter) AddSelectionsLeaveHandler(h SelectionsRewriteHandler) {
+	r.selectionsLeave = append(r.selectionsLeave, h)
+}
+
+// rewriteSelections is a function that rewrites Selections type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteSelections(ss *Selections) *Selections {
+	for _, handler := range r.selectionsEnter {
+		handler(nil, ss)
+	}
+
+	var rewritten *Selections
+	var changed bool
+
+	for current := ss; current != nil; current = current.next {
+		cursor := Cursor{elem: "Selection"}
+		r.rewriteSelection(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ss; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Selection))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Selection))
+		}
+	}
+
+	if changed {
+		ss = rewritten.Reverse()
+	}
+
+	for _, handler := range r.selectionsLeave {
+		handler(nil, ss)
+	}
+
+	return ss
+}
+
+// StringPathNodeRewriteHandler function can handle enter/leave events for StringPathNode.
+type StringPathNodeRewriteHandler func(*Cursor, *PathNode)
+
+// AddStringPathNodeEnterHandler adds a handler to be called when entering StringPathNode nodes.
+func (r *Rewriter) AddStringPathNodeEnterHandler(h StringPathNodeRewriteHandler) {
+	r.stringPathNodeEnter = append(r.stringPathNodeEnter, h)
+}
+
+// AddStringPathNodeLeaveHandler adds a handler to be called when leaving StringPathNode nodes.
+func (r *Rewriter) AddStringPathNodeLeaveHandler(h StringPathNodeRewriteHandler) {
+	r.stringPathNodeLeave = append(r.stringPathNodeLeave, h)
+}
+
+// rewriteStringPathNode is a function that rewrites StringPathNode type's AST node.
+func (r *Rewriter) rewriteStringPathNode(cursor *Cursor, pn *PathNode) {
+	for _, handler := range r.stringPathNodeEnter {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.stringPathNodeLeave {
+		if handler(cursor, pn); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// StringValueRewriteHandler function can handle enter/leave events for StringValue.
+type StringValueRewriteHandler func(*Cursor, *Value)
+
+// AddStringValueEnterHandler adds a handler to be called when entering StringValue nodes.
+func (r *Rewriter) AddStringValueEnterHandler(h StringValueRewriteHandler) {
+	r.stringValueEnter = append(r.stringValueEnter, h)
+}
+
+// AddStringValueLeaveHandler adds a handler to be called when leaving StringValue nodes.
+func (r *Rewriter) AddStringValueLeaveHandler(h StringValueRewriteHandler) {
+	r.stringValueLeave = append(r.stringValueLeave, h)
+}
+
+// rewriteStringValue is a function that rewrites StringValue type's AST node.
+func (r *Rewriter) rewriteStringValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.stringValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.stringValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// SubscriptionOperationDefinitionRewriteHandler function can handle enter/leave events for SubscriptionOperationDefinition.
+type SubscriptionOperationDefinitionRewriteHandler func(*Cursor, *OperationDefinition)
+
+// AddSubscriptionOperationDefinitionEnterHandler adds a handler to be called when entering SubscriptionOperationDefinition nodes.
+func (r *Rewriter) AddSubscriptionOperationDefinitionEnterHandler(h SubscriptionOperationDefinitionRewriteHandler) {
+	r.subscriptionOperationDefinitionEnter = append(r.subscriptionOperationDefinitionEnter, h)
+}
+
+// AddSubscriptionOperationDefinitionLeaveHandler adds a handler to be called when leaving SubscriptionOperationDefinition nodes.
+func (r *Rewriter) AddSubscriptionOperationDefinitionLeaveHandler(h SubscriptionOperationDefinitionRewriteHandler) {
+	r.subscriptionOperationDefinitionLeave = append(r.subscriptionOperationDefinitionLeave, h)
+}
+
+// rewriteSubscriptionOperationDefinition is a function that rewrites SubscriptionOperationDefinition type's AST node.
+func (r *Rewriter) rewriteSubscriptionOperationDefinition(cursor *Cursor, od *OperationDefinition) {
+	for _, handler := range r.subscriptionOperationDefinitionEnter {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if od.VariableDefinitions != nil {
+		od.VariableDefinitions = r.rewriteVariableDefinitions(od.VariableDefinitions)
+	}
+
+	if od.Directives != nil {
+		od.Directives = r.rewriteDirectives(od.Directives)
+	}
+
+	if od.SelectionSet != nil {
+		od.SelectionSet = r.rewriteSelections(od.SelectionSet)
+	}
+
+	for _, handler := range r.subscriptionOperationDefinitionLeave {
+		if handler(cursor, od); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeRewriteHandler function can handle enter/leave events for Type.
+type TypeRewriteHandler func(*Cursor, *Type)
+
+// AddTypeEnterHandler adds a handler to be called when entering Type nodes.
+func (r *Rewriter) AddTypeEnterHandler(h TypeRewriteHandler) {
+	r.typeEnter = append(r.typeEnter, h)
+}
+
+// AddTypeLeaveHandler adds a handler to be called when leaving Type nodes.
+func (r *Rewriter) AddTypeLeaveHandler(h TypeRewriteHandler) {
+	r.typeLeave = append(r.typeLeave, h)
+}
+
+// rewriteType is a function that rewrites Type type's AST node.
+func (r *Rewriter) rewriteType(cursor *Cursor, t *Type) {
+	for _, handler := range r.typeEnter {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch t.Kind {
+	case TypeKindList:
+		r.rewriteListType(cursor, t)
+	case TypeKindNamed:
+		r.rewriteNamedType(cursor, t)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.typeLeave {
+		if handler(cursor, t); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeConditionRewriteHandler function can handle enter/leave events for TypeCondition.
+type TypeConditionRewriteHandler func(*Cursor, *TypeCondition)
+
+// AddTypeConditionEnterHandler adds a handler to be called when entering TypeCondition nodes.
+func (r *Rewriter) AddTypeConditionEnterHandler(h TypeConditionRewriteHandler) {
+	r.typeConditionEnter = append(r.typeConditionEnter, h)
+}
+
+// AddTypeConditionLeaveHandler adds a handler to be called when leaving TypeCondition nodes.
+func (r *Rewriter) AddTypeConditionLeaveHandler(h TypeConditionRewriteHandler) {
+	r.typeConditionLeave = append(r.typeConditionLeave, h)
+}
+
+// rewriteTypeCondition is a function that rewrites TypeCondition type's AST node.
+func (r *Rewriter) rewriteTypeCondition(cursor *Cursor, tc *TypeCondition) {
+	for _, handler := range r.typeConditionEnter {
+		if handler(cursor, tc); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteType(nil, &tc.NamedType)
+
+	for _, handler := range r.typeConditionLeave {
+		if handler(cursor, tc); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeDefinitionRewriteHandler function can handle enter/leave events for TypeDefinition.
+type TypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddTypeDefinitionEnterHandler adds a handler to be called when entering TypeDefinition nodes.
+func (r *Rewriter) AddTypeDefinitionEnterHandler(h TypeDefinitionRewriteHandler) {
+	r.typeDefinitionEnter = append(r.typeDefinitionEnter, h)
+}
+
+// AddTypeDefinitionLeaveHandler adds a handler to be called when leaving TypeDefinition nodes.
+func (r *Rewriter) AddTypeDefinitionLeaveHandler(h TypeDefinitionRewriteHandler) {
+	r.typeDefinitionLeave = append(r.typeDefinitionLeave, h)
+}
+
+// rewriteTypeDefinition is a function that rewrites TypeDefinition type's AST node.
+func (r *Rewriter) rewriteTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.typeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch td.Kind {
+	case TypeDefinitionKindEnum:
+		r.rewriteEnumTypeDefinition(cursor, td)
+	case TypeDefinitionKindInputObject:
+		r.rewriteInputObjectTypeDefinition(cursor, td)
+	case TypeDefinitionKindInterface:
+		r.rewriteInterfaceTypeDefinition(cursor, td)
+	case TypeDefinitionKindObject:
+		r.rewriteObjectTypeDefinition(cursor, td)
+	case TypeDefinitionKindScalar:
+		r.rewriteScalarTypeDefinition(cursor, td)
+	case TypeDefinitionKindUnion:
+		r.rewriteUnionTypeDefinition(cursor, td)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.typeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeExtensionRewriteHandler function can handle enter/leave events for TypeExtension.
+type TypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddTypeExtensionEnterHandler adds a handler to be called when entering TypeExtension nodes.
+func (r *Rewriter) AddTypeExtensionEnterHandler(h TypeExtensionRewriteHandler) {
+	r.typeExtensionEnter = append(r.typeExtensionEnter, h)
+}
+
+// AddTypeExtensionLeaveHandler adds a handler to be called when leaving TypeExtension nodes.
+func (r *Rewriter) AddTypeExtensionLeaveHandler(h TypeExtensionRewriteHandler) {
+	r.typeExtensionLeave = append(r.typeExtensionLeave, h)
+}
+
+// rewriteTypeExtension is a function that rewrites TypeExtension type's AST node.
+func (r *Rewriter) rewriteTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.typeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch te.Kind {
+	case TypeExtensionKindEnum:
+		r.rewriteEnumTypeExtension(cursor, te)
+	case TypeExtensionKindInputObject:
+		r.rewriteInputObjectTypeExtension(cursor, te)
+	case TypeExtensionKindInterface:
+		r.rewriteInterfaceTypeExtension(cursor, te)
+	case TypeExtensionKindObject:
+		r.rewriteObjectTypeExtension(cursor, te)
+	case TypeExtensionKindScalar:
+		r.rewriteScalarTypeExtension(cursor, te)
+	case TypeExtensionKindUnion:
+		r.rewriteUnionTypeExtension(cursor, te)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.typeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeSystemDefinitionRewriteHandler function can handle enter/leave events for TypeSystemDefinition.
+type TypeSystemDefinitionRewriteHandler func(*Cursor, *TypeSystemDefinition)
+
+// AddTypeSystemDefinitionEnterHandler adds a handler to be called when entering TypeSystemDefinition nodes.
+func (r *Rewriter) AddTypeSystemDefinitionEnterHandler(h TypeSystemDefinitionRewriteHandler) {
+	r.typeSystemDefinitionEnter = append(r.typeSystemDefinitionEnter, h)
+}
+
+// AddTypeSystemDefinitionLeaveHandler adds a handler to be called when leaving TypeSystemDefinition nodes.
+func (r *Rewriter) AddTypeSystemDefinitionLeaveHandler(h TypeSystemDefinitionRewriteHandler) {
+	r.typeSystemDefinitionLeave = append(r.typeSystemDefinitionLeave, h)
+}
+
+// rewriteTypeSystemDefinition is a function that rewrites TypeSystemDefinition type's AST node.
+func (r *Rewriter) rewriteTypeSystemDefinition(cursor *Cursor, tsd *TypeSystemDefinition) {
+	for _, handler := range r.typeSystemDefinitionEnter {
+		if handler(cursor, tsd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch tsd.Kind {
+	case TypeSystemDefinitionKindDirective:
+		r.rewriteDirectiveDefinition(cursor, tsd.DirectiveDefinition)
+	case TypeSystemDefinitionKindSchema:
+		r.rewriteSchemaDefinition(cursor, tsd.SchemaDefinition)
+	case TypeSystemDefinitionKindType:
+		r.rewriteTypeDefinition(cursor, tsd.TypeDefinition)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.typeSystemDefinitionLeave {
+		if handler(cursor, tsd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypeSystemExtensionRewriteHandler function can handle enter/leave events for TypeSystemExtension.
+type TypeSystemExtensionRewriteHandler func(*Cursor, *TypeSystemExtension)
+
+// AddTypeSystemExtensionEnterHandler adds a handler to be called when entering TypeSystemExtension nodes.
+func (r *Rewriter) AddTypeSystemExtensionEnterHandler(h TypeSystemExtensionRewriteHandler) {
+	r.typeSystemExtensionEnter = append(r.typeSystemExtensionEnter, h)
+}
+
+// AddTypeSystemExtensionLeaveHandler adds a handler to be called when leaving TypeSystemExtension nodes.
+func (r *Rewriter) AddTypeSystemExtensionLeaveHandler(h TypeSystemExtensionRewriteHandler) {
+	r.typeSystemExtensionLeave = append(r.typeSystemExtensionLeave, h)
+}
+
+// rewriteTypeSystemExtension is a function that rewrites TypeSystemExtension type's AST node.
+func (r *Rewriter) rewriteTypeSystemExtension(cursor *Cursor, tse *TypeSystemExtension) {
+	for _, handler := range r.typeSystemExtensionEnter {
+		if handler(cursor, tse); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch tse.Kind {
+	case TypeSystemExtensionKindSchema:
+		r.rewriteSchemaExtension(cursor, tse.SchemaExtension)
+	case TypeSystemExtensionKindType:
+		r.rewriteTypeExtension(cursor, tse.TypeExtension)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.typeSystemExtensionLeave {
+		if handler(cursor, tse); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// TypesRewriteHandler function can handle enter/leave events for Types.
+type TypesRewriteHandler func(*Cursor, *Types)
+
+// AddTypesEnterHandler adds a handler to be called when entering Types nodes.
+func (r *Rewriter) AddTypesEnterHandler(h TypesRewriteHandler) {
+	r.typesEnter = append(r.typesEnter, h)
+}
+
+// AddTypesLeaveHandler adds a handler to be called when leaving Types nodes.
+func (r *Rewriter) AddTypesLeaveHandler(h TypesRewriteHandler) {
+	r.typesLeave = append(r.typesLeave, h)
+}
+
+// rewriteTypes is a function that rewrites Types type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteTypes(ts *Types) *Types {
+	for _, handler := range r.typesEnter {
+		handler(nil, ts)
+	}
+
+	var rewritten *Types
+	var changed bool
+
+	for current := ts; current != nil; current = current.next {
+		cursor := Cursor{elem: "Type"}
+		r.rewriteType(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := ts; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(Type))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(Type))
+		}
+	}
+
+	if changed {
+		ts = rewritten.Reverse()
+	}
+
+	for _, handler := range r.typesLeave {
+		handler(nil, ts)
+	}
+
+	return ts
+}
+
+// UnionTypeDefinitionRewriteHandler function can handle enter/leave events for UnionTypeDefinition.
+type UnionTypeDefinitionRewriteHandler func(*Cursor, *TypeDefinition)
+
+// AddUnionTypeDefinitionEnterHandler adds a handler to be called when entering UnionTypeDefinition nodes.
+func (r *Rewriter) AddUnionTypeDefinitionEnterHandler(h UnionTypeDefinitionRewriteHandler) {
+	r.unionTypeDefinitionEnter = append(r.unionTypeDefinitionEnter, h)
+}
+
+// AddUnionTypeDefinitionLeaveHandler adds a handler to be called when leaving UnionTypeDefinition nodes.
+func (r *Rewriter) AddUnionTypeDefinitionLeaveHandler(h UnionTypeDefinitionRewriteHandler) {
+	r.unionTypeDefinitionLeave = append(r.unionTypeDefinitionLeave, h)
+}
+
+// rewriteUnionTypeDefinition is a function that rewrites UnionTypeDefinition type's AST node.
+func (r *Rewriter) rewriteUnionTypeDefinition(cursor *Cursor, td *TypeDefinition) {
+	for _, handler := range r.unionTypeDefinitionEnter {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if td.ImplementsInterface != nil {
+		td.ImplementsInterface = r.rewriteTypes(td.ImplementsInterface)
+	}
+
+	if td.Directives != nil {
+		td.Directives = r.rewriteDirectives(td.Directives)
+	}
+
+	if td.FieldsDefinition != nil {
+		td.FieldsDefinition = r.rewriteFieldDefinitions(td.FieldsDefinition)
+	}
+
+	if td.UnionMemberTypes != nil {
+		td.UnionMemberTypes = r.rewriteTypes(td.UnionMemberTypes)
+	}
+
+	if td.EnumValuesDefinition != nil {
+		td.EnumValuesDefinition = r.rewriteEnumValueDefinitions(td.EnumValuesDefinition)
+	}
+
+	if td.InputFieldsDefinition != nil {
+		td.InputFieldsDefinition = r.rewriteInputValueDefinitions(td.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.unionTypeDefinitionLeave {
+		if handler(cursor, td); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// UnionTypeExtensionRewriteHandler function can handle enter/leave events for UnionTypeExtension.
+type UnionTypeExtensionRewriteHandler func(*Cursor, *TypeExtension)
+
+// AddUnionTypeExtensionEnterHandler adds a handler to be called when entering UnionTypeExtension nodes.
+func (r *Rewriter) AddUnionTypeExtensionEnterHandler(h UnionTypeExtensionRewriteHandler) {
+	r.unionTypeExtensionEnter = append(r.unionTypeExtensionEnter, h)
+}
+
+// AddUnionTypeExtensionLeaveHandler adds a handler to be called when leaving UnionTypeExtension nodes.
+func (r *Rewriter) AddUnionTypeExtensionLeaveHandler(h UnionTypeExtensionRewriteHandler) {
+	r.unionTypeExtensionLeave = append(r.unionTypeExtensionLeave, h)
+}
+
+// rewriteUnionTypeExtension is a function that rewrites UnionTypeExtension type's AST node.
+func (r *Rewriter) rewriteUnionTypeExtension(cursor *Cursor, te *TypeExtension) {
+	for _, handler := range r.unionTypeExtensionEnter {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+
+	if te.Directives != nil {
+		te.Directives = r.rewriteDirectives(te.Directives)
+	}
+
+	if te.ImplementsInterface != nil {
+		te.ImplementsInterface = r.rewriteTypes(te.ImplementsInterface)
+	}
+
+	if te.FieldsDefinition != nil {
+		te.FieldsDefinition = r.rewriteFieldDefinitions(te.FieldsDefinition)
+	}
+
+	if te.UnionMemberTypes != nil {
+		te.UnionMemberTypes = r.rewriteTypes(te.UnionMemberTypes)
+	}
+
+	if te.EnumValuesDefinition != nil {
+		te.EnumValuesDefinition = r.rewriteEnumValueDefinitions(te.EnumValuesDefinition)
+	}
+
+	if te.InputFieldsDefinition != nil {
+		te.InputFieldsDefinition = r.rewriteInputValueDefinitions(te.InputFieldsDefinition)
+	}
+
+	for _, handler := range r.unionTypeExtensionLeave {
+		if handler(cursor, te); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// ValueRewriteHandler function can handle enter/leave events for Value.
+type ValueRewriteHandler func(*Cursor, *Value)
+
+// AddValueEnterHandler adds a handler to be called when entering Value nodes.
+func (r *Rewriter) AddValueEnterHandler(h ValueRewriteHandler) {
+	r.valueEnter = append(r.valueEnter, h)
+}
+
+// AddValueLeaveHandler adds a handler to be called when leaving Value nodes.
+func (r *Rewriter) AddValueLeaveHandler(h ValueRewriteHandler) {
+	r.valueLeave = append(r.valueLeave, h)
+}
+
+// rewriteValue is a function that rewrites Value type's AST node.
+func (r *Rewriter) rewriteValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.valueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	switch v.Kind {
+	case ValueKindBoolean:
+		r.rewriteBooleanValue(cursor, v)
+	case ValueKindEnum:
+		r.rewriteEnumValue(cursor, v)
+	case ValueKindFloat:
+		r.rewriteFloatValue(cursor, v)
+	case ValueKindInt:
+		r.rewriteIntValue(cursor, v)
+	case ValueKindList:
+		r.rewriteListValue(cursor, v)
+	case ValueKindNull:
+		r.rewriteNullValue(cursor, v)
+	case ValueKindObject:
+		r.rewriteObjectValue(cursor, v)
+	case ValueKindString:
+		r.rewriteStringValue(cursor, v)
+	case ValueKindVariable:
+		r.rewriteVariableValue(cursor, v)
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+
+	for _, handler := range r.valueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// VariableDefinitionRewriteHandler function can handle enter/leave events for VariableDefinition.
+type VariableDefinitionRewriteHandler func(*Cursor, *VariableDefinition)
+
+// AddVariableDefinitionEnterHandler adds a handler to be called when entering VariableDefinition nodes.
+func (r *Rewriter) AddVariableDefinitionEnterHandler(h VariableDefinitionRewriteHandler) {
+	r.variableDefinitionEnter = append(r.variableDefinitionEnter, h)
+}
+
+// AddVariableDefinitionLeaveHandler adds a handler to be called when leaving VariableDefinition nodes.
+func (r *Rewriter) AddVariableDefinitionLeaveHandler(h VariableDefinitionRewriteHandler) {
+	r.variableDefinitionLeave = append(r.variableDefinitionLeave, h)
+}
+
+// rewriteVariableDefinition is a function that rewrites VariableDefinition type's AST node.
+func (r *Rewriter) rewriteVariableDefinition(cursor *Cursor, vd *VariableDefinition) {
+	for _, handler := range r.variableDefinitionEnter {
+		if handler(cursor, vd); cursor.isDeleted() {
+			return
+		}
+	}
+
+	r.rewriteType(nil, &vd.Type)
+
+	if vd.DefaultValue != nil {
+		r.rewriteValue(nil, vd.DefaultValue)
+	}
+
+	for _, handler := range r.variableDefinitionLeave {
+		if handler(cursor, vd); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// VariableDefinitionsRewriteHandler function can handle enter/leave events for VariableDefinitions.
+type VariableDefinitionsRewriteHandler func(*Cursor, *VariableDefinitions)
+
+// AddVariableDefinitionsEnterHandler adds a handler to be called when entering VariableDefinitions nodes.
+func (r *Rewriter) AddVariableDefinitionsEnterHandler(h VariableDefinitionsRewriteHandler) {
+	r.variableDefinitionsEnter = append(r.variableDefinitionsEnter, h)
+}
+
+// AddVariableDefinitionsLeaveHandler adds a handler to be called when leaving VariableDefinitions nodes.
+func (r *Rewriter) AddVariableDefinitionsLeaveHandler(h VariableDefinitionsRewriteHandler) {
+	r.variableDefinitionsLeave = append(r.variableDefinitionsLeave, h)
+}
+
+// rewriteVariableDefinitions is a function that rewrites VariableDefinitions type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewriteVariableDefinitions(vds *VariableDefinitions) *VariableDefinitions {
+	for _, handler := range r.variableDefinitionsEnter {
+		handler(nil, vds)
+	}
+
+	var rewritten *VariableDefinitions
+	var changed bool
+
+	for current := vds; current != nil; current = current.next {
+		cursor := Cursor{elem: "VariableDefinition"}
+		r.rewriteVariableDefinition(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := vds; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.(VariableDefinition))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.(VariableDefinition))
+		}
+	}
+
+	if changed {
+		vds = rewritten.Reverse()
+	}
+
+	for _, handler := range r.variableDefinitionsLeave {
+		handler(nil, vds)
+	}
+
+	return vds
+}
+
+// VariableValueRewriteHandler function can handle enter/leave events for VariableValue.
+type VariableValueRewriteHandler func(*Cursor, *Value)
+
+// AddVariableValueEnterHandler adds a handler to be called when entering VariableValue nodes.
+func (r *Rewriter) AddVariableValueEnterHandler(h VariableValueRewriteHandler) {
+	r.variableValueEnter = append(r.variableValueEnter, h)
+}
+
+// AddVariableValueLeaveHandler adds a handler to be called when leaving VariableValue nodes.
+func (r *Rewriter) AddVariableValueLeaveHandler(h VariableValueRewriteHandler) {
+	r.variableValueLeave = append(r.variableValueLeave, h)
+}
+
+// rewriteVariableValue is a function that rewrites VariableValue type's AST node.
+func (r *Rewriter) rewriteVariableValue(cursor *Cursor, v *Value) {
+	for _, handler := range r.variableValueEnter {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+
+	for _, handler := range r.variableValueLeave {
+		if handler(cursor, v); cursor.isDeleted() {
+			return
+		}
+	}
+}
+
+// rewriteObjectFieldSlice is a function that rewrites each ObjectField in a slice, returning
+// the rewritten slice.
+func (r *Rewriter) rewriteObjectFieldSlice(ofs []ObjectField) []ObjectField {
+	var rewritten []ObjectField
+	var changed bool
+
+	for i := range ofs {
+		cursor := Cursor{elem: "ObjectField"}
+		r.rewriteObjectField(&cursor, &ofs[i])
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+			rewritten = append(make([]ObjectField, 0, len(ofs)), ofs[:i]...)
+		}
+
+		for _, node := range cursor.before {
+			rewritten = append(rewritten, node.(ObjectField))
+		}
+
+		if !cursor.deleted {
+			rewritten = append(rewritten, ofs[i])
+		}
+
+		for _, node := range cursor.after {
+			rewritten = append(rewritten, node.(ObjectField))
+		}
+	}
+
+	if changed {
+		return rewritten
+	}
+
+	return ofs
+}
+
+// rewriteValueSlice is a function that rewrites each Value in a slice, returning
+// the rewritten slice.
+func (r *Rewriter) rewriteValueSlice(vs []Value) []Value {
+	var rewritten []Value
+	var changed bool
+
+	for i := range vs {
+		cursor := Cursor{elem: "Value"}
+		r.rewriteValue(&cursor, &vs[i])
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+			rewritten = append(make([]Value, 0, len(vs)), vs[:i]...)
+		}
+
+		for _, node := range cursor.before {
+			rewritten = append(rewritten, node.(Value))
+		}
+
+		if !cursor.deleted {
+			rewritten = append(rewritten, vs[i])
+		}
+
+		for _, node := range cursor.after {
+			rewritten = append(rewritten, node.(Value))
+		}
+	}
+
+	if changed {
+		return rewritten
+	}
+
+	return vs
+}
@@ -0,0 +1,221 @@
+package ast_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriter_Rewrite(t *testing.T) {
+	parse := func(t *testing.T, query string) ast.Document {
+		doc, err := language.NewParser([]byte(query)).Parse()
+		require.NoError(t, err)
+		return doc
+	}
+
+	tests := []struct {
+		msg      string
+		input    string
+		rewriter func(r *ast.Rewriter)
+		expected string
+	}{
+		{
+			msg: "modify nodes in place",
+			input: `{
+  foo(a: 1)
+  bar
+}`,
+			rewriter: func(r *ast.Rewriter) {
+				r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+					s.Alias = s.Name
+					s.Name = strings.ToUpper(s.Name)
+				})
+				r.AddIntValueEnterHandler(func(c *ast.Cursor, v *ast.Value) {
+					v.IntValue++
+					v.RawValue = strconv.Itoa(v.IntValue)
+				})
+			},
+			expected: `{
+  foo: FOO(a: 2)
+  bar: BAR
+}`,
+		},
+		{
+			msg: "delete nodes from lists",
+			input: `{
+  foo(a: 1, secret: 2, b: [1, null, 3])
+  secret {
+    bar
+  }
+  baz @secret
+}
+
+fragment Secret on Foo {
+  bar
+}`,
+			rewriter: func(r *ast.Rewriter) {
+				r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+					if s.Name == "secret" {
+						c.Delete()
+					}
+				})
+				r.AddArgumentEnterHandler(func(c *ast.Cursor, a *ast.Argument) {
+					if a.Name == "secret" {
+						c.Delete()
+					}
+				})
+				r.AddDirectiveEnterHandler(func(c *ast.Cursor, d *ast.Directive) {
+					if d.Name == "secret" {
+						c.Delete()
+					}
+				})
+				r.AddNullValueEnterHandler(func(c *ast.Cursor, v *ast.Value) {
+					if c.InList() {
+						c.Delete()
+					}
+				})
+				r.AddFragmentDefinitionEnterHandler(func(c *ast.Cursor, def *ast.FragmentDefinition) {
+					if def.Name == "Secret" {
+						c.Delete()
+					}
+				})
+			},
+			expected: `{
+  foo(a: 1, b: [1, 3])
+  baz
+}`,
+		},
+		{
+			msg: "insert nodes into lists",
+			input: `{
+  foo {
+    bar
+  }
+}`,
+			rewriter: func(r *ast.Rewriter) {
+				r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+					if s.SelectionSet == nil {
+						return
+					}
+
+					c.InsertBefore(ast.Selection{Kind: ast.SelectionKindField, Name: "before"})
+					c.InsertAfter(ast.Selection{Kind: ast.SelectionKindField, Name: "after"})
+
+					s.SelectionSet.Join((*ast.Selections)(nil).Add(ast.Selection{Kind: ast.SelectionKindField, Name: "__typename"}))
+				})
+			},
+			expected: `{
+  before
+  foo {
+    bar
+    __typename
+  }
+  after
+}`,
+		},
+		{
+			msg: "leave nodes that are not changed",
+			input: `{
+  foo
+}`,
+			rewriter: func(r *ast.Rewriter) {},
+			expected: `{
+  foo
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			r := &ast.Rewriter{}
+			test.rewriter(r)
+
+			actual := r.Rewrite(parse(t, test.input))
+
+			assert.Equal(t, test.expected, ast.Sdump(actual))
+		})
+	}
+
+	t.Run("should call handlers in order", func(t *testing.T) {
+		var events []string
+
+		r := &ast.Rewriter{}
+		r.AddSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+			events = append(events, "enter "+s.Name)
+		})
+		r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+			events = append(events, "enter field "+s.Name)
+			if s.Name == "baz" {
+				c.Delete()
+			}
+		})
+		r.AddSelectionLeaveHandler(func(c *ast.Cursor, s *ast.Selection) {
+			events = append(events, "leave "+s.Name)
+		})
+		r.AddSelectionsLeaveHandler(func(c *ast.Cursor, ss *ast.Selections) {
+			assert.False(t, c.InList())
+			events = append(events, "leave list")
+		})
+
+		r.Rewrite(parse(t, `{ foo { bar } baz }`))
+
+		expected := []string{
+			"enter foo", "enter field foo",
+			"enter bar", "enter field bar", "leave bar", "leave list",
+			"leave foo",
+			"enter baz", "enter field baz",
+			"leave list",
+		}
+
+		assert.Equal(t, expected, events)
+	})
+
+	t.Run("should update list lengths", func(t *testing.T) {
+		r := &ast.Rewriter{}
+		r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+			if s.Name == "foo" {
+				c.Delete()
+				c.InsertAfter(ast.Selection{Kind: ast.SelectionKindField, Name: "a"})
+				c.InsertAfter(ast.Selection{Kind: ast.SelectionKindField, Name: "b"})
+			}
+		})
+
+		doc := r.Rewrite(parse(t, `{ foo bar }`))
+
+		selections := doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet
+		require.Equal(t, 3, selections.Len())
+
+		var names []string
+		selections.ForEach(func(s ast.Selection, i int) {
+			names = append(names, s.Name)
+		})
+
+		assert.Equal(t, []string{"a", "b", "bar"}, names)
+	})
+
+	t.Run("should panic when deleting a node that isn't in a list", func(t *testing.T) {
+		r := &ast.Rewriter{}
+		r.AddNamedTypeEnterHandler(func(c *ast.Cursor, typ *ast.Type) {
+			c.Delete()
+		})
+
+		assert.Panics(t, func() {
+			r.Rewrite(parse(t, `query Foo($a: Int) { foo }`))
+		})
+	})
+	t.Run("should panic when inserting a node of the wrong type", func(t *testing.T) {
+		r := &ast.Rewriter{}
+		r.AddFieldSelectionEnterHandler(func(c *ast.Cursor, s *ast.Selection) {
+			c.InsertBefore(&ast.Selection{Kind: ast.SelectionKindField, Name: "a"})
+		})
+
+		assert.PanicsWithValue(t, "ast: Cursor.InsertBefore called with a *ast.Selection, in a list of ast.Selection", func() {
+			r.Rewrite(parse(t, `{ foo }`))
+		})
+	})
+}
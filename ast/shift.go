@@ -0,0 +1,136 @@
+package ast
+
+// ShiftLocations moves every location within this definition, including those of the nodes it
+// contains, by the given number of bytes. This is used to keep locations accurate when text is
+// inserted or removed before a definition, without parsing it again. Nodes are updated in place, so any other document sharing them will see the change too.
+func (d *Definition) ShiftLocations(bytes int) {
+	d.Loc.shift(bytes)
+	d.Comments.shift(bytes)
+
+	switch d.Kind {
+	case DefinitionKindExecutable:
+		switch def := d.ExecutableDefinition; def.Kind {
+		case ExecutableDefinitionKindOperation:
+			def.OperationDefinition.VariableDefinitions.shift(bytes)
+			def.OperationDefinition.Directives.shift(bytes)
+			def.OperationDefinition.SelectionSet.shift(bytes)
+		case ExecutableDefinitionKindFragment:
+			def.FragmentDefinition.VariableDefinitions.shift(bytes)
+			def.FragmentDefinition.Directives.shift(bytes)
+			def.FragmentDefinition.SelectionSet.shift(bytes)
+		}
+	case DefinitionKindTypeSystem:
+		switch def := d.TypeSystemDefinition; def.Kind {
+		case TypeSystemDefinitionKindSchema:
+			def.SchemaDefinition.Directives.shift(bytes)
+		case TypeSystemDefinitionKindType:
+			def.TypeDefinition.Directives.shift(bytes)
+			def.TypeDefinition.FieldsDefinition.shift(bytes)
+			def.TypeDefinition.EnumValuesDefinition.shift(bytes)
+			def.TypeDefinition.InputFieldsDefinition.shift(bytes)
+		case TypeSystemDefinitionKindDirective:
+			def.DirectiveDefinition.ArgumentsDefinition.shift(bytes)
+		}
+	case DefinitionKindTypeSystemExtension:
+		switch def := d.TypeSystemExtension; def.Kind {
+		case TypeSystemExtensionKindSchema:
+			def.SchemaExtension.Directives.shift(bytes)
+		case TypeSystemExtensionKindType:
+			def.TypeExtension.Loc.shift(bytes)
+			def.TypeExtension.Directives.shift(bytes)
+			def.TypeExtension.FieldsDefinition.shift(bytes)
+			def.TypeExtension.EnumValuesDefinition.shift(bytes)
+			def.TypeExtension.InputFieldsDefinition.shift(bytes)
+		}
+	}
+}
+
+// shift moves this location by the given number of bytes, unless it's unset.
+func (l *Location) shift(bytes int) {
+	if *l == (Location{}) {
+		return
+	}
+
+	l.Start += bytes
+	l.End += bytes
+}
+
+func (cs *Comments) shift(bytes int) {
+	for ; cs != nil; cs = cs.next {
+		cs.Data.Loc.shift(bytes)
+	}
+}
+
+func (ds *Directives) shift(bytes int) {
+	for ; ds != nil; ds = ds.next {
+		ds.Data.Loc.shift(bytes)
+		ds.Data.Arguments.shift(bytes)
+	}
+}
+
+func (as *Arguments) shift(bytes int) {
+	for ; as != nil; as = as.next {
+		as.Data.Loc.shift(bytes)
+		as.Data.Value.shift(bytes)
+	}
+}
+
+func (vds *VariableDefinitions) shift(bytes int) {
+	for ; vds != nil; vds = vds.next {
+		vds.Data.Loc.shift(bytes)
+		vds.Data.DefaultValue.shift(bytes)
+	}
+}
+
+func (ss *Selections) shift(bytes int) {
+	for ; ss != nil; ss = ss.next {
+		ss.Data.Loc.shift(bytes)
+		ss.Data.Comments.shift(bytes)
+		ss.Data.Arguments.shift(bytes)
+		ss.Data.Directives.shift(bytes)
+		ss.Data.SelectionSet.shift(bytes)
+	}
+}
+
+func (fds *FieldDefinitions) shift(bytes int) {
+	for ; fds != nil; fds = fds.next {
+		fds.Data.Loc.shift(bytes)
+		fds.Data.Comments.shift(bytes)
+		fds.Data.ArgumentsDefinition.shift(bytes)
+		fds.Data.Directives.shift(bytes)
+	}
+}
+
+func (evds *EnumValueDefinitions) shift(bytes int) {
+	for ; evds != nil; evds = evds.next {
+		evds.Data.Loc.shift(bytes)
+		evds.Data.Comments.shift(bytes)
+		evds.Data.Directives.shift(bytes)
+	}
+}
+
+func (ivds *InputValueDefinitions) shift(bytes int) {
+	for ; ivds != nil; ivds = ivds.next {
+		ivds.Data.Loc.shift(bytes)
+		ivds.Data.Comments.shift(bytes)
+		ivds.Data.Directives.shift(bytes)
+		ivds.Data.DefaultValue.shift(bytes)
+	}
+}
+
+func (v *Value) shift(bytes int) {
+	if v == nil {
+		return
+	}
+
+	v.Loc.shift(bytes)
+
+	for i := range v.ListValue {
+		v.ListValue[i].shift(bytes)
+	}
+
+	for i := range v.ObjectValue {
+		v.ObjectValue[i].Loc.shift(bytes)
+		v.ObjectValue[i].Value.shift(bytes)
+	}
+}
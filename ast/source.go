@@ -0,0 +1,177 @@
+package ast
+
+import (
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+// ColumnUnit is a unit that columns can be measured in.
+type ColumnUnit int
+
+// ColumnUnit constants.
+const (
+	// ColumnUnitRunes counts columns in Unicode code points.
+	ColumnUnitRunes ColumnUnit = iota
+	// ColumnUnitBytes counts columns in bytes of UTF-8 encoded input.
+	ColumnUnitBytes
+	// ColumnUnitUTF16 counts columns in UTF-16 code units, as used by the Language Server Protocol.
+	ColumnUnitUTF16
+)
+
+// Source is one of the sources that a document was parsed from, e.g. a file. Documents parsed from
+// several sources are given one Source for each of them, and the offsets in their locations carry
+// on from one source to the next, so that each offset is only in one of them.
+//
+// Locations only hold offsets, and the lines and columns that they're at are found from the source
+// when they're needed, which keeps them small, and parsing fast. The lines of a source are indexed
+// the first time a position in it is needed, from its Body. Sources that are read incrementally
+// have no Body, and are indexed as they're read instead, with Write.
+type Source struct {
+	Name       string     // The name of the source, e.g. a file name, if known.
+	Base       int        // The offset of the start of the source in the document.
+	Body       []byte     // The input of the source, if it's held in memory.
+	ColumnUnit ColumnUnit // The unit that columns in the source are measured in.
+
+	once  sync.Once
+	size  int          // The number of bytes indexed so far.
+	cr    bool         // True if the last byte indexed was a carriage return.
+	lines []int        // The offsets of the start of each line after the first.
+	wide  []sourceRune // The characters that take up fewer columns than they do bytes.
+}
+
+// sourceRune is a character in a source that takes up fewer columns than it does bytes.
+type sourceRune struct {
+	offset int // The offset of the character in the source.
+	skip   int // The number of bytes of the character that don't count as a column.
+}
+
+// Write indexes the next part of the input of this source, which must end on a character boundary.
+// Sources that are read incrementally don't keep their input, so it must be written to them as it's
+// read, in order, for positions in them to be found. It never returns an error.
+func (s *Source) Write(p []byte) (int, error) {
+	for i := 0; i < len(p); {
+		b := p[i]
+
+		switch {
+		case b == '\n' && s.cr:
+			// The LF of a CRLF line terminator belongs to the same line as the CR.
+			s.lines[len(s.lines)-1]++
+		case b == '\n' || b == '\r':
+			s.lines = append(s.lines, s.size+i+1)
+		}
+
+		s.cr = b == '\r'
+
+		if b < utf8.RuneSelf {
+			i++
+			continue
+		}
+
+		r, w := utf8.DecodeRune(p[i:])
+
+		var columns int
+		switch {
+		case s.ColumnUnit == ColumnUnitBytes:
+			columns = w
+		case s.ColumnUnit == ColumnUnitUTF16 && r > 0xFFFF:
+			columns = 2 // Encoded as a surrogate pair.
+		default:
+			columns = 1
+		}
+
+		if columns < w {
+			s.wide = append(s.wide, sourceRune{offset: s.size + i, skip: w - columns})
+		}
+
+		i += w
+	}
+
+	s.size += len(p)
+
+	return len(p), nil
+}
+
+// index indexes the Body of this source, the first time that it's called.
+func (s *Source) index() {
+	s.once.Do(func() {
+		if s.Body != nil {
+			s.Write(s.Body)
+		}
+	})
+}
+
+// position returns the line and column of the given offset in this source.
+func (s *Source) position(offset int) (line, column int) {
+	s.index()
+
+	offset -= s.Base
+
+	// The number of lines that start at, or before, the offset is the index of its line.
+	line = sort.Search(len(s.lines), func(i int) bool {
+		return s.lines[i] > offset
+	})
+
+	var start int
+	if line > 0 {
+		start = s.lines[line-1]
+	}
+
+	column = offset - start
+
+	i := sort.Search(len(s.wide), func(i int) bool {
+		return s.wide[i].offset >= start
+	})
+
+	for ; i < len(s.wide) && s.wide[i].offset < offset; i++ {
+		column -= s.wide[i].skip
+	}
+
+	return line + 1, column + 1
+}
+
+// Position returns the position of the start of the given location, including the name of the
+// source that it's in. If the location is unknown, e.g. because the document was parsed without
+// locations, a zero Position is returned.
+func (d Document) Position(loc Location) Position {
+	return d.position(loc, loc.Start)
+}
+
+// EndPosition returns the position of the end of the given location, i.e. the position just after
+// its last character. Like Position, a zero Position is returned if the location is unknown.
+func (d Document) EndPosition(loc Location) Position {
+	return d.position(loc, loc.End)
+}
+
+// position returns the position of the given offset, which is in the given location. The source is
+// found from the start of the location, as a location that ends a source ends at the base of the
+// next one.
+func (d Document) position(loc Location, offset int) Position {
+	if loc == (Location{}) {
+		return Position{}
+	}
+
+	source := d.source(loc.Start)
+	if source == nil {
+		return Position{}
+	}
+
+	line, column := source.position(offset)
+
+	return Position{
+		Line:   line,
+		Column: column,
+		Source: source.Name,
+	}
+}
+
+// source returns the source that the given offset is in, or nil if the document has no sources.
+func (d Document) source(offset int) *Source {
+	for i := len(d.Sources) - 1; i >= 0; i-- {
+		if d.Sources[i].Base <= offset {
+			return d.Sources[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package ast_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_Position(t *testing.T) {
+	body := []byte("{ a }\r\n{ \"ü😃\" b }\r{ c }\n\n{ d }")
+
+	// The offsets of "b", "c", and "d".
+	b, c, d := 18, 24, 31
+
+	tests := []struct {
+		unit     ast.ColumnUnit
+		expected []ast.Position
+	}{
+		{ast.ColumnUnitRunes, []ast.Position{{Line: 2, Column: 8}, {Line: 3, Column: 3}, {Line: 5, Column: 3}}},
+		{ast.ColumnUnitBytes, []ast.Position{{Line: 2, Column: 12}, {Line: 3, Column: 3}, {Line: 5, Column: 3}}},
+		{ast.ColumnUnitUTF16, []ast.Position{{Line: 2, Column: 9}, {Line: 3, Column: 3}, {Line: 5, Column: 3}}},
+	}
+
+	positions := func(doc ast.Document) []ast.Position {
+		var out []ast.Position
+		for _, offset := range []int{b, c, d} {
+			out = append(out, doc.Position(ast.Location{Start: offset, End: offset + 1}))
+		}
+		return out
+	}
+
+	for _, test := range tests {
+		t.Run("should find positions from the body", func(t *testing.T) {
+			doc := ast.Document{
+				Sources: []*ast.Source{{Body: body, ColumnUnit: test.unit}},
+			}
+
+			assert.Equal(t, test.expected, positions(doc))
+		})
+
+		t.Run("should find positions from written input", func(t *testing.T) {
+			source := &ast.Source{ColumnUnit: test.unit}
+
+			// Input is written one character at a time, so CRLF is split across writes.
+			for bs := body; len(bs) > 0; {
+				_, w := utf8.DecodeRune(bs)
+				source.Write(bs[:w])
+				bs = bs[w:]
+			}
+
+			doc := ast.Document{Sources: []*ast.Source{source}}
+
+			assert.Equal(t, test.expected, positions(doc))
+		})
+	}
+
+	t.Run("should include the name of the source", func(t *testing.T) {
+		doc := ast.Document{
+			Sources: []*ast.Source{
+				{Name: "a.graphql", Body: []byte("{ a }")},
+				{Name: "b.graphql", Base: 6, Body: []byte("{ b }")},
+			},
+		}
+
+		assert.Equal(t, ast.Position{Line: 1, Column: 3, Source: "a.graphql"}, doc.Position(ast.Location{Start: 2, End: 3}))
+		assert.Equal(t, ast.Position{Line: 1, Column: 3, Source: "b.graphql"}, doc.Position(ast.Location{Start: 8, End: 9}))
+	})
+
+	t.Run("should return a zero position for unknown locations", func(t *testing.T) {
+		assert.Equal(t, ast.Position{}, ast.Document{}.Position(ast.Location{Start: 2, End: 3}))
+		assert.Equal(t, ast.Position{}, ast.Document{
+			Sources: []*ast.Source{{Body: []byte("{ a }")}},
+		}.Position(ast.Location{}))
+	})
+}
+
+func TestDocument_EndPosition(t *testing.T) {
+	t.Run("should find the position just after the end of a location", func(t *testing.T) {
+		doc := ast.Document{
+			Sources: []*ast.Source{{Body: []byte("{\n  \"ü😃\"\n}"), ColumnUnit: ast.ColumnUnitUTF16}},
+		}
+
+		assert.Equal(t, ast.Position{Line: 2, Column: 3}, doc.Position(ast.Location{Start: 4, End: 12}))
+		assert.Equal(t, ast.Position{Line: 2, Column: 8}, doc.EndPosition(ast.Location{Start: 4, End: 12}))
+		assert.Equal(t, ast.Position{Line: 3, Column: 2}, doc.EndPosition(ast.Location{Start: 0, End: 14}))
+	})
+
+	t.Run("should find the end of a location that ends its source in that source", func(t *testing.T) {
+		doc := ast.Document{
+			Sources: []*ast.Source{
+				{Name: "a.graphql", Body: []byte("{ a }")},
+				{Name: "b.graphql", Base: 5, Body: []byte("{ b }")},
+			},
+		}
+
+		assert.Equal(t, ast.Position{Line: 1, Column: 6, Source: "a.graphql"}, doc.EndPosition(ast.Location{Start: 0, End: 5}))
+		assert.Equal(t, ast.Position{Line: 1, Column: 6, Source: "b.graphql"}, doc.EndPosition(ast.Location{Start: 5, End: 10}))
+	})
+
+	t.Run("should return a zero position for unknown locations", func(t *testing.T) {
+		assert.Equal(t, ast.Position{}, ast.Document{}.EndPosition(ast.Location{Start: 2, End: 3}))
+	})
+}
@@ -11,7 +11,7 @@ import (
 // Error fulfils the requirements of a GraphQL error type.
 type Error struct {
 	Message   string
-	Locations *ast.Locations
+	Locations *ast.Positions
 	Path      *ast.PathNodes
 }
 
@@ -22,18 +22,24 @@ func NewError(message string) Error {
 	}
 }
 
+// WithLocations returns a copy of this Error with the given locations attached, replacing any that
+// it already had.
+func (e Error) WithLocations(locations ...ast.Position) Error {
+	e.Locations = ast.PositionsFromSlice(locations)
+	return e
+}
+
 // MarshalJSON returns this Error as some JSON bytes. If the Error is invalid, an error will be
 // returned.
 func (e *Error) MarshalJSON() ([]byte, error) {
 	buf := &bytes.Buffer{}
 	buf.WriteString(`{`)
-	buf.WriteString(`"message":"`)
-	buf.WriteString(e.Message)
-	buf.WriteString(`"`)
+	buf.WriteString(`"message":`)
+	writeJSONString(buf, e.Message)
 
 	if e.Locations.Len() > 0 {
 		buf.WriteString(`,"locations":[`)
-		e.Locations.ForEach(func(location ast.Location, i int) {
+		e.Locations.ForEach(func(location ast.Position, i int) {
 			if i > 0 {
 				buf.WriteString(",")
 			}
@@ -43,6 +49,12 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 			buf.WriteString(strconv.FormatUint(uint64(location.Line), 10))
 			buf.WriteString(`,"column":`)
 			buf.WriteString(strconv.FormatUint(uint64(location.Column), 10))
+
+			if location.Source != "" {
+				buf.WriteString(`,"source":`)
+				writeJSONString(buf, location.Source)
+			}
+
 			buf.WriteString(`}`)
 		})
 		buf.WriteString(`]`)
@@ -57,9 +69,7 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 
 			switch pathNode.Kind {
 			case ast.PathNodeKindString:
-				buf.WriteString(`"`)
-				buf.WriteString(pathNode.String)
-				buf.WriteString(`"`)
+				writeJSONString(buf, pathNode.String)
 			case ast.PathNodeKindInt:
 				buf.WriteString(strconv.Itoa(pathNode.Int))
 			}
@@ -94,3 +104,35 @@ func SortErrors(errs *Errors) *Errors {
 
 	return sorted
 }
+
+// writeJSONString writes the given string to the given buffer as a quoted JSON string, escaping any
+// characters that would otherwise produce invalid JSON.
+func writeJSONString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+
+	buf.WriteByte('"')
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '"' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c == '\n':
+			buf.WriteString(`\n`)
+		case c == '\r':
+			buf.WriteString(`\r`)
+		case c == '\t':
+			buf.WriteString(`\t`)
+		case c < 0x20:
+			buf.WriteString(`\u00`)
+			buf.WriteByte(hex[c>>4])
+			buf.WriteByte(hex[c&0xF])
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	buf.WriteByte('"')
+}
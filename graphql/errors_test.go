@@ -10,7 +10,7 @@ import (
 
 func TestError_MarshalJSON(t *testing.T) {
 	t.Run("should return valid JSON", func(t *testing.T) {
-		locations := []ast.Location{
+		locations := []ast.Position{
 			{Line: 6, Column: 7},
 		}
 
@@ -23,7 +23,7 @@ func TestError_MarshalJSON(t *testing.T) {
 
 		gqlErr := Error{
 			Message:   "Name for character with ID 1002 could not be fetched.",
-			Locations: ast.LocationsFromSlice(locations),
+			Locations: ast.PositionsFromSlice(locations),
 			Path:      ast.PathNodesFromSlice(nodes),
 		}
 
@@ -34,4 +34,49 @@ func TestError_MarshalJSON(t *testing.T) {
 
 		assert.Equal(t, expected, string(actual))
 	})
+
+	t.Run("should include the source name of locations, if known", func(t *testing.T) {
+		locations := []ast.Position{
+			{Line: 1, Column: 1, Source: "a.graphql"},
+			{Line: 2, Column: 3, Source: `b "quoted".graphql`},
+			{Line: 4, Column: 5},
+		}
+
+		gqlErr := Error{
+			Message:   "There can be only one type named \"Foo\".",
+			Locations: ast.PositionsFromSlice(locations),
+		}
+
+		actual, err := gqlErr.MarshalJSON()
+		require.NoError(t, err)
+
+		expected := `{"message":"There can be only one type named \"Foo\".","locations":[{"line":1,"column":1,"source":"a.graphql"},{"line":2,"column":3,"source":"b \"quoted\".graphql"},{"line":4,"column":5}]}`
+
+		assert.Equal(t, expected, string(actual))
+	})
+
+	t.Run("should escape special characters", func(t *testing.T) {
+		gqlErr := Error{
+			Message: "Unexpected Name \"foo\"\n\\.",
+			Path:    ast.PathNodesFromSlice([]ast.PathNode{{Kind: ast.PathNodeKindString, String: `"quoted"`}}),
+		}
+
+		actual, err := gqlErr.MarshalJSON()
+		require.NoError(t, err)
+
+		expected := `{"message":"Unexpected Name \"foo\"\n\\.","path":["\"quoted\""]}`
+
+		assert.Equal(t, expected, string(actual))
+	})
+}
+
+func TestError_WithLocations(t *testing.T) {
+	t.Run("should replace the locations of a copy of the error", func(t *testing.T) {
+		gqlErr := NewError("foo").WithLocations(ast.Position{Line: 1, Column: 2})
+
+		actual := gqlErr.WithLocations(ast.Position{Line: 3, Column: 4}, ast.Position{Line: 5, Column: 6})
+
+		assert.Equal(t, ast.PositionsFromSlice([]ast.Position{{Line: 1, Column: 2}}), gqlErr.Locations)
+		assert.Equal(t, ast.PositionsFromSlice([]ast.Position{{Line: 3, Column: 4}, {Line: 5, Column: 6}}), actual.Locations)
+	})
 }
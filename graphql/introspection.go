@@ -0,0 +1,379 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+)
+
+// introspectionResult is the result of an introspection query, either as a whole response, or just
+// the data within it.
+type introspectionResult struct {
+	Data   *introspectionResult `json:"data"`
+	Schema *introspectionSchema `json:"__schema"`
+}
+
+type introspectionSchema struct {
+	QueryType        *introspectionTypeRef    `json:"queryType"`
+	MutationType     *introspectionTypeRef    `json:"mutationType"`
+	SubscriptionType *introspectionTypeRef    `json:"subscriptionType"`
+	Types            []introspectionType      `json:"types"`
+	Directives       []introspectionDirective `json:"directives"`
+}
+
+type introspectionType struct {
+	Kind          string                    `json:"kind"`
+	Name          string                    `json:"name"`
+	Description   *string                   `json:"description"`
+	Fields        []introspectionField      `json:"fields"`
+	InputFields   []introspectionInputValue `json:"inputFields"`
+	Interfaces    []introspectionTypeRef    `json:"interfaces"`
+	EnumValues    []introspectionEnumValue  `json:"enumValues"`
+	PossibleTypes []introspectionTypeRef    `json:"possibleTypes"`
+}
+
+type introspectionField struct {
+	Name              string                    `json:"name"`
+	Description       *string                   `json:"description"`
+	Args              []introspectionInputValue `json:"args"`
+	Type              introspectionTypeRef      `json:"type"`
+	IsDeprecated      bool                      `json:"isDeprecated"`
+	DeprecationReason *string                   `json:"deprecationReason"`
+}
+
+type introspectionInputValue struct {
+	Name              string               `json:"name"`
+	Description       *string              `json:"description"`
+	Type              introspectionTypeRef `json:"type"`
+	DefaultValue      *string              `json:"defaultValue"`
+	IsDeprecated      bool                 `json:"isDeprecated"`
+	DeprecationReason *string              `json:"deprecationReason"`
+}
+
+type introspectionEnumValue struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionDirective struct {
+	Name         string                    `json:"name"`
+	Description  *string                   `json:"description"`
+	Locations    []string                  `json:"locations"`
+	Args         []introspectionInputValue `json:"args"`
+	IsRepeatable bool                      `json:"isRepeatable"`
+}
+
+// introspectionTypeKinds maps the kinds of named types in introspection results to their kinds of
+// type definition.
+var introspectionTypeKinds = map[string]ast.TypeDefinitionKind{
+	"SCALAR":       ast.TypeDefinitionKindScalar,
+	"OBJECT":       ast.TypeDefinitionKindObject,
+	"INTERFACE":    ast.TypeDefinitionKindInterface,
+	"UNION":        ast.TypeDefinitionKindUnion,
+	"ENUM":         ast.TypeDefinitionKindEnum,
+	"INPUT_OBJECT": ast.TypeDefinitionKindInputObject,
+}
+
+// SchemaFromIntrospection builds a Schema from the JSON result of an introspection query. The JSON
+// may be a whole response, i.e. {"data": {"__schema": ...}}, or just the data within it. Default
+// values are parsed into AST values, and deprecation reasons become "@deprecated" directives. The
+// introspection types themselves, whose names start with "__", are left out, as they're not part of
+// the schema's own type system.
+func SchemaFromIntrospection(data []byte) (*Schema, error) {
+	var result introspectionResult
+
+	err := json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid introspection result: %v", err)
+	}
+
+	if result.Data != nil {
+		result = *result.Data
+	}
+
+	if result.Schema == nil {
+		return nil, errors.New("invalid introspection result: missing __schema")
+	}
+
+	if result.Schema.QueryType == nil {
+		return nil, errors.New("invalid introspection result: missing query type")
+	}
+
+	schema := &Schema{
+		Directives: make(map[string]*ast.DirectiveDefinition, len(result.Schema.Directives)),
+		Types:      make(map[string]*ast.TypeDefinition, len(result.Schema.Types)),
+	}
+
+	var operationTypes []ast.OperationTypeDefinition
+
+	for _, ot := range []struct {
+		ref  *introspectionTypeRef
+		kind ast.OperationDefinitionKind
+		typ  **ast.Type
+	}{
+		{result.Schema.QueryType, ast.OperationDefinitionKindQuery, &schema.QueryType},
+		{result.Schema.MutationType, ast.OperationDefinitionKindMutation, &schema.MutationType},
+		{result.Schema.SubscriptionType, ast.OperationDefinitionKindSubscription, &schema.SubscriptionType},
+	} {
+		if ot.ref == nil {
+			continue
+		}
+
+		namedType := ast.Type{
+			NamedType: ot.ref.Name,
+			Kind:      ast.TypeKindNamed,
+		}
+
+		*ot.typ = &namedType
+
+		operationTypes = append(operationTypes, ast.OperationTypeDefinition{
+			NamedType:     namedType,
+			OperationType: ot.kind,
+		})
+	}
+
+	schema.Definition = &ast.SchemaDefinition{
+		OperationTypeDefinitions: ast.OperationTypeDefinitionsFromSlice(operationTypes),
+	}
+
+	for _, it := range result.Schema.Types {
+		if strings.HasPrefix(it.Name, "__") {
+			continue
+		}
+
+		def, err := typeDefinitionFromIntrospection(it)
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Types[def.Name] = def
+	}
+
+	for _, id := range result.Schema.Directives {
+		def, err := directiveDefinitionFromIntrospection(id)
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Directives[def.Name] = def
+	}
+
+	for name, def := range SpecifiedTypes() {
+		if _, ok := schema.Types[name]; !ok {
+			schema.Types[name] = def
+		}
+	}
+
+	for name, def := range SpecifiedDirectives() {
+		if _, ok := schema.Directives[name]; !ok {
+			schema.Directives[name] = def
+		}
+	}
+
+	return schema, nil
+}
+
+// typeDefinitionFromIntrospection returns the type definition for the given introspected type.
+func typeDefinitionFromIntrospection(it introspectionType) (*ast.TypeDefinition, error) {
+	kind, ok := introspectionTypeKinds[it.Kind]
+	if !ok {
+		return nil, fmt.Errorf("invalid introspection result: unknown kind %q of type %q", it.Kind, it.Name)
+	}
+
+	def := &ast.TypeDefinition{
+		Description: stringOrEmpty(it.Description),
+		Name:        it.Name,
+		Kind:        kind,
+	}
+
+	switch kind {
+	case ast.TypeDefinitionKindObject, ast.TypeDefinitionKindInterface:
+		fields := make([]ast.FieldDefinition, 0, len(it.Fields))
+		for _, f := range it.Fields {
+			args, err := inputValueDefinitionsFromIntrospection(f.Args, ast.DirectiveLocationKindArgumentDefinition)
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, ast.FieldDefinition{
+				Description:         stringOrEmpty(f.Description),
+				Name:                f.Name,
+				ArgumentsDefinition: args,
+				Type:                typeFromIntrospection(f.Type),
+				Directives:          deprecatedDirectives(f.IsDeprecated, f.DeprecationReason, ast.DirectiveLocationKindFieldDefinition),
+			})
+		}
+
+		def.FieldsDefinition = ast.FieldDefinitionsFromSlice(fields)
+		def.ImplementsInterface = typesFromIntrospection(it.Interfaces)
+	case ast.TypeDefinitionKindUnion:
+		def.UnionMemberTypes = typesFromIntrospection(it.PossibleTypes)
+	case ast.TypeDefinitionKindEnum:
+		values := make([]ast.EnumValueDefinition, 0, len(it.EnumValues))
+		for _, v := range it.EnumValues {
+			values = append(values, ast.EnumValueDefinition{
+				Description: stringOrEmpty(v.Description),
+				Directives:  deprecatedDirectives(v.IsDeprecated, v.DeprecationReason, ast.DirectiveLocationKindEnumValue),
+				EnumValue:   v.Name,
+			})
+		}
+
+		def.EnumValuesDefinition = ast.EnumValueDefinitionsFromSlice(values)
+	case ast.TypeDefinitionKindInputObject:
+		inputFields, err := inputValueDefinitionsFromIntrospection(it.InputFields, ast.DirectiveLocationKindInputFieldDefinition)
+		if err != nil {
+			return nil, err
+		}
+
+		def.InputFieldsDefinition = inputFields
+	}
+
+	return def, nil
+}
+
+// directiveDefinitionFromIntrospection returns the directive definition for the given introspected
+// directive.
+func directiveDefinitionFromIntrospection(id introspectionDirective) (*ast.DirectiveDefinition, error) {
+	var locations ast.DirectiveLocation
+
+	for _, name := range id.Locations {
+		location, ok := ast.DirectiveLocationsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid introspection result: unknown location %q of directive %q", name, id.Name)
+		}
+
+		locations |= location
+	}
+
+	args, err := inputValueDefinitionsFromIntrospection(id.Args, ast.DirectiveLocationKindArgumentDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.DirectiveDefinition{
+		Description:         stringOrEmpty(id.Description),
+		Name:                id.Name,
+		ArgumentsDefinition: args,
+		DirectiveLocations:  locations,
+		Repeatable:          id.IsRepeatable,
+	}, nil
+}
+
+// inputValueDefinitionsFromIntrospection returns the input value definitions for the given
+// introspected arguments, or input fields, depending on the given location.
+func inputValueDefinitionsFromIntrospection(ivs []introspectionInputValue, location ast.DirectiveLocation) (*ast.InputValueDefinitions, error) {
+	if len(ivs) == 0 {
+		return nil, nil
+	}
+
+	defs := make([]ast.InputValueDefinition, 0, len(ivs))
+	for _, iv := range ivs {
+		def := ast.InputValueDefinition{
+			Description: stringOrEmpty(iv.Description),
+			Name:        iv.Name,
+			Type:        typeFromIntrospection(iv.Type),
+			Directives:  deprecatedDirectives(iv.IsDeprecated, iv.DeprecationReason, location),
+		}
+
+		if iv.DefaultValue != nil {
+			// Default values are printed in GraphQL syntax, e.g. `{foo: [1, "bar", BAZ]}`. They aren't
+			// part of any document, so locations in them would be meaningless.
+			value, err := language.NewParserWithOptions([]byte(*iv.DefaultValue), language.ParserOptions{
+				SkipLocations: true,
+			}).ParseValue()
+			if err != nil {
+				return nil, fmt.Errorf("invalid introspection result: invalid default value of %q: %v", iv.Name, err)
+			}
+
+			def.DefaultValue = &value
+		}
+
+		defs = append(defs, def)
+	}
+
+	return ast.InputValueDefinitionsFromSlice(defs), nil
+}
+
+// typeFromIntrospection returns the type that the given introspected type reference refers to.
+func typeFromIntrospection(ref introspectionTypeRef) ast.Type {
+	switch {
+	case ref.Kind == "NON_NULL" && ref.OfType != nil:
+		t := typeFromIntrospection(*ref.OfType)
+		t.NonNullable = true
+
+		return t
+	case ref.Kind == "LIST" && ref.OfType != nil:
+		t := typeFromIntrospection(*ref.OfType)
+
+		return ast.Type{
+			ListType: &t,
+			Kind:     ast.TypeKindList,
+		}
+	}
+
+	return ast.Type{
+		NamedType: ref.Name,
+		Kind:      ast.TypeKindNamed,
+	}
+}
+
+// typesFromIntrospection returns the named types that the given introspected type references
+// refer to.
+func typesFromIntrospection(refs []introspectionTypeRef) *ast.Types {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	types := make([]ast.Type, 0, len(refs))
+	for _, ref := range refs {
+		types = append(types, typeFromIntrospection(ref))
+	}
+
+	return ast.TypesFromSlice(types)
+}
+
+// deprecatedDirectives returns a "@deprecated" directive, with the given reason, if any, in the
+// given location, if the element it's for is deprecated.
+func deprecatedDirectives(deprecated bool, reason *string, location ast.DirectiveLocation) *ast.Directives {
+	if !deprecated {
+		return nil
+	}
+
+	var args *ast.Arguments
+	if reason != nil {
+		args = args.Add(ast.Argument{
+			Name: "reason",
+			Value: ast.Value{
+				StringValue: *reason,
+				Kind:        ast.ValueKindString,
+			},
+		})
+	}
+
+	return (*ast.Directives)(nil).Add(ast.Directive{
+		Name:      DeprecatedDirective.Name,
+		Arguments: args,
+		Location:  location,
+	})
+}
+
+// stringOrEmpty returns the given string, or an empty string if it's nil.
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
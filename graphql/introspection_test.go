@@ -0,0 +1,200 @@
+package graphql_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser"
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaFromIntrospection(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/introspection.json")
+	require.NoError(t, err)
+
+	schema, err := graphql.SchemaFromIntrospection(data)
+	require.NoError(t, err)
+
+	fields := func(def *ast.TypeDefinition) map[string]ast.FieldDefinition {
+		fds := make(map[string]ast.FieldDefinition)
+		def.FieldsDefinition.ForEach(func(fd ast.FieldDefinition, i int) {
+			fds[fd.Name] = fd
+		})
+		return fds
+	}
+
+	t.Run("should set the root operation types", func(t *testing.T) {
+		require.NotNil(t, schema.QueryType)
+		require.NotNil(t, schema.MutationType)
+		assert.Equal(t, "Query", schema.QueryType.NamedType)
+		assert.Equal(t, "Mutation", schema.MutationType.NamedType)
+		assert.Nil(t, schema.SubscriptionType)
+
+		require.NotNil(t, schema.Definition)
+		assert.Equal(t, 2, schema.Definition.OperationTypeDefinitions.Len())
+	})
+
+	t.Run("should build type definitions", func(t *testing.T) {
+		assert.NotContains(t, schema.Types, "__Schema")
+
+		for name, kind := range map[string]ast.TypeDefinitionKind{
+			"Query":        ast.TypeDefinitionKindObject,
+			"Node":         ast.TypeDefinitionKindInterface,
+			"SearchResult": ast.TypeDefinitionKindUnion,
+			"Role":         ast.TypeDefinitionKindEnum,
+			"Filter":       ast.TypeDefinitionKindInputObject,
+			"Float":        ast.TypeDefinitionKindScalar,
+		} {
+			require.Contains(t, schema.Types, name)
+			assert.Equal(t, kind, schema.Types[name].Kind, name)
+		}
+
+		query := schema.Types["Query"]
+		assert.Equal(t, "The root query type.", query.Description)
+
+		queryFields := fields(query)
+		assert.Equal(t, "User", queryFields["user"].Type.String())
+		assert.Equal(t, "[SearchResult!]!", queryFields["search"].Type.String())
+		assert.Equal(t, "[User]", queryFields["users"].Type.String())
+
+		user := schema.Types["User"]
+		require.Equal(t, 1, user.ImplementsInterface.Len())
+		assert.Equal(t, "Node", user.ImplementsInterface.Data.NamedType)
+
+		var members []string
+		schema.Types["SearchResult"].UnionMemberTypes.ForEach(func(t ast.Type, i int) {
+			members = append(members, t.NamedType)
+		})
+		assert.Equal(t, []string{"User", "Group"}, members)
+
+		admin, ok := schema.Types["Role"].EnumValueDefinitionByName("ADMIN")
+		require.True(t, ok)
+		assert.Equal(t, "Can do anything.", admin.Description)
+
+		assert.Equal(t, 5, schema.Types["Filter"].InputFieldsDefinition.Len())
+	})
+
+	t.Run("should parse default values", func(t *testing.T) {
+		queryFields := fields(schema.Types["Query"])
+
+		var args []ast.InputValueDefinition
+		queryFields["user"].ArgumentsDefinition.ForEach(func(ivd ast.InputValueDefinition, i int) {
+			args = append(args, ivd)
+		})
+
+		require.Len(t, args, 2)
+		assert.Equal(t, "The user's ID.", args[0].Description)
+		assert.Equal(t, "ID!", args[0].Type.String())
+		assert.Nil(t, args[0].DefaultValue)
+		assert.Equal(t, &ast.Value{Kind: ast.ValueKindInt, IntValue: 10, RawValue: "10"}, args[1].DefaultValue)
+
+		filter := queryFields["search"].ArgumentsDefinition.Data
+		expected := &ast.Value{
+			Kind: ast.ValueKindObject,
+			ObjectValue: []ast.ObjectField{
+				{Name: "roles", Value: ast.Value{
+					Kind: ast.ValueKindList,
+					ListValue: []ast.Value{
+						{Kind: ast.ValueKindEnum, StringValue: "ADMIN"},
+						{Kind: ast.ValueKindEnum, StringValue: "USER"},
+					},
+				}},
+				{Name: "name", Value: ast.Value{Kind: ast.ValueKindString, StringValue: "Jörg"}},
+				{Name: "ratio", Value: ast.Value{Kind: ast.ValueKindFloat, FloatValue: 1.5, RawValue: "1.5"}},
+				{Name: "active", Value: ast.Value{Kind: ast.ValueKindBoolean, BooleanValue: true}},
+				{Name: "after", Value: ast.Value{Kind: ast.ValueKindNull}},
+			},
+		}
+
+		assert.Equal(t, expected, filter.DefaultValue)
+	})
+
+	t.Run("should parse block strings and escape sequences in default values", func(t *testing.T) {
+		input := `{"__schema": {"queryType": {"name": "Query"}, "directives": [{"name": "foo", "locations": ["FIELD"], "args": [
+			{"name": "a", "type": {"kind": "SCALAR", "name": "String"}, "defaultValue": "\"\"\"\n    Block\n      string\n\"\"\""},
+			{"name": "b", "type": {"kind": "SCALAR", "name": "String"}, "defaultValue": "\"\\u00e9\\u{1F600}\\t\""}
+		]}]}}`
+
+		schema, err := graphql.SchemaFromIntrospection([]byte(input))
+		require.NoError(t, err)
+
+		args := schema.Directives["foo"].ArgumentsDefinition
+		require.Equal(t, 2, args.Len())
+
+		var values []string
+		args.ForEach(func(ivd ast.InputValueDefinition, i int) {
+			values = append(values, ivd.DefaultValue.StringValue)
+		})
+
+		assert.Equal(t, []string{"Block\n  string", "é😀\t"}, values)
+	})
+
+	t.Run("should add deprecated directives", func(t *testing.T) {
+		queryFields := fields(schema.Types["Query"])
+
+		assert.Nil(t, queryFields["user"].Directives)
+
+		directives := queryFields["users"].Directives
+		require.Equal(t, 1, directives.Len())
+		assert.Equal(t, "deprecated", directives.Data.Name)
+		require.Equal(t, 1, directives.Data.Arguments.Len())
+		assert.Equal(t, "reason", directives.Data.Arguments.Data.Name)
+		assert.Equal(t, "Use `search`.", directives.Data.Arguments.Data.Value.StringValue)
+
+		guest, ok := schema.Types["Role"].EnumValueDefinitionByName("GUEST")
+		require.True(t, ok)
+		require.Equal(t, 1, guest.Directives.Len())
+		assert.Equal(t, "deprecated", guest.Directives.Data.Name)
+		assert.Nil(t, guest.Directives.Data.Arguments)
+	})
+
+	t.Run("should build directive definitions", func(t *testing.T) {
+		require.Contains(t, schema.Directives, "cached")
+
+		cached := schema.Directives["cached"]
+		assert.Equal(t, "Caches the result.", cached.Description)
+		assert.Equal(t, ast.DirectiveLocationKindField|ast.DirectiveLocationKindFragmentSpread, cached.DirectiveLocations)
+		assert.True(t, cached.Repeatable)
+		assert.Equal(t, 1, cached.ArgumentsDefinition.Len())
+
+		// Specified directives are added, if they're missing.
+		assert.Contains(t, schema.Directives, "include")
+		assert.Contains(t, schema.Directives, "deprecated")
+	})
+
+	t.Run("should be usable to validate documents", func(t *testing.T) {
+		doc, errs, err := graphqlparser.ParseDoc([]byte(`{ user(id: "1", size: 20) @cached(ttl: 5) { name } }`), schema)
+		require.NoError(t, err)
+		assert.Nil(t, errs)
+		assert.NotNil(t, doc)
+
+		_, errs, err = graphqlparser.ParseDoc([]byte(`{ user(id: "1", size: "20") @unknown { name } }`), schema)
+		require.NoError(t, err)
+		assert.True(t, errs.Len() > 0)
+	})
+}
+
+func TestSchemaFromIntrospection_errors(t *testing.T) {
+	tests := []struct {
+		msg   string
+		input string
+	}{
+		{"invalid JSON", `{"__schema": `},
+		{"missing __schema", `{"data": {}}`},
+		{"missing query type", `{"__schema": {"types": []}}`},
+		{"unknown type kind", `{"__schema": {"queryType": {"name": "Query"}, "types": [{"kind": "FOO", "name": "Query"}]}}`},
+		{"unknown directive location", `{"__schema": {"queryType": {"name": "Query"}, "directives": [{"name": "foo", "locations": ["FOO"]}]}}`},
+		{"invalid default value", `{"__schema": {"queryType": {"name": "Query"}, "directives": [{"name": "foo", "locations": ["FIELD"], "args": [{"name": "a", "type": {"kind": "SCALAR", "name": "Int"}, "defaultValue": "[1"}]}]}}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			schema, err := graphql.SchemaFromIntrospection([]byte(test.input))
+			assert.Error(t, err)
+			assert.Nil(t, schema)
+		})
+	}
+}
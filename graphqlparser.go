@@ -17,11 +17,27 @@ var (
 
 // ParseDoc ...
 func ParseDoc(doc []byte, schema *graphql.Schema) (*ast.Document, *graphql.Errors, error) {
-	parser := language.NewParser(doc)
+	queryAST, err := language.ParseBytes(doc)
+
+	return validateDoc(queryAST, err, schema)
+}
+
+// ParseDocWithOptions is like ParseDoc, but parses the document with the given options. This can be
+// used to set limits on the size and complexity of untrusted documents, which are then enforced
+// before any validation takes place.
+func ParseDocWithOptions(doc []byte, schema *graphql.Schema, opts language.ParserOptions) (*ast.Document, *graphql.Errors, error) {
+	parser := language.NewParserWithOptions(doc, opts)
 
 	queryAST, err := parser.Parse()
+
+	return validateDoc(queryAST, err, schema)
+}
+
+// validateDoc validates a parsed query document against the given schema, unless parsing it failed.
+func validateDoc(queryAST ast.Document, err error, schema *graphql.Schema) (*ast.Document, *graphql.Errors, error) {
 	if err != nil {
-		return nil, nil, err
+		errs, err := GraphQLErrors(err)
+		return nil, errs, err
 	}
 
 	ctx := validation.Validate(queryAST, schema, DefaultValidationWalker)
@@ -38,15 +54,34 @@ func ParseSDLDoc(doc []byte, schema *graphql.Schema) (*graphql.Schema, *graphql.
 
 	sdlAST, err := parser.Parse()
 	if err != nil {
-		return nil, nil, err
+		errs, err := GraphQLErrors(err)
+		return nil, errs, err
 	}
 
+	return buildSchema(sdlAST, schema)
+}
+
+// ParseSDLSources is like ParseSDLDoc, but parses a schema that is split across several sources,
+// e.g. files. The name of each source is included in the locations of any errors.
+func ParseSDLSources(sources []language.Source, schema *graphql.Schema) (*graphql.Schema, *graphql.Errors, error) {
+	sdlAST, err := language.ParseSources(sources, language.ParserOptions{})
+	if err != nil {
+		errs, err := GraphQLErrors(err)
+		return nil, errs, err
+	}
+
+	return buildSchema(sdlAST, schema)
+}
+
+// buildSchema validates the given SDL document, and builds a schema from it, extending the given
+// schema if it's not nil.
+func buildSchema(sdlAST ast.Document, schema *graphql.Schema) (*graphql.Schema, *graphql.Errors, error) {
 	ctx := validation.ValidateSDL(sdlAST, schema, DefaultValidationWalkerSDL)
 	if ctx.Errors.Len() > 0 {
 		return nil, ctx.Errors, nil
 	}
 
-	schema, err = validation.BuildSchema(ctx)
+	schema, err := validation.BuildSchema(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -58,3 +93,29 @@ func ParseSDLDoc(doc []byte, schema *graphql.Schema) (*graphql.Schema, *graphql.
 
 	return schema, nil, nil
 }
+
+// GraphQLErrors converts an error returned by the parser, i.e. a *language.SyntaxError,
+// language.SyntaxErrors, or a *language.LimitError, into GraphQL errors, so that they may be returned
+// to clients in the same way as validation errors. Any other error is returned as-is.
+func GraphQLErrors(err error) (*graphql.Errors, error) {
+	switch err := err.(type) {
+	case *language.SyntaxError:
+		return (*graphql.Errors)(nil).Add(syntaxError(err)), nil
+	case language.SyntaxErrors:
+		var errs *graphql.Errors
+		for _, e := range err {
+			errs = errs.Add(syntaxError(e))
+		}
+
+		return errs.Reverse(), nil
+	case *language.LimitError:
+		return (*graphql.Errors)(nil).Add(graphql.NewError(err.Message()).WithLocations(err.Position)), nil
+	}
+
+	return nil, err
+}
+
+// syntaxError converts a syntax error into a GraphQL error.
+func syntaxError(err *language.SyntaxError) graphql.Error {
+	return graphql.NewError("Syntax Error: " + err.Message).WithLocations(err.Position)
+}
@@ -0,0 +1,93 @@
+package graphqlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/graphql"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDoc(t *testing.T) {
+	t.Run("should limit the depth of deeply nested lists", func(t *testing.T) {
+		// Without a limit, this exhausts the stack, which can't be recovered from.
+		query := "{ a(x: " + strings.Repeat("[", 1000000)
+
+		doc, errs, err := ParseDoc([]byte(query), nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, doc)
+		require.Equal(t, 1, errs.Len())
+
+		errs.ForEach(func(err graphql.Error, i int) {
+			assert.Contains(t, err.Message, "Maximum depth of 1000 exceeded.")
+		})
+	})
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	marshal := func(t *testing.T, errs *graphql.Errors) []string {
+		var out []string
+		errs.ForEach(func(err graphql.Error, i int) {
+			actual, merr := err.MarshalJSON()
+			require.NoError(t, merr)
+			out = append(out, string(actual))
+		})
+		return out
+	}
+
+	t.Run("should convert syntax errors", func(t *testing.T) {
+		_, err := language.NewParser([]byte("{ foo(bar: ) }")).Parse()
+		require.Error(t, err)
+
+		errs, err := GraphQLErrors(err)
+		require.NoError(t, err)
+
+		expected := []string{
+			`{"message":"Syntax Error: Unexpected Punctuator \")\", expected Punctuator \"$\" or \"[\" or \"{\" or IntValue or FloatValue or StringValue or Name.","locations":[{"line":1,"column":12}]}`,
+		}
+
+		assert.Equal(t, expected, marshal(t, errs))
+	})
+
+	t.Run("should convert every syntax error, in order, when recovering", func(t *testing.T) {
+		_, err := language.ParseSources([]language.Source{
+			{Name: "a.graphql", Body: []byte("type Query { foo( }")},
+			{Name: "b.graphql", Body: []byte("type Bar {")},
+		}, language.ParserOptions{RecoverErrors: true})
+		require.Error(t, err)
+
+		errs, err := GraphQLErrors(err)
+		require.NoError(t, err)
+
+		actual := marshal(t, errs)
+		require.Len(t, actual, 2)
+		assert.Contains(t, actual[0], `"source":"a.graphql"`)
+		assert.Contains(t, actual[1], `"locations":[{"line":1,"column":11,"source":"b.graphql"}]`)
+	})
+
+	t.Run("should convert limit errors, which aren't syntax errors", func(t *testing.T) {
+		_, err := language.NewParserWithOptions([]byte("{ a }"), language.ParserOptions{MaxTokens: 2}).Parse()
+		require.Error(t, err)
+
+		errs, err := GraphQLErrors(err)
+		require.NoError(t, err)
+
+		expected := []string{
+			`{"message":"Maximum number of tokens of 2 exceeded.","locations":[{"line":1,"column":5}]}`,
+		}
+
+		assert.Equal(t, expected, marshal(t, errs))
+	})
+
+	t.Run("should return other errors as-is", func(t *testing.T) {
+		other := errors.New("foo")
+
+		errs, err := GraphQLErrors(other)
+		assert.Nil(t, errs)
+		assert.Equal(t, other, err)
+	})
+}
@@ -0,0 +1,170 @@
+package language
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+)
+
+// Expectation describes a token the parser would have accepted at the point where it encountered
+// a syntax error. If Literals is empty, any token of the given Kind would have been accepted.
+type Expectation struct {
+	Kind     TokenKind
+	Literals []string
+}
+
+// String returns a human readable form of this Expectation, e.g. `Name "query" or "mutation"`.
+func (e Expectation) String() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(e.Kind.String())
+
+	for i, l := range e.Literals {
+		if i == 0 {
+			buf.WriteString(" ")
+		} else {
+			buf.WriteString(" or ")
+		}
+
+		buf.WriteString(strconv.Quote(l))
+	}
+
+	return buf.String()
+}
+
+// SyntaxError is the error returned by the parser when the input is not a syntactically valid
+// GraphQL document. It points at the offending token, and lists what would have been accepted
+// instead, if that is known.
+type SyntaxError struct {
+	Message  string
+	Location ast.Location
+	Position ast.Position
+	Token    Token
+	Expected []Expectation
+}
+
+// newSyntaxError returns a new SyntaxError for the given unexpected token. The message is derived
+// from the token and the expectations. Illegal tokens already carry a message from the lexer, so
+// that is used as-is, and expectations are dropped, as the input could not be tokenised at all.
+func newSyntaxError(token Token, expected []Expectation) *SyntaxError {
+	buf := &bytes.Buffer{}
+
+	if token.Kind == TokenKindIllegal {
+		buf.WriteString(token.Literal)
+		expected = nil
+	} else {
+		buf.WriteString("Unexpected ")
+		buf.WriteString(token.Kind.String())
+
+		if token.Kind != TokenKindEOF {
+			buf.WriteString(" ")
+			buf.WriteString(strconv.Quote(token.Literal))
+		}
+	}
+
+	if len(expected) > 0 {
+		buf.WriteString(", expected ")
+		for i, e := range expected {
+			if i > 0 {
+				buf.WriteString(" or ")
+			}
+
+			buf.WriteString(e.String())
+		}
+	}
+
+	buf.WriteString(".")
+
+	return &SyntaxError{
+		Message:  buf.String(),
+		Location: tokenLocation(token),
+		Position: tokenPosition(token),
+		Token:    token,
+		Expected: expected,
+	}
+}
+
+// Error returns this SyntaxError as a string, including the position it occurred at.
+func (e *SyntaxError) Error() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("syntax error at line ")
+	buf.WriteString(strconv.Itoa(e.Position.Line))
+	buf.WriteString(", column ")
+	buf.WriteString(strconv.Itoa(e.Position.Column))
+	buf.WriteString(": ")
+	buf.WriteString(e.Message)
+
+	return buf.String()
+}
+
+// SyntaxErrors is a list of syntax errors. It is returned by the parser when error recovery is
+// enabled, and one or more syntax errors were encountered.
+type SyntaxErrors []*SyntaxError
+
+// Error returns all of the errors in this list as a string, one per line.
+func (es SyntaxErrors) Error() string {
+	buf := &bytes.Buffer{}
+
+	for i, e := range es {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		buf.WriteString(e.Error())
+	}
+
+	return buf.String()
+}
+
+// All different kinds of limit that may be set on the parser, see ParserOptions.
+const (
+	LimitKindDepth LimitKind = iota
+	LimitKindTokens
+	LimitKindDefinitions
+	LimitKindStringLength
+	LimitKindSize
+)
+
+// LimitKindNames is a map of limit kinds to their descriptions as strings.
+var LimitKindNames = map[LimitKind]string{
+	LimitKindDepth:        "depth",
+	LimitKindTokens:       "number of tokens",
+	LimitKindDefinitions:  "number of definitions",
+	LimitKindStringLength: "string length",
+	LimitKindSize:         "input size",
+}
+
+// LimitKind represents one of the limits that may be set on the parser.
+type LimitKind int
+
+// String returns the description of this kind of limit.
+func (k LimitKind) String() string {
+	return LimitKindNames[k]
+}
+
+// LimitError is the error returned by the parser when the input exceeds one of the limits set in
+// ParserOptions. Parsing stops as soon as a limit is exceeded, even if error recovery is enabled.
+type LimitError struct {
+	Kind     LimitKind
+	Max      int
+	Location ast.Location
+	Position ast.Position
+}
+
+// Message returns a description of the limit that was exceeded, without its position.
+func (e *LimitError) Message() string {
+	return "Maximum " + e.Kind.String() + " of " + strconv.Itoa(e.Max) + " exceeded."
+}
+
+// Error returns this LimitError as a string, including the position it occurred at.
+func (e *LimitError) Error() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("limit exceeded at line ")
+	buf.WriteString(strconv.Itoa(e.Position.Line))
+	buf.WriteString(", column ")
+	buf.WriteString(strconv.Itoa(e.Position.Column))
+	buf.WriteString(": ")
+	buf.WriteString(e.Message())
+
+	return buf.String()
+}
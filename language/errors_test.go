@@ -0,0 +1,204 @@
+package language_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntaxError(t *testing.T) {
+	tt := []struct {
+		msg      string
+		query    string
+		message  string
+		location ast.Location
+		position ast.Position
+		expected []language.Expectation
+	}{
+		{
+			msg:      "unexpected token",
+			query:    "query { foo(bar: ) }",
+			message:  `Unexpected Punctuator ")", expected Punctuator "$" or "[" or "{" or IntValue or FloatValue or StringValue or Name.`,
+			location: ast.Location{Start: 17, End: 18},
+			position: ast.Position{Line: 1, Column: 18},
+			expected: []language.Expectation{
+				{Kind: language.TokenKindPunctuator, Literals: []string{"$", "[", "{"}},
+				{Kind: language.TokenKindIntValue},
+				{Kind: language.TokenKindFloatValue},
+				{Kind: language.TokenKindStringValue},
+				{Kind: language.TokenKindName},
+			},
+		},
+		{
+			msg:      "unexpected eof",
+			query:    "query {\n  foo",
+			message:  `Unexpected EOF, expected Punctuator "}".`,
+			location: ast.Location{Start: 13, End: 13},
+			position: ast.Position{Line: 2, Column: 6},
+			expected: []language.Expectation{
+				{Kind: language.TokenKindPunctuator, Literals: []string{"}"}},
+			},
+		},
+		{
+			msg:      "illegal token",
+			query:    `{ foo(bar: "baz) }`,
+			message:  `invalid character within string: '\x00'.`,
+			location: ast.Location{Start: 11, End: 18},
+			position: ast.Position{Line: 1, Column: 12},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := language.NewParser([]byte(tc.query)).Parse()
+			require.Error(t, err)
+
+			serr, ok := err.(*language.SyntaxError)
+			require.True(t, ok, "expected a *language.SyntaxError")
+
+			assert.Equal(t, tc.message, serr.Message)
+			assert.Equal(t, tc.location, serr.Location)
+			assert.Equal(t, tc.position, serr.Position)
+			assert.Equal(t, tc.expected, serr.Expected)
+		})
+	}
+}
+
+func TestLimitError(t *testing.T) {
+	tt := []struct {
+		msg      string
+		query    string
+		opts     language.ParserOptions
+		kind     language.LimitKind
+		location ast.Location
+		position ast.Position
+	}{
+		{
+			msg:      "selection set depth",
+			query:    "{ a { b { c } } }",
+			opts:     language.ParserOptions{MaxDepth: 2},
+			kind:     language.LimitKindDepth,
+			location: ast.Location{Start: 8, End: 9},
+			position: ast.Position{Line: 1, Column: 9},
+		},
+		{
+			msg:      "list value depth",
+			query:    "{ a(b: [[[1]]]) }",
+			opts:     language.ParserOptions{MaxDepth: 3},
+			kind:     language.LimitKindDepth,
+			location: ast.Location{Start: 8, End: 9},
+			position: ast.Position{Line: 1, Column: 9},
+		},
+		{
+			msg:      "list type depth",
+			query:    "type Foo { bar: [[String]] }",
+			opts:     language.ParserOptions{MaxDepth: 2},
+			kind:     language.LimitKindDepth,
+			location: ast.Location{Start: 17, End: 18},
+			position: ast.Position{Line: 1, Column: 18},
+		},
+		{
+			msg:      "tokens",
+			query:    "{ a b c }",
+			opts:     language.ParserOptions{MaxTokens: 3},
+			kind:     language.LimitKindTokens,
+			location: ast.Location{Start: 6, End: 7},
+			position: ast.Position{Line: 1, Column: 7},
+		},
+		{
+			msg:      "definitions",
+			query:    "query A { a } query B { b }",
+			opts:     language.ParserOptions{MaxDefinitions: 1},
+			kind:     language.LimitKindDefinitions,
+			location: ast.Location{Start: 14, End: 19},
+			position: ast.Position{Line: 1, Column: 15},
+		},
+		{
+			msg:      "string length",
+			query:    `{ a(b: "abcd") }`,
+			opts:     language.ParserOptions{MaxStringLength: 3},
+			kind:     language.LimitKindStringLength,
+			location: ast.Location{Start: 7, End: 13},
+			position: ast.Position{Line: 1, Column: 8},
+		},
+		{
+			msg:      "input size",
+			query:    "{ a b c }",
+			opts:     language.ParserOptions{MaxSize: 5},
+			kind:     language.LimitKindSize,
+			location: ast.Location{Start: 6, End: 7},
+			position: ast.Position{Line: 1, Column: 7},
+		},
+		{
+			// The brace and parenthesis count towards the depth, so the limit is exceeded by the
+			// bracket before the last one.
+			msg:      "default depth",
+			query:    "{ a(b: " + strings.Repeat("[", language.DefaultMaxDepth) + " }",
+			kind:     language.LimitKindDepth,
+			location: ast.Location{Start: 5 + language.DefaultMaxDepth, End: 6 + language.DefaultMaxDepth},
+			position: ast.Position{Line: 1, Column: 6 + language.DefaultMaxDepth},
+		},
+		{
+			msg:      "with error recovery",
+			query:    "{ a { b } } { c",
+			opts:     language.ParserOptions{MaxDepth: 1, RecoverErrors: true},
+			kind:     language.LimitKindDepth,
+			location: ast.Location{Start: 4, End: 5},
+			position: ast.Position{Line: 1, Column: 5},
+		},
+		{
+			msg:      "while recovering from a syntax error",
+			query:    "query { a ) b c d e f g h i j k l m n }",
+			opts:     language.ParserOptions{MaxTokens: 5, RecoverErrors: true},
+			kind:     language.LimitKindTokens,
+			location: ast.Location{Start: 14, End: 15},
+			position: ast.Position{Line: 1, Column: 15},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := language.NewParserWithOptions([]byte(tc.query), tc.opts).Parse()
+			require.Error(t, err)
+
+			lerr, ok := err.(*language.LimitError)
+			require.True(t, ok, "expected a *language.LimitError, got %T", err)
+
+			assert.Equal(t, tc.kind, lerr.Kind)
+			assert.Equal(t, tc.location, lerr.Location)
+			assert.Equal(t, tc.position, lerr.Position)
+		})
+	}
+
+	t.Run("should allow input within limits", func(t *testing.T) {
+		psr := language.NewParserWithOptions([]byte(`{ a(b: "abc") { c } }`), language.ParserOptions{
+			MaxDepth:        2,
+			MaxTokens:       12,
+			MaxDefinitions:  1,
+			MaxStringLength: 3,
+			MaxSize:         21,
+		})
+
+		_, err := psr.Parse()
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not limit depth if MaxDepth is negative", func(t *testing.T) {
+		depth := language.DefaultMaxDepth * 2
+		query := "{ a(b: " + strings.Repeat("[", depth) + strings.Repeat("]", depth) + ") }"
+
+		_, err := language.NewParserWithOptions([]byte(query), language.ParserOptions{MaxDepth: -1}).Parse()
+		assert.NoError(t, err)
+	})
+
+	t.Run("should describe the limit that was exceeded", func(t *testing.T) {
+		_, err := language.NewParserWithOptions([]byte("{ a }"), language.ParserOptions{MaxTokens: 2}).Parse()
+		require.Error(t, err)
+
+		assert.Equal(t, "limit exceeded at line 1, column 5: Maximum number of tokens of 2 exceeded.", err.Error())
+	})
+}
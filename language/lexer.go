@@ -3,9 +3,13 @@ package language
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"math"
 	"strings"
+	"unicode/utf16"
 	"unicode/utf8"
+
+	"github.com/bucketd/go-graphqlparser/ast"
 )
 
 const (
@@ -47,17 +51,78 @@ const (
 	t3 = 0xE0 // 1110 0000
 	t4 = 0xF0 // 1111 0000
 	t5 = 0xF8 // 1111 1000
+
+	// readerBufferSize is the initial size of the buffer used when reading input from an io.Reader.
+	readerBufferSize = 4096
+	// maxEmptyReads is the number of times in a row an io.Reader may return no data, and no error,
+	// before giving up on it.
+	maxEmptyReads = 100
+)
+
+// LexerOptions contains options that change the behaviour of a Lexer.
+type LexerOptions struct {
+	// ScanIgnored makes the lexer emit tokens for ignored input too, i.e. Unicode BOMs, whitespace,
+	// line terminators, comments, and commas, instead of skipping over them.
+	ScanIgnored bool
+	// ColumnUnit is the unit that token columns are measured in. Defaults to runes.
+	ColumnUnit ColumnUnit
+	// SpecVersion is the edition of the GraphQL specification whose lexical grammar is followed.
+	// Defaults to the latest edition.
+	SpecVersion SpecVersion
+}
+
+// SpecVersion is an edition of the GraphQL specification.
+type SpecVersion int
+
+// SpecVersion constants.
+const (
+	// SpecVersionOctober2021 is the October 2021 edition, which adds variable width unicode escape
+	// sequences, e.g. "\u{1F600}", requires surrogate pairs to be valid, and forbids numbers from
+	// being followed by a '.', or the start of a name.
+	SpecVersionOctober2021 SpecVersion = iota
+	// SpecVersionJune2018 is the June 2018 edition.
+	SpecVersionJune2018
+)
+
+// ColumnUnit is a unit that columns can be measured in. It's the same as ast.ColumnUnit, which the
+// sources of documents record, so that positions in them are measured in the same unit.
+type ColumnUnit = ast.ColumnUnit
+
+// ColumnUnit constants.
+const (
+	// ColumnUnitRunes counts columns in Unicode code points.
+	ColumnUnitRunes = ast.ColumnUnitRunes
+	// ColumnUnitBytes counts columns in bytes of UTF-8 encoded input.
+	ColumnUnitBytes = ast.ColumnUnitBytes
+	// ColumnUnitUTF16 counts columns in UTF-16 code units, as used by the Language Server Protocol.
+	ColumnUnitUTF16 = ast.ColumnUnitUTF16
 )
 
 // Lexer holds the state of a state machine for lexically analysing GraphQL queries.
 type Lexer struct {
 	input    []byte // Raw input is just a byte slice. It is expected to be UTF-8 encoded characters.
 	inputLen int    // Length of the input, in bytes.
+	opts     LexerOptions
+
+	// Streaming information, only used when reading from an io.Reader. In that case, input is a
+	// buffer, holding the bytes of input that have been read but not yet scanned.
+	reader io.Reader   // Where to read more input from, nil once the reader is exhausted.
+	err    error       // The error returned by reader, if it wasn't io.EOF.
+	stream bool        // True if input is a buffer that is reused, so literals must be copied.
+	short  bool        // True if the end of the buffer was reached while scanning the current token.
+	base   int         // The offset of the start of input from the start of the stream, in bytes.
+	source *ast.Source // If set, input is written to it before it's discarded, see flush.
 
 	// Positional information.
 	pos  int // The start position of the last rune read, in bytes.
 	lpos int // The start position of the last rune read, in runes, on the current line.
 	line int // The current line number.
+
+	// Column conversion information, only used when columns aren't measured in runes. Tokens are
+	// scanned in order, so conversion resumes from the end of the last converted position.
+	cline int // The line of the last converted position.
+	coff  int // The last converted position, from the start of the stream, in bytes.
+	ccol  int // The last converted position, in the configured column unit, on its line.
 }
 
 // NewLexer returns a new lexer, for lexically analysing GraphQL queries from a given reader.
@@ -69,25 +134,137 @@ func NewLexer(input []byte) *Lexer {
 	}
 }
 
+// NewLexerWithOptions returns a new lexer, configured with the given options.
+func NewLexerWithOptions(input []byte, opts LexerOptions) *Lexer {
+	return &Lexer{
+		input:    input,
+		inputLen: len(input),
+		opts:     opts,
+		line:     1,
+	}
+}
+
+// Reset discards the state of this lexer, and prepares it to scan the given input instead, keeping
+// its options. This allows a lexer to be reused, rather than allocating a new one for every input.
+func (l *Lexer) Reset(input []byte) {
+	*l = Lexer{
+		input:    input,
+		inputLen: len(input),
+		opts:     l.opts,
+		line:     1,
+	}
+}
+
+// NewLexerReader returns a new lexer that reads its input incrementally from the given reader.
+// Input is discarded from the buffer once it's been scanned, so large documents don't need to be
+// held in memory all at once, though the buffer still grows to about twice the size of the longest
+// token. Ignored input is discarded as it's skipped, unless ScanIgnored is set, in which case it's
+// scanned as tokens too. Unlike NewLexer, token literals are copied out of the buffer.
+func NewLexerReader(r io.Reader) *Lexer {
+	return NewLexerReaderWithOptions(r, LexerOptions{})
+}
+
+// NewLexerReaderWithOptions returns a new lexer that reads its input incrementally from the given
+// reader, configured with the given options.
+func NewLexerReaderWithOptions(r io.Reader, opts LexerOptions) *Lexer {
+	return &Lexer{
+		input:  make([]byte, readerBufferSize),
+		opts:   opts,
+		reader: r,
+		stream: true,
+		line:   1,
+	}
+}
+
 // Scan attempts to read the next significant token from the input. Tokens that are not understood
 // will yield an "illegal" token.
 func (l *Lexer) Scan() Token {
+	var tok Token
+	l.scan(&tok)
+
+	return tok
+}
+
+// scan scans the next significant token into the given token. Tokens are large, so the parser
+// calls this directly, to have them written in place, rather than copied.
+func (l *Lexer) scan(tok *Token) {
+	// Scanning a byte slice, with columns measured in runes, is by far the most common case, so it's
+	// kept as direct as possible.
+	if !l.stream && l.opts.ColumnUnit == ColumnUnitRunes {
+		l.scanBuffered(tok)
+		return
+	}
+
+	if l.stream {
+		l.scanStream(tok)
+	} else {
+		l.scanBuffered(tok)
+	}
+
+	if l.opts.ColumnUnit != ColumnUnitRunes {
+		tok.Column = l.column(tok.Line, tok.Start)
+		tok.EndColumn = l.column(tok.EndLine, tok.End)
+	}
+}
+
+// scanStream scans the next significant token when reading from an io.Reader.
+func (l *Lexer) scanStream(tok *Token) {
+	l.compact()
+
+	// Tokens are scanned from the buffer alone, so that reading it is as fast as reading a byte
+	// slice. If a token runs out of buffered input before the reader is exhausted, it's scanned
+	// again once more input has been read.
+	for {
+		// Ignored input is skipped first, and discarded from the buffer as it's skipped, so that long
+		// runs of whitespace or comments are neither held in memory nor scanned again.
+		if !l.opts.ScanIgnored {
+			l.skipIgnored()
+		}
+
+		state := *l
+		l.short = false
+
+		l.scanBuffered(tok)
+		if !l.short || l.reader == nil {
+			return
+		}
+
+		*l = state
+		l.fill()
+	}
+}
+
+// scanBuffered scans the next significant token from the buffered input.
+func (l *Lexer) scanBuffered(tok *Token) {
+	if l.opts.ScanIgnored {
+		if t, ok := l.scanIgnored(); ok {
+			*tok = t
+			return
+		}
+	}
+
 	r, w := l.readNextSignificant()
 
+	// Comments are skipped, along with any ignored input following them. This loops, rather than
+	// scanning the next token recursively, so that many comments in a row can't exhaust the stack.
+	for r == '#' {
+		l.skipComment()
+		r, w = l.readNextSignificant()
+	}
+
+	start := l.pos - w
+
 	switch {
 	case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_':
-		return l.scanName(r)
+		*tok = l.scanName(r)
 
 	// TODO: Analyse frequency of occurrences of each punctuator in common queries to figure
 	// out the best order for these to be in.
 	case r == '{' || r == '}' || r == '[' || r == ']' || r == '!' || r == '$' || r == '(' || r == ')' || r == ':' || r == '@' || r == '&' || r == '.' || r == '=' || r == '|':
-		return l.scanPunctuator(r, w)
+		*tok = l.scanPunctuator(r, w)
 
 	case (r >= '0' && r <= '9') || r == '-':
-		return l.scanNumber(r)
-
-	case r == '#':
-		return l.scanComment()
+		*tok = l.scanNumber(r)
 
 	case r == '"':
 		r1, w1 := l.read()
@@ -101,29 +278,45 @@ func (l *Lexer) Scan() Token {
 		}
 
 		if r1 == '"' && r2 == '"' {
-			return l.scanBlockString()
+			*tok = l.scanBlockString()
+			break
 		}
 
 		l.unread(w2)
 		l.unread(w1)
 
-		return l.scanString()
+		*tok = l.scanString()
 
 	case r == eof:
-		return Token{
+		if l.err != nil {
+			*tok = Token{
+				Kind:    TokenKindIllegal,
+				Literal: l.err.Error(),
+				Column:  l.lpos + 1,
+				Line:    l.line,
+			}
+			break
+		}
+
+		*tok = Token{
 			Kind:   TokenKindEOF,
 			Column: l.lpos + 1,
 			Line:   l.line,
 		}
 
 	default:
-		return Token{
+		*tok = Token{
 			Kind:    TokenKindIllegal,
 			Literal: string(r),
 			Column:  l.lpos,
 			Line:    l.line,
 		}
 	}
+
+	tok.Start = l.base + start
+	tok.End = l.base + l.pos
+	tok.EndLine = l.line
+	tok.EndColumn = l.lpos + 1
 }
 
 // scanString scans a valid GraphQL string.
@@ -174,9 +367,27 @@ Loop:
 			//bc += w
 
 			if r == 'u' {
-				r, _ = l.read()
+				r, w = l.read()
 				if r >= utf8.RuneSelf {
-					_, _ = l.readUnicode()
+					r, w = l.readUnicode()
+				}
+
+				// Variable width escape sequences are read up to the closing brace. They're
+				// validated, and decoded, along with the rest of the string below. No more bytes
+				// are needed for them, as they're at least as long as the rune they produce.
+				if r == '{' && l.opts.SpecVersion != SpecVersionJune2018 {
+					for r != '}' && r != '"' && r != eof {
+						r, w = l.read()
+						if r >= utf8.RuneSelf {
+							r, w = l.readUnicode()
+						}
+					}
+
+					if r == '"' {
+						l.unread(w)
+					}
+
+					continue
 				}
 
 				r, _ = l.read()
@@ -206,7 +417,7 @@ Loop:
 	if !hasEscape {
 		return Token{
 			Kind:    TokenKindStringValue,
-			Literal: btos(l.input[startPos : l.pos-1]),
+			Literal: l.literal(l.input[startPos : l.pos-1]),
 			Column:  startLPos,
 			Line:    startLine,
 		}
@@ -323,7 +534,7 @@ func (l *Lexer) scanBlockString() Token {
 	if !hasCR && !hasEscape {
 		return Token{
 			Kind:    TokenKindStringValue,
-			Literal: l.blockStringLiteral(btos(l.input[startPos : l.pos-3])),
+			Literal: l.blockStringLiteral(l.literal(l.input[startPos : l.pos-3])),
 			Column:  startLPos - 2,
 			Line:    startLine,
 		}
@@ -399,7 +610,10 @@ func (l *Lexer) blockStringLiteral(raw string) string {
 	lines := strings.Split(raw, "\n")
 	lineCount := len(lines)
 
-	l.line += lineCount - 1
+	if lineCount > 1 {
+		l.line += lineCount - 1
+		l.syncColumn()
+	}
 
 	commonIndent := math.MaxInt64
 	for i, line := range lines {
@@ -510,6 +724,10 @@ func escapedChar(l *Lexer) (rune, error) {
 			r1, _ = l.readUnicode()
 		}
 
+		if r1 == '{' && l.opts.SpecVersion != SpecVersionJune2018 {
+			return escapedCodePoint(l)
+		}
+
 		r2, _ := l.read()
 		if r2 >= utf8.RuneSelf {
 			r2, _ = l.readUnicode()
@@ -529,12 +747,85 @@ func escapedChar(l *Lexer) (rune, error) {
 		if r < 0 {
 			return 0, fmt.Errorf("invalid character escape sequence: %s", "\\u"+string([]rune{r1, r2, r3, r4}))
 		}
+
+		if l.opts.SpecVersion != SpecVersionJune2018 && r >= surrogateMin && r <= surrogateMax {
+			return escapedSurrogatePair(l, r)
+		}
+
 		return r, nil
 	}
 
 	return 0, fmt.Errorf("invalid character escape sequence: %s", "\\"+string(r))
 }
 
+// escapedCodePoint returns the rune for a variable width unicode escape sequence, e.g. "\u{1F600}",
+// having already read up to, and including, the opening brace.
+func escapedCodePoint(l *Lexer) (rune, error) {
+	var r rune
+	var digits int
+
+	seq := []rune("\\u{")
+
+	for {
+		c, _ := l.read()
+		if c >= utf8.RuneSelf {
+			c, _ = l.readUnicode()
+		}
+
+		seq = append(seq, c)
+
+		if c == '}' && digits > 0 {
+			break
+		}
+
+		i := hexRuneToInt(c)
+		if i < 0 || r > maxRune {
+			return 0, fmt.Errorf("invalid character escape sequence: %s", string(seq))
+		}
+
+		r = r<<4 | rune(i)
+		digits++
+	}
+
+	if r > maxRune || (r >= surrogateMin && r <= surrogateMax) {
+		return 0, fmt.Errorf("invalid character escape sequence: %s", string(seq))
+	}
+
+	return r, nil
+}
+
+// escapedSurrogatePair returns the rune for a fixed width unicode escape sequence that encodes the
+// given surrogate, which must be a leading surrogate immediately followed by an escape sequence for
+// a trailing surrogate, e.g. "\uD83D\uDE00".
+func escapedSurrogatePair(l *Lexer, lead rune) (rune, error) {
+	seq := fmt.Sprintf("\\u%04X", lead)
+
+	if lead > 0xDBFF {
+		return 0, fmt.Errorf("invalid character escape sequence: %s", seq)
+	}
+
+	var rs [6]rune
+	for i := range rs {
+		rs[i], _ = l.read()
+		if rs[i] >= utf8.RuneSelf {
+			rs[i], _ = l.readUnicode()
+		}
+
+		// Stop early, so that the end of the string isn't consumed.
+		if rs[i] == '"' || rs[i] == eof {
+			l.tryUnread(utf8.RuneLen(rs[i]))
+			return 0, fmt.Errorf("invalid character escape sequence: %s", seq)
+		}
+	}
+
+	trail := unicodeCodePointToRune(rs[2], rs[3], rs[4], rs[5])
+	if rs[0] != bsl || rs[1] != 'u' || trail < 0xDC00 || trail > surrogateMax {
+		return 0, fmt.Errorf("invalid character escape sequence: %s", seq)
+	}
+
+	return utf16.DecodeRune(lead, trail), nil
+}
+
 // encodeRune is a copy of the utf8.EncodeRune function, but instead of passing in a byte slice as
 // the first argument, a callback is given. This callback may be called multiple times. This allows
 // individual bytes to be passed back to the caller, one at a time. This enables the caller to do
@@ -590,8 +881,87 @@ func hexRuneToInt(r rune) int {
 	return -1
 }
 
-// scanComment scans valid GraphQL comments.
-func (l *Lexer) scanComment() Token {
+// scanIgnored attempts to scan a single ignored token, returning false if the next rune doesn't
+// start one. Runs of whitespace are returned as a single token, as are CRLF line terminators.
+func (l *Lexer) scanIgnored() (Token, bool) {
+	start := l.pos
+	line := l.line
+	column := l.lpos + 1
+
+	r, w := l.read()
+	if r >= utf8.RuneSelf {
+		r, w = l.readUnicode()
+	}
+
+	var kind TokenKind
+
+	switch r {
+	case ws, tab:
+		kind = TokenKindWhiteSpace
+
+		for {
+			r, w = l.read()
+			if r != ws && r != tab {
+				l.tryUnread(w)
+				break
+			}
+		}
+	case cr:
+		kind = TokenKindLineTerminator
+
+		if r, w = l.read(); r != lf {
+			l.tryUnread(w)
+		}
+
+		l.line++
+		l.lpos = 0
+	case lf:
+		kind = TokenKindLineTerminator
+
+		l.line++
+		l.lpos = 0
+	case com:
+		kind = TokenKindComma
+	case bom:
+		kind = TokenKindUnicodeBOM
+	case '#':
+		kind = TokenKindComment
+
+		for {
+			r, w = l.read()
+			if r >= utf8.RuneSelf {
+				r, w = l.readUnicode()
+			}
+
+			if r == cr || r == lf || w == 0 {
+				l.tryUnread(w)
+				break
+			}
+		}
+	default:
+		l.tryUnread(w)
+		return Token{}, false
+	}
+
+	literal := l.literal(l.input[start:l.pos])
+	if kind == TokenKindComment {
+		literal = literal[1:]
+	}
+
+	return Token{
+		Kind:      kind,
+		Literal:   literal,
+		Line:      line,
+		Column:    column,
+		EndLine:   l.line,
+		EndColumn: l.lpos + 1,
+		Start:     l.base + start,
+		End:       l.base + l.pos,
+	}, true
+}
+
+// skipComment skips the rest of a comment, up to and including the line terminator that ends it.
+func (l *Lexer) skipComment() {
 	var wasCR bool
 	var r rune
 	var w int
@@ -603,24 +973,24 @@ func (l *Lexer) scanComment() Token {
 		}
 
 		if r == eof {
-			return l.Scan()
+			return
 		}
 
 		// If on the last iteration we saw a CR, then we should check if we just read an LF on this
 		// iteration. If we did, reset line position as the next character is still the start of the
-		// next line, then scan.
+		// next line.
 		if wasCR && r == lf {
 			l.lpos = 0
 
-			return l.Scan()
+			return
 		}
 
 		// Otherwise, if we saw a CR, and this rune isn't an LF, then we have started reading the
-		// next line's runes, so unread the rune we read, and scan the next token.
+		// next line's runes, so unread the rune we read.
 		if wasCR && r != lf {
 			l.unread(w)
 
-			return l.Scan()
+			return
 		}
 
 		// If we encounter a CR at any point, this will be true.
@@ -638,7 +1008,7 @@ func (l *Lexer) scanComment() Token {
 			l.line++
 			l.lpos = 0
 
-			return l.Scan()
+			return
 		}
 	}
 }
@@ -668,7 +1038,7 @@ Loop:
 
 	return Token{
 		Kind:    TokenKindName,
-		Literal: btos(l.input[byteStart:l.pos]),
+		Literal: l.literal(l.input[byteStart:l.pos]),
 		Column:  runeStart,
 		Line:    l.line,
 	}
@@ -710,7 +1080,7 @@ func (l *Lexer) scanPunctuator(r rune, w int) Token {
 
 	return Token{
 		Kind:    TokenKindPunctuator,
-		Literal: btos(l.input[byteStart-w : byteStart]),
+		Literal: l.literal(l.input[byteStart-w : byteStart]),
 		Column:  runeStart,
 		Line:    l.line,
 	}
@@ -815,12 +1185,23 @@ func (l *Lexer) scanNumber(r rune) Token {
 		}
 	}
 
+	// As of the October 2021 spec, a number must not be followed by a '.', or the start of a name.
+	if l.opts.SpecVersion != SpecVersionJune2018 &&
+		(r == '.' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')) {
+		return Token{
+			Kind:    TokenKindIllegal,
+			Literal: fmt.Sprintf("invalid number, expected digit but got: %q", r),
+			Column:  runeStart,
+			Line:    l.line,
+		}
+	}
+
 	if r != eof {
 		l.unread(w)
 	}
 
 	t := Token{
-		Literal: btos(l.input[byteStart:l.pos]),
+		Literal: l.literal(l.input[byteStart:l.pos]),
 		Line:    l.line,
 		Column:  runeStart,
 	}
@@ -888,10 +1269,13 @@ Loop:
 			if !wasCR {
 				// \r\n is not 2 newlines, so we must check what the last rune was.
 				l.line++
-				l.lpos = 0
 			}
+
+			l.lpos = 0
+			wasCR = false
 		case r == tab || r == ws || r == com || r == bom:
 			// Skip!
+			wasCR = false
 		default:
 			// Done, this run was significant.
 			break Loop
@@ -906,6 +1290,7 @@ Loop:
 // the last left off. Returns the EOF rune if we hit the end of the input.
 func (l *Lexer) read() (rune, int) {
 	if l.pos >= l.inputLen {
+		l.short = true
 		return eof, 0
 	}
 
@@ -923,15 +1308,217 @@ func (l *Lexer) read() (rune, int) {
 // this method because we don't want to actually make the call to this function if we don't need to.
 func (l *Lexer) readUnicode() (rune, int) {
 	if l.pos >= l.inputLen {
+		l.short = true
 		return eof, 0
 	}
 
-	r, w := utf8.DecodeRune(l.input[l.pos-1:])
+	// The rest of the rune may not have been read into the buffer yet.
+	if !utf8.FullRune(l.input[l.pos-1 : l.inputLen]) {
+		l.short = true
+	}
+
+	r, w := utf8.DecodeRune(l.input[l.pos-1 : l.inputLen])
 	l.pos += w - 1
 
 	return r, w
 }
 
+// skipIgnored skips whitespace, line terminators, commas, and comments when reading from an
+// io.Reader, stopping at the next significant rune. Whenever the buffer runs out, the input that
+// has been skipped is discarded before more is read.
+func (l *Lexer) skipIgnored() {
+	var comment, wasCR bool
+
+	for {
+		if l.pos >= l.inputLen || (l.input[l.pos] >= utf8.RuneSelf && !utf8.FullRune(l.input[l.pos:l.inputLen])) {
+			if l.reader == nil {
+				return
+			}
+
+			l.discard()
+			l.fill()
+
+			continue
+		}
+
+		r, w := rune(l.input[l.pos]), 1
+		if r >= utf8.RuneSelf {
+			r, w = utf8.DecodeRune(l.input[l.pos:l.inputLen])
+		}
+
+		switch {
+		case r == cr:
+			l.line++
+			l.lpos = 0
+			comment = false
+			wasCR = true
+		case r == lf:
+			if !wasCR {
+				l.line++
+			}
+
+			l.lpos = 0
+			comment = false
+			wasCR = false
+		case comment || r == '#':
+			l.lpos++
+			comment = true
+			wasCR = false
+		case r == tab || r == ws || r == com || r == bom:
+			l.lpos++
+			wasCR = false
+		default:
+			return
+		}
+
+		l.pos += w
+	}
+}
+
+// fill reads more input from the reader into the buffer, growing the buffer if it's too small,
+// until the reader is exhausted, or the input that's buffered but not yet scanned has doubled, so
+// that tokens longer than the buffer are only scanned again a few times.
+func (l *Lexer) fill() {
+	want := l.inputLen - l.pos
+	if want == 0 {
+		want = 1
+	}
+
+	for len(l.input)-l.inputLen < want {
+		buf := make([]byte, 2*len(l.input))
+		copy(buf, l.input[:l.inputLen])
+		l.input = buf
+	}
+
+	var empty int
+
+	for l.reader != nil && want > 0 {
+		n, err := l.reader.Read(l.input[l.inputLen:])
+		l.inputLen += n
+		want -= n
+
+		if n == 0 && err == nil {
+			empty++
+			if empty >= maxEmptyReads {
+				err = io.ErrNoProgress
+			}
+		} else {
+			empty = 0
+		}
+
+		if err != nil {
+			if err != io.EOF {
+				l.err = err
+			}
+
+			l.reader = nil
+		}
+	}
+}
+
+// compact discards the input that has already been scanned from the buffer, once it takes up at
+// least half of the buffer. It must only be called between tokens.
+func (l *Lexer) compact() {
+	if l.pos >= len(l.input)/2 {
+		l.discard()
+	}
+}
+
+// discard removes the input that has already been scanned from the buffer, writing it to the
+// source first, if there is one. It must not be called while a token is being scanned.
+func (l *Lexer) discard() {
+	if l.pos == 0 {
+		return
+	}
+
+	// The start of the current line may be discarded, so columns must be converted up to here
+	// while it's still in the buffer.
+	if l.opts.ColumnUnit != ColumnUnitRunes {
+		l.column(l.line, l.base+l.pos)
+	}
+
+	if l.source != nil {
+		l.source.Write(l.input[:l.pos])
+	}
+
+	l.inputLen = copy(l.input, l.input[l.pos:l.inputLen])
+	l.base += l.pos
+	l.pos = 0
+}
+
+// flush writes the input that's still buffered to the source, if there is one. It's called once
+// scanning has finished, so that the source has been given all of the input that was read.
+func (l *Lexer) flush() {
+	if l.source != nil {
+		l.source.Write(l.input[:l.inputLen])
+		l.source = nil
+	}
+}
+
+// literal returns the given bytes of input as a string. When reading from a byte slice this doesn't
+// copy, but when reading from an io.Reader the buffer is reused, so the bytes must be copied.
+func (l *Lexer) literal(bs []byte) string {
+	if l.stream {
+		return string(bs)
+	}
+
+	return btos(bs)
+}
+
+// column returns the column of the given byte offset from the start of the stream, on the given
+// line, measured in the configured column unit.
+func (l *Lexer) column(line, offset int) int {
+	if line != l.cline || offset < l.coff || l.coff < l.base {
+		// Find the start of the line by walking back from the offset to the last line terminator.
+		i := offset - l.base
+		for i > 0 && l.input[i-1] != byte(lf) && l.input[i-1] != byte(cr) {
+			i--
+		}
+
+		l.cline = line
+		l.coff = l.base + i
+		l.ccol = 0
+	}
+
+	bs := l.input[l.coff-l.base : offset-l.base]
+	for len(bs) > 0 {
+		r, w := utf8.DecodeRune(bs)
+		bs = bs[w:]
+
+		switch {
+		case l.opts.ColumnUnit == ColumnUnitBytes:
+			l.ccol += w
+		case r > rune3Max:
+			l.ccol += 2 // Encoded as a surrogate pair.
+		default:
+			l.ccol++
+		}
+	}
+
+	l.coff = offset
+
+	return l.ccol + 1
+}
+
+// syncColumn recalculates the position, in runes, of the last rune read on the current line from
+// the input itself. This is needed after reading tokens that span multiple lines, because read does
+// not track line terminators itself.
+func (l *Lexer) syncColumn() {
+	i := l.pos
+	for i > 0 && l.input[i-1] != byte(lf) && l.input[i-1] != byte(cr) {
+		i--
+	}
+
+	l.lpos = utf8.RuneCount(l.input[i:l.pos])
+}
+
+// tryUnread is like unread, but does nothing if nothing was read, i.e. at the end of the input.
+func (l *Lexer) tryUnread(width int) {
+	if width > 0 {
+		l.unread(width)
+	}
+}
+
 // unread goes back one rune's worth of bytes in the input, changing the
 // positions we keep track of.
 // Does not currently go back a line.
@@ -2,13 +2,18 @@ package language_test
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/bucketd/go-graphqlparser/language"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	glexer "github.com/graphql-go/graphql/language/lexer"
 	gsource "github.com/graphql-go/graphql/language/source"
@@ -117,6 +122,394 @@ func BenchmarkLexer(b *testing.B) {
 	})
 }
 
+func TestLexer_ScanIgnored(t *testing.T) {
+	type token struct {
+		Kind    language.TokenKind
+		Literal string
+	}
+
+	tests := []struct {
+		msg      string
+		input    string
+		expected []token
+	}{
+		{
+			msg:   "whitespace and commas",
+			input: "\ufeff foo,\tbar",
+			expected: []token{
+				{language.TokenKindUnicodeBOM, "\ufeff"},
+				{language.TokenKindWhiteSpace, " "},
+				{language.TokenKindName, "foo"},
+				{language.TokenKindComma, ","},
+				{language.TokenKindWhiteSpace, "\t"},
+				{language.TokenKindName, "bar"},
+				{language.TokenKindEOF, ""},
+			},
+		},
+		{
+			msg:   "line terminators",
+			input: "foo" + slf + "bar" + scr + slf + "baz" + scr,
+			expected: []token{
+				{language.TokenKindName, "foo"},
+				{language.TokenKindLineTerminator, slf},
+				{language.TokenKindName, "bar"},
+				{language.TokenKindLineTerminator, scr + slf},
+				{language.TokenKindName, "baz"},
+				{language.TokenKindLineTerminator, scr},
+				{language.TokenKindEOF, ""},
+			},
+		},
+		{
+			msg:   "comments",
+			input: "# foo" + slf + "bar # baz",
+			expected: []token{
+				{language.TokenKindComment, " foo"},
+				{language.TokenKindLineTerminator, slf},
+				{language.TokenKindName, "bar"},
+				{language.TokenKindWhiteSpace, " "},
+				{language.TokenKindComment, " baz"},
+				{language.TokenKindEOF, ""},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			lxr := language.NewLexerWithOptions([]byte(test.input), language.LexerOptions{
+				ScanIgnored: true,
+			})
+
+			var actual []token
+			var end int
+
+			for {
+				tok := lxr.Scan()
+
+				// Every byte of input should be covered by a token.
+				assert.Equal(t, end, tok.Start)
+				end = tok.End
+
+				actual = append(actual, token{tok.Kind, tok.Literal})
+				if tok.Kind == language.TokenKindEOF || tok.Kind == language.TokenKindIllegal {
+					break
+				}
+			}
+
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, len(test.input), end)
+		})
+	}
+}
+
+func TestLexer_Scan_lineTerminators(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+	}{
+		{"a\nb", 2, 1},
+		{"a\rb", 2, 1},
+		{"a\r\nb", 2, 1},
+		{"a \r\n b", 2, 2},
+		{"a\r \nb", 3, 1},
+		{"a\n\r\nb", 3, 1},
+	}
+
+	for _, test := range tests {
+		lxr := language.NewLexer([]byte(test.input))
+		lxr.Scan()
+
+		tok := lxr.Scan()
+		require.Equal(t, "b", tok.Literal)
+
+		assert.Equal(t, test.line, tok.Line, "%q", test.input)
+		assert.Equal(t, test.column, tok.Column, "%q", test.input)
+	}
+}
+
+func TestLexer_ColumnUnit(t *testing.T) {
+	type position struct {
+		Literal   string
+		Column    int
+		EndColumn int
+	}
+
+	// "界" is 3 bytes and 1 UTF-16 code unit, "😃" is 4 bytes and 2 UTF-16 code units.
+	input := "{ foo(a: \"界😃\", b: \"\"\"\n😃 界\"\"\" c) # 😃\n  d: \"😃\" }"
+
+	tests := []struct {
+		msg      string
+		unit     language.ColumnUnit
+		expected []position
+	}{
+		{
+			msg:  "runes",
+			unit: language.ColumnUnitRunes,
+			expected: []position{
+				{"{", 1, 2}, {"foo", 3, 6}, {"(", 6, 7}, {"a", 7, 8}, {":", 8, 9}, {"界😃", 10, 14},
+				{"b", 16, 17}, {":", 17, 18}, {"😃 界", 19, 7}, {"c", 8, 9}, {")", 9, 10},
+				{"d", 3, 4}, {":", 4, 5}, {"😃", 6, 9}, {"}", 10, 11},
+			},
+		},
+		{
+			msg:  "bytes",
+			unit: language.ColumnUnitBytes,
+			expected: []position{
+				{"{", 1, 2}, {"foo", 3, 6}, {"(", 6, 7}, {"a", 7, 8}, {":", 8, 9}, {"界😃", 10, 19},
+				{"b", 21, 22}, {":", 22, 23}, {"😃 界", 24, 12}, {"c", 13, 14}, {")", 14, 15},
+				{"d", 3, 4}, {":", 4, 5}, {"😃", 6, 12}, {"}", 13, 14},
+			},
+		},
+		{
+			msg:  "utf-16",
+			unit: language.ColumnUnitUTF16,
+			expected: []position{
+				{"{", 1, 2}, {"foo", 3, 6}, {"(", 6, 7}, {"a", 7, 8}, {":", 8, 9}, {"界😃", 10, 15},
+				{"b", 17, 18}, {":", 18, 19}, {"😃 界", 20, 8}, {"c", 9, 10}, {")", 10, 11},
+				{"d", 3, 4}, {":", 4, 5}, {"😃", 6, 10}, {"}", 11, 12},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			lexers := map[string]*language.Lexer{
+				"bytes": language.NewLexerWithOptions([]byte(input), language.LexerOptions{
+					ColumnUnit: test.unit,
+				}),
+				"reader": language.NewLexerReaderWithOptions(iotest.OneByteReader(strings.NewReader(input)), language.LexerOptions{
+					ColumnUnit: test.unit,
+				}),
+			}
+
+			for name, lxr := range lexers {
+				var actual []position
+
+				for {
+					tok := lxr.Scan()
+					require.NotEqual(t, language.TokenKindIllegal, tok.Kind, tok.Literal)
+
+					if tok.Kind == language.TokenKindEOF {
+						break
+					}
+
+					actual = append(actual, position{tok.Literal, tok.Column, tok.EndColumn})
+				}
+
+				assert.Equal(t, test.expected, actual, name)
+			}
+		})
+	}
+}
+
+func TestNewLexerReader(t *testing.T) {
+	scanAll := func(lxr *language.Lexer) []language.Token {
+		var toks []language.Token
+		for {
+			tok := lxr.Scan()
+			toks = append(toks, tok)
+
+			if tok.Kind == language.TokenKindEOF || tok.Kind == language.TokenKindIllegal {
+				return toks
+			}
+		}
+	}
+
+	t.Run("should produce the same tokens as NewLexer", func(t *testing.T) {
+		inputs := map[string]string{
+			"query":        query,
+			"many queries": strings.Repeat(query, 100),
+			"long string":  `{ foo(bar: """` + strings.Repeat("baz ", 5000) + `""") }`,
+			"multi-byte":   strings.Repeat("# 😃\n{ foo(bar: \"界\") }\n", 500),
+		}
+
+		readers := map[string]func(string) io.Reader{
+			"reader": func(s string) io.Reader {
+				return strings.NewReader(s)
+			},
+			"one byte reader": func(s string) io.Reader {
+				return iotest.OneByteReader(strings.NewReader(s))
+			},
+		}
+
+		for iname, input := range inputs {
+			for rname, reader := range readers {
+				t.Run(iname+"/"+rname, func(t *testing.T) {
+					expected := scanAll(language.NewLexer([]byte(input)))
+					actual := scanAll(language.NewLexerReader(reader(input)))
+
+					assert.Equal(t, expected, actual)
+				})
+			}
+		}
+	})
+
+	t.Run("should not reuse memory used by earlier token literals", func(t *testing.T) {
+		lxr := language.NewLexerReader(iotest.HalfReader(strings.NewReader(strings.Repeat("foo bar ", 2000))))
+
+		first := lxr.Scan()
+		scanAll(lxr)
+
+		assert.Equal(t, "foo", first.Literal)
+	})
+
+	t.Run("should not buffer long runs of ignored input", func(t *testing.T) {
+		inputs := map[string]string{
+			"whitespace": "{ a" + strings.Repeat(" ", 8<<20) + "b }",
+			"comment":    "{ a\n#" + strings.Repeat("😃", 2<<20) + "\nb }",
+			"mixed":      "\"😃\"" + strings.Repeat(" ,\t#\r\n", 1<<20) + "\r\n  a",
+		}
+
+		for name, input := range inputs {
+			t.Run(name, func(t *testing.T) {
+				opts := language.LexerOptions{ColumnUnit: language.ColumnUnitUTF16}
+				r := &sizeReader{r: strings.NewReader(input)}
+
+				expected := scanAll(language.NewLexerWithOptions([]byte(input), opts))
+				actual := scanAll(language.NewLexerReaderWithOptions(r, opts))
+
+				assert.Equal(t, expected, actual)
+				assert.True(t, r.max <= 4096, "expected the buffer not to grow, but read %d bytes at once", r.max)
+			})
+		}
+	})
+
+	t.Run("should return an illegal token if the reader fails", func(t *testing.T) {
+		r := io.MultiReader(strings.NewReader("{ foo "), errReader{})
+
+		toks := scanAll(language.NewLexerReader(r))
+		require.Len(t, toks, 3)
+
+		assert.Equal(t, language.TokenKindIllegal, toks[2].Kind)
+		assert.Equal(t, "read failed", toks[2].Literal)
+	})
+}
+
+// sizeReader is an io.Reader that records the largest buffer it's asked to read into.
+type sizeReader struct {
+	r   io.Reader
+	max int
+}
+
+func (r *sizeReader) Read(p []byte) (int, error) {
+	if len(p) > r.max {
+		r.max = len(p)
+	}
+
+	return r.r.Read(p)
+}
+
+// errReader is an io.Reader that always fails.
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestLexer_SpecVersion(t *testing.T) {
+	type token struct {
+		Kind    language.TokenKind
+		Literal string
+	}
+
+	tests := []struct {
+		msg      string
+		input    string
+		version  language.SpecVersion
+		expected []token
+	}{
+		{
+			msg:      "october 2021 variable width escape",
+			input:    `"\u{1F600}"`,
+			version:  language.SpecVersionOctober2021,
+			expected: []token{{language.TokenKindStringValue, "😀"}},
+		},
+		{
+			msg:      "june 2018 variable width escape",
+			input:    `"\u{1F600}"`,
+			version:  language.SpecVersionJune2018,
+			expected: []token{{language.TokenKindIllegal, `invalid character escape sequence: \u{1F6`}},
+		},
+		{
+			msg:      "october 2021 surrogate pair",
+			input:    `"\uD83D\uDE00"`,
+			version:  language.SpecVersionOctober2021,
+			expected: []token{{language.TokenKindStringValue, "😀"}},
+		},
+		{
+			msg:      "june 2018 surrogate pair",
+			input:    `"\uD83D\uDE00"`,
+			version:  language.SpecVersionJune2018,
+			expected: []token{{language.TokenKindStringValue, "\uFFFD\uFFFD"}},
+		},
+		{
+			msg:      "october 2021 lone surrogate",
+			input:    `"\uD83D" foo`,
+			version:  language.SpecVersionOctober2021,
+			expected: []token{{language.TokenKindIllegal, `invalid character escape sequence: \uD83D`}},
+		},
+		{
+			msg:      "june 2018 lone surrogate",
+			input:    `"\uD83D" foo`,
+			version:  language.SpecVersionJune2018,
+			expected: []token{{language.TokenKindStringValue, "\uFFFD"}, {language.TokenKindName, "foo"}},
+		},
+		{
+			msg:      "october 2021 number followed by a name",
+			input:    "123abc",
+			version:  language.SpecVersionOctober2021,
+			expected: []token{{language.TokenKindIllegal, "invalid number, expected digit but got: 'a'"}},
+		},
+		{
+			msg:      "june 2018 number followed by a name",
+			input:    "123abc",
+			version:  language.SpecVersionJune2018,
+			expected: []token{{language.TokenKindIntValue, "123"}, {language.TokenKindName, "abc"}},
+		},
+		{
+			msg:      "october 2021 number followed by a dot",
+			input:    "1.2.3",
+			version:  language.SpecVersionOctober2021,
+			expected: []token{{language.TokenKindIllegal, "invalid number, expected digit but got: '.'"}},
+		},
+		{
+			msg:      "june 2018 number followed by a dot",
+			input:    "1.2.3",
+			version:  language.SpecVersionJune2018,
+			expected: []token{{language.TokenKindFloatValue, "1.2"}, {language.TokenKindIllegal, `invalid punctuator, expected "..." but got: ".3\x00"`}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			opts := language.LexerOptions{SpecVersion: test.version}
+
+			lexers := map[string]*language.Lexer{
+				"bytes":  language.NewLexerWithOptions([]byte(test.input), opts),
+				"reader": language.NewLexerReaderWithOptions(iotest.OneByteReader(strings.NewReader(test.input)), opts),
+			}
+
+			for name, lxr := range lexers {
+				var actual []token
+
+				for {
+					tok := lxr.Scan()
+					if tok.Kind == language.TokenKindEOF {
+						break
+					}
+
+					actual = append(actual, token{tok.Kind, tok.Literal})
+					if tok.Kind == language.TokenKindIllegal {
+						break
+					}
+				}
+
+				assert.Equal(t, test.expected, actual, name)
+			}
+		})
+	}
+}
+
 func TestLexer_ScanGolden(t *testing.T) {
 	tests := []struct {
 		index string
@@ -137,6 +530,11 @@ func TestLexer_ScanGolden(t *testing.T) {
 		{"012", "1.界"},
 		{"013", "01"},
 		{"014", "-1.1e界"},
+		{"015", "123abc"},
+		{"016", "1.2.3"},
+		{"017", "0x1F"},
+		{"018", "1e5_"},
+		{"019", "1.5e3.0"},
 
 		// scanPunctuator
 		{"101", "!$()...:=@[]{|}"},
@@ -175,6 +573,21 @@ func TestLexer_ScanGolden(t *testing.T) {
 		{"319", `"\uFFFF"`},
 		{"320", `"😀"`},
 		{"321", `"\uD800"`},
+		{"322", `"\u{1F600}"`},
+		{"323", `"\u{0}"`},
+		{"324", `"\u{10FFFF}"`},
+		{"325", `"\u{110000}"`},
+		{"326", `"\u{}"`},
+		{"327", `"\u{1F6Z0}"`},
+		{"328", `"\u{1F600"`},
+		{"329", `"\u{D800}"`},
+		{"330", `"\uD83D\uDE00"`},
+		{"331", `"\uDE00"`},
+		{"332", `"\uD83D"`},
+		{"333", `"\uD83D\u0041"`},
+		{"334", `"\uD83Dfoo bar"`},
+		{"335", `"\u{00000000000001F600}"`},
+		{"336", `"foo \u{4e16} bar"`},
 
 		// scanBlockString
 		{"401", `""""""`},
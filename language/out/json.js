@@ -0,0 +1,13 @@
+// Prints the graphql-js AST of the document read from stdin as JSON, for cross-checking the JSON
+// produced by ast.Document's MarshalJSON method, e.g.:
+//
+//     echo '{ foo }' | node json.js
+const { parse } = require("graphql/language");
+
+let input = "";
+
+process.stdin.setEncoding("utf8");
+process.stdin.on("data", (chunk) => input += chunk);
+process.stdin.on("end", () => {
+    console.log(JSON.stringify(parse(input), null, 2));
+});
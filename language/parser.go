@@ -1,18 +1,144 @@
 package language
 
 import (
-	"bytes"
-	"errors"
+	"io"
+	"math"
 	"strconv"
-	"strings"
+	"sync"
 
 	"github.com/bucketd/go-graphqlparser/ast"
 )
 
+// definitionKeywords are the names that may appear at the start of a top-level definition.
+var definitionKeywords = []string{
+	"query",
+	"mutation",
+	"subscription",
+	"fragment",
+	"extend",
+	"schema",
+	"scalar",
+	"type",
+	"interface",
+	"union",
+	"enum",
+	"input",
+	"directive",
+}
+
+// DefaultMaxDepth is the limit on how deeply brackets may be nested if ParserOptions.MaxDepth isn't
+// set. It's far deeper than any reasonable document, but shallow enough that parsing can't exhaust
+// the stack.
+const DefaultMaxDepth = 1000
+
+// ParserOptions configures optional Parser behaviour. The zero value results in the default
+// behaviour.
+type ParserOptions struct {
+	// RecoverErrors enables error recovery. Instead of stopping at the first syntax error, the
+	// parser skips ahead to the start of the next definition and carries on. Every syntax error
+	// encountered is returned as SyntaxErrors, alongside a partial document containing all of the
+	// definitions that could be parsed.
+	RecoverErrors bool
+
+	// SkipLocations stops the parser from recording source locations on AST nodes, leaving them
+	// zeroed. This saves a little work when locations aren't needed, e.g. for trusted queries.
+	SkipLocations bool
+
+	// ParseComments makes the parser attach comments to the AST nodes nearest to them, instead of
+	// discarding them. Comments are attached to definitions, selections, field definitions, input
+	// value definitions, and enum value definitions. Comments within other nodes are attached to
+	// the next one of those that follows them.
+	ParseComments bool
+
+	// MaxDepth limits how deeply brackets may be nested, i.e. selection sets, arguments, list and
+	// object values, and list types. Zero means DefaultMaxDepth, and a negative value means no
+	// limit. Nesting is parsed recursively, so without a limit, deeply nested input can exhaust the
+	// stack, which can't be recovered from.
+	MaxDepth int
+
+	// MaxTokens limits the number of tokens in the input, not including ignored tokens such as
+	// whitespace and comments. Zero means no limit.
+	MaxTokens int
+
+	// MaxDefinitions limits the number of top-level definitions in the input. Zero means no limit.
+	MaxDefinitions int
+
+	// MaxStringLength limits the length, in bytes, of the value of any string. Zero means no limit.
+	MaxStringLength int
+
+	// MaxSize limits the size of the input, in bytes. Zero means no limit.
+	MaxSize int
+
+	// Arena, if set, is used to allocate the nodes of the AST, so that the document is backed by a
+	// few large allocations instead of many small ones. The document must not be used after the
+	// arena is reset.
+	Arena *ast.Arena
+
+	// ColumnUnit is the unit that columns are measured in, both in the positions of AST node
+	// locations and in errors. Defaults to runes. Editors using the Language Server Protocol expect UTF-16.
+	ColumnUnit ColumnUnit
+
+	// SpecVersion is the edition of the GraphQL specification whose lexical grammar is followed.
+	// Defaults to the latest edition.
+	SpecVersion SpecVersion
+
+	// ExperimentalFragmentArguments enables variable definitions on fragment definitions, e.g.
+	// "fragment F($size: Int = 10) on User", and arguments on fragment spreads, e.g. "...F(size: 20)".
+	// These aren't part of the GraphQL specification.
+	ExperimentalFragmentArguments bool
+}
+
 // Parser is a parser for GraphQL documents.
 type Parser struct {
-	lexer *Lexer
-	token Token
+	lexer  *Lexer
+	token  Token
+	prev   Token // The last token consumed, used to find where a node ends.
+	opts   ParserOptions
+	source string // The name of the source being parsed, if any, recorded on errors and the document.
+
+	depth  int  // The number of unclosed brackets preceding the current token.
+	tokens int  // The number of tokens read so far.
+	closed bool // True if the previous token was a closing brace.
+	first  bool // True if the current token is the first on its line.
+
+	prevFirst bool  // True if the previous token was the first on its line.
+	next      Token // A token to make current again on the next scan, set by unscan.
+	unscanned bool  // True if next is set.
+
+	errs  SyntaxErrors // Errors collected so far, only used when recovering from errors.
+	limit *LimitError  // Set if one of the limits in opts has been exceeded.
+
+	comments []Token // Comments read, but not yet attached to a node.
+}
+
+// sourceBlock holds the source of a document along with the backing array of the document's
+// Sources, so that both are allocated at once. They belong to the document that's returned, so
+// unlike the rest of the parser's scratch memory they can't be reused by Reset.
+type sourceBlock struct {
+	source  ast.Source
+	sources [1]*ast.Source
+}
+
+// parserPool holds parsers that may be reused by ParseBytes.
+var parserPool = sync.Pool{
+	New: func() interface{} {
+		return NewParser(nil)
+	},
+}
+
+// ParseBytes parses the given input into a document, like NewParser(input).Parse(), but reuses
+// parsers, and their scratch memory, between calls. This reduces allocations on hot paths.
+func ParseBytes(input []byte) (ast.Document, error) {
+	p := parserPool.Get().(*Parser)
+	p.Reset(input)
+
+	doc, err := p.Parse()
+
+	// Don't hold on to the input while the parser is in the pool.
+	p.Reset(nil)
+	parserPool.Put(p)
+
+	return doc, err
 }
 
 // NewParser returns a new Parser instance.
@@ -22,54 +148,107 @@ func NewParser(input []byte) *Parser {
 	}
 }
 
+// NewParserWithOptions returns a new Parser instance, configured with the given options.
+func NewParserWithOptions(input []byte, opts ParserOptions) *Parser {
+	return &Parser{
+		lexer: NewLexerWithOptions(input, LexerOptions{
+			ScanIgnored: opts.ParseComments,
+			ColumnUnit:  opts.ColumnUnit,
+			SpecVersion: opts.SpecVersion,
+		}),
+		opts: opts,
+	}
+}
+
+// NewParserReader returns a new Parser instance that reads its input incrementally from the given
+// reader. See NewLexerReader.
+func NewParserReader(r io.Reader) *Parser {
+	return &Parser{
+		lexer: NewLexerReader(r),
+	}
+}
+
+// NewParserReaderWithOptions returns a new Parser instance that reads its input incrementally from
+// the given reader, configured with the given options.
+func NewParserReaderWithOptions(r io.Reader, opts ParserOptions) *Parser {
+	return &Parser{
+		lexer: NewLexerReaderWithOptions(r, LexerOptions{
+			ScanIgnored: opts.ParseComments,
+			ColumnUnit:  opts.ColumnUnit,
+			SpecVersion: opts.SpecVersion,
+		}),
+		opts: opts,
+	}
+}
+
+// Reset discards the state of this parser, and prepares it to parse the given input instead,
+// keeping its options. Memory used as scratch space while parsing is kept for reuse, so parsing
+// many inputs with one parser allocates less than creating a new parser for each of them.
+func (p *Parser) Reset(input []byte) {
+	p.lexer.Reset(input)
+
+	*p = Parser{
+		lexer:    p.lexer,
+		opts:     p.opts,
+		comments: p.comments[:0],
+	}
+}
+
 // Parse loops over the lexically analysed tokens produced by the lexer from the raw bytes of input
 // and parses them into an AST of the GraphQL Document which it returns.
 func (p *Parser) Parse() (ast.Document, error) {
 	var document ast.Document
 
-	p.token = p.lexer.Scan()
+	// The source is recorded once on the document, rather than on every location, so that the
+	// positions of locations can be found from it when they're needed.
+	if !p.opts.SkipLocations {
+		block := &sourceBlock{
+			source: ast.Source{
+				Name:       p.source,
+				Base:       p.lexer.base,
+				ColumnUnit: p.opts.ColumnUnit,
+			},
+		}
+
+		source := &block.source
+		block.sources[0] = source
+
+		if p.lexer.stream {
+			p.lexer.source = source
+		} else {
+			source.Body = p.lexer.input[:p.lexer.inputLen]
+		}
+
+		document.Sources = block.sources[:]
+	}
+
+	p.scan()
 
 	var definitions *ast.Definitions
 
-	typeExtensions := make(map[string]struct{})
+	var count int
 
 	for {
-		definition, err := p.parseDefinition(document)
-		if err != nil {
-			return ast.Document{}, err
-		}
+		start := p.tokens
 
-		definitions = definitions.Add(definition)
+		count++
+		if p.opts.MaxDefinitions > 0 && count > p.opts.MaxDefinitions {
+			return ast.Document{}, p.exceeded(LimitKindDefinitions, p.opts.MaxDefinitions, p.token)
+		}
 
-		// Maybe there's a better way of doing this?
-		switch definition.Kind {
-		case ast.DefinitionKindExecutable:
-			switch definition.ExecutableDefinition.Kind {
-			case ast.ExecutableDefinitionKindOperation:
-				document.OperationDefinitions++
-			case ast.ExecutableDefinitionKindFragment:
-				document.FragmentDefinitions++
-			}
-		case ast.DefinitionKindTypeSystem:
-			switch definition.TypeSystemDefinition.Kind {
-			case ast.TypeSystemDefinitionKindDirective:
-				document.DirectiveDefinitions++
-			case ast.TypeSystemDefinitionKindSchema:
-				document.SchemaDefinitions++
-			case ast.TypeSystemDefinitionKindType:
-				document.TypeDefinitions++
-			}
-		case ast.DefinitionKindTypeSystemExtension:
-			switch definition.TypeSystemExtension.Kind {
-			case ast.TypeSystemExtensionKindSchema:
-				document.SchemaExtensions++
-			case ast.TypeSystemExtensionKindType:
-				typeExtensions[definition.TypeSystemExtension.TypeExtension.Name] = struct{}{}
+		definition, err := p.parseDefinition(document)
+		if err != nil {
+			if err := p.recover(err, start); err != nil {
+				return ast.Document{}, err
 			}
+		} else {
+			definitions = definitions.AddIn(p.opts.Arena, definition)
 		}
 
 		if p.peek0(TokenKindIllegal) {
-			return ast.Document{}, p.unexpected(p.token)
+			if err := p.recover(p.unexpected(p.token), p.tokens); err != nil {
+				return ast.Document{}, err
+			}
 		}
 
 		if p.peek0(TokenKindEOF) {
@@ -77,16 +256,193 @@ func (p *Parser) Parse() (ast.Document, error) {
 		}
 	}
 
-	// This has to be handled differently to the other counts because you can have multiple type
-	// extensions per type, so if we just increment each time we see one we could have the wrong
-	// count, and end up allocating more memory than needed later.
-	document.TypeExtensions = int32(len(typeExtensions))
+	p.lexer.flush()
+
+	// Any comments left over at the end of the document are attached to the last definition.
+	if definitions != nil {
+		definitions.Data.Comments = p.danglingComments(definitions.Data.Comments, p.token)
+	}
 
 	document.Definitions = definitions.Reverse()
+	document.CountDefinitions()
+
+	if len(p.errs) > 0 {
+		return document, p.errs
+	}
 
 	return document, nil
 }
 
+// recover records the given error and skips ahead to the start of the next definition, if error
+// recovery is enabled. Otherwise, the error is returned so that parsing may be aborted. The start
+// argument is the number of tokens that had been read when the failed definition began, and is
+// used to ensure that some progress is always made.
+func (p *Parser) recover(err error, start int) error {
+	if p.limit != nil {
+		return p.limit
+	}
+
+	serr, ok := err.(*SyntaxError)
+	if !ok || !p.opts.RecoverErrors {
+		return err
+	}
+
+	p.errs = append(p.errs, serr)
+
+	// The offending token has already been reported, so it can be skipped. We must also always
+	// make progress, otherwise the same definition would fail to parse again.
+	if p.peek0(TokenKindIllegal) || p.tokens == start {
+		p.scan()
+	}
+
+	// A definition keyword at the start of a line in an unclosed block may have been consumed as a
+	// field name, before the error was found in what follows it.
+	if p.depth > 0 && p.prevFirst && p.prev.Kind == TokenKindName && p.startsDefinition(p.prev) {
+		p.unscan(p.prev)
+		p.depth = 0
+
+		return nil
+	}
+
+	// Skip to the next definition keyword, description, or selection set, that isn't nested in any
+	// brackets. An unclosed bracket is a common cause of syntax errors though, so a definition
+	// keyword that directly follows a closing brace is also accepted, as it's likely to start a new
+	// definition, as is one that starts a line and is followed by the rest of a definition.
+	for !p.peek0(TokenKindEOF) {
+		if p.peek0(TokenKindIllegal) {
+			p.errs = append(p.errs, p.syntaxError(p.token, nil))
+		} else if p.depth == 0 && p.peek0(TokenKindStringValue) {
+			break
+		} else if p.depth == 0 && (p.closed || p.first) && p.peek1(TokenKindPunctuator, "{") {
+			break
+		} else if (p.depth == 0 || p.closed) && p.peekn(TokenKindName, definitionKeywords...) {
+			p.depth = 0
+			break
+		} else if p.first && p.peekn(TokenKindName, definitionKeywords...) {
+			keyword := p.token
+
+			p.scan()
+			if p.limit != nil {
+				return p.limit
+			}
+
+			if p.startsDefinition(keyword) {
+				p.unscan(keyword)
+				p.depth = 0
+				break
+			}
+
+			// The current token hasn't been checked yet.
+			continue
+		}
+
+		p.scan()
+
+		// Once a limit has been exceeded the parser stops scanning, so the current token would
+		// never change.
+		if p.limit != nil {
+			return p.limit
+		}
+	}
+
+	return nil
+}
+
+// startsDefinition returns true if the given token is a definition keyword that, followed by the
+// current token, looks like the start of a definition, rather than a field that shares its name.
+func (p *Parser) startsDefinition(keyword Token) bool {
+	switch {
+	case p.peek0(TokenKindName):
+		for _, l := range definitionKeywords {
+			if keyword.Literal == l {
+				return true
+			}
+		}
+	case p.peek1(TokenKindPunctuator, "{"):
+		switch keyword.Literal {
+		case "query", "mutation", "subscription", "schema":
+			return true
+		}
+	case p.peek1(TokenKindPunctuator, "@"):
+		switch keyword.Literal {
+		case "query", "mutation", "subscription", "schema", "directive":
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseValue parses the input as a single GraphQL value, e.g. `{ foo: [1, 2, $bar] }`. Anything
+// other than ignored tokens following the value is a syntax error.
+func ParseValue(input []byte) (ast.Value, error) {
+	return NewParser(input).ParseValue()
+}
+
+// ParseType parses the input as a single GraphQL type reference, e.g. `[ID!]!`. Anything other
+// than ignored tokens following the type is a syntax error.
+func ParseType(input []byte) (ast.Type, error) {
+	return NewParser(input).ParseType()
+}
+
+// ParseSelectionSet parses the input as a single GraphQL selection set, including the braces,
+// e.g. `{ foo { bar } }`. Anything other than ignored tokens following the selection set is a
+// syntax error.
+func ParseSelectionSet(input []byte) (*ast.Selections, error) {
+	return NewParser(input).ParseSelectionSet()
+}
+
+// ParseValue parses the input as a single GraphQL value. See ParseValue.
+func (p *Parser) ParseValue() (ast.Value, error) {
+	p.scan()
+
+	value, err := p.parseValue()
+	if err = p.finish(err); err != nil {
+		return ast.Value{}, err
+	}
+
+	return value, nil
+}
+
+// ParseType parses the input as a single GraphQL type reference. See ParseType.
+func (p *Parser) ParseType() (ast.Type, error) {
+	p.scan()
+
+	astType, err := p.parseType()
+	if err = p.finish(err); err != nil {
+		return ast.Type{}, err
+	}
+
+	return astType, nil
+}
+
+// ParseSelectionSet parses the input as a single GraphQL selection set. See ParseSelectionSet.
+func (p *Parser) ParseSelectionSet() (*ast.Selections, error) {
+	p.scan()
+
+	selections, err := p.parseSelectionSet(false)
+	if err = p.finish(err); err != nil {
+		return nil, err
+	}
+
+	return selections, nil
+}
+
+// finish is used by the methods that parse part of a document to check that the whole input has
+// been consumed, given the error returned by the parse function, if any. If a limit was exceeded,
+// the LimitError is returned instead of the SyntaxError caused by it.
+func (p *Parser) finish(err error) error {
+	if err == nil && !p.peek0(TokenKindEOF) {
+		err = p.unexpected(p.token, p.expected(TokenKindEOF))
+	}
+
+	if err != nil && p.limit != nil {
+		return p.limit
+	}
+
+	return err
+}
+
 // parseDefinition ...
 func (p *Parser) parseDefinition(document ast.Document) (ast.Definition, error) {
 	var err error
@@ -94,17 +450,16 @@ func (p *Parser) parseDefinition(document ast.Document) (ast.Definition, error)
 	// We can only allow a shorthand query if it's the only executable definition.
 	isShorthandQuery := p.token.Literal == "{"
 
-	location := ast.Location{
-		Line:   p.token.Line,
-		Column: p.token.Column,
-	}
+	start := p.token
+	comments := p.leadingComments()
 
 	// ExecutableDefinition...
 	if p.peekn(TokenKindName, "query", "mutation", "subscription") || p.peek1(TokenKindPunctuator, "{") {
 		definition := ast.Definition{}
 		definition.Kind = ast.DefinitionKindExecutable
 		definition.ExecutableDefinition, err = p.parseOperationDefinition(isShorthandQuery)
-		definition.Location = location
+		definition.Loc = p.location(start)
+		definition.Comments = p.nodeComments(comments)
 
 		return definition, err
 	}
@@ -114,7 +469,8 @@ func (p *Parser) parseDefinition(document ast.Document) (ast.Definition, error)
 		definition := ast.Definition{}
 		definition.Kind = ast.DefinitionKindExecutable
 		definition.ExecutableDefinition, err = p.parseFragmentDefinition()
-		definition.Location = location
+		definition.Loc = p.location(start)
+		definition.Comments = p.nodeComments(comments)
 
 		return definition, err
 	}
@@ -124,7 +480,8 @@ func (p *Parser) parseDefinition(document ast.Document) (ast.Definition, error)
 		definition := ast.Definition{}
 		definition.Kind = ast.DefinitionKindTypeSystemExtension
 		definition.TypeSystemExtension, err = p.parseTypeSystemExtension()
-		definition.Location = location
+		definition.Loc = p.location(start)
+		definition.Comments = p.nodeComments(comments)
 
 		return definition, err
 	}
@@ -148,16 +505,17 @@ func (p *Parser) parseDefinition(document ast.Document) (ast.Definition, error)
 		definition := ast.Definition{}
 		definition.Kind = ast.DefinitionKindTypeSystem
 		definition.TypeSystemDefinition, err = p.parseTypeSystemDefinition(description)
-		definition.Location = location
+		definition.Loc = p.location(start)
+		definition.Comments = p.nodeComments(comments)
 
 		return definition, err
 	}
 
 	return ast.Definition{}, p.unexpected(p.token,
-		p.expected(TokenKindName, "query", "mutation", "fragment"),
+		p.expected(TokenKindName, "query", "mutation", "subscription", "fragment", "extend"),
+		p.expected(TokenKindName, typeDefLits...),
 		p.expected(TokenKindPunctuator, "{"),
 	)
-
 }
 
 // parseOperationDefinition ...
@@ -207,16 +565,16 @@ func (p *Parser) parseOperationDefinition(isShorthandQuery bool) (*ast.Executabl
 		return nil, err
 	}
 
-	return &ast.ExecutableDefinition{
+	return p.opts.Arena.NewExecutableDefinition(ast.ExecutableDefinition{
 		Kind: ast.ExecutableDefinitionKindOperation,
-		OperationDefinition: &ast.OperationDefinition{
+		OperationDefinition: p.opts.Arena.NewOperationDefinition(ast.OperationDefinition{
 			Kind:                opType,
 			Name:                name,
 			VariableDefinitions: variableDefinitions,
 			Directives:          directives,
 			SelectionSet:        selectionSet,
-		},
-	}, nil
+		}),
+	}), nil
 }
 
 // parseOperationType ...
@@ -244,11 +602,21 @@ func (p *Parser) parseFragmentDefinition() (*ast.ExecutableDefinition, error) {
 
 	tok, ok := p.consume0(TokenKindName)
 	if !ok {
-		return nil, nil
+		return nil, p.unexpected(p.token, p.expected(TokenKindName))
 	}
 
 	if tok.Literal == "on" {
-		return nil, p.unexpected(p.token, p.expected(TokenKindName, "!on"))
+		return nil, p.unexpected(tok, p.expected(TokenKindName, "!on"))
+	}
+
+	var variableDefinitions *ast.VariableDefinitions
+	var err error
+
+	if p.opts.ExperimentalFragmentArguments {
+		variableDefinitions, err = p.parseVariableDefinitions()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	condition, err := p.parseTypeCondition()
@@ -266,15 +634,16 @@ func (p *Parser) parseFragmentDefinition() (*ast.ExecutableDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.ExecutableDefinition{
+	return p.opts.Arena.NewExecutableDefinition(ast.ExecutableDefinition{
 		Kind: ast.ExecutableDefinitionKindFragment,
-		FragmentDefinition: &ast.FragmentDefinition{
-			Name:          tok.Literal,
-			TypeCondition: condition,
-			Directives:    directives,
-			SelectionSet:  selections,
-		},
-	}, nil
+		FragmentDefinition: p.opts.Arena.NewFragmentDefinition(ast.FragmentDefinition{
+			Name:                tok.Literal,
+			VariableDefinitions: variableDefinitions,
+			TypeCondition:       condition,
+			Directives:          directives,
+			SelectionSet:        selections,
+		}),
+	}), nil
 }
 
 // parseTypeCondition ...
@@ -290,10 +659,10 @@ func (p *Parser) parseTypeCondition() (*ast.TypeCondition, error) {
 	}
 
 	if conType.Kind != ast.TypeKindNamed {
-		return nil, p.unexpected(p.token, "NamedType")
+		return nil, p.unexpected(p.token, p.expected(TokenKindName))
 	}
 
-	condition := &ast.TypeCondition{}
+	condition := p.opts.Arena.NewTypeCondition(ast.TypeCondition{})
 	condition.NamedType = conType
 
 	return condition, nil
@@ -308,6 +677,8 @@ func (p *Parser) parseVariableDefinitions() (*ast.VariableDefinitions, error) {
 	var definitions *ast.VariableDefinitions
 
 	for {
+		start := p.token
+
 		if _, err := p.mustConsume1(TokenKindPunctuator, "$"); err != nil {
 			return nil, err
 		}
@@ -334,7 +705,8 @@ func (p *Parser) parseVariableDefinitions() (*ast.VariableDefinitions, error) {
 			return nil, err
 		}
 
-		definitions = definitions.Add(definition)
+		definition.Loc = p.location(start)
+		definitions = definitions.AddIn(p.opts.Arena, definition)
 
 		if p.peek1(TokenKindPunctuator, ")") {
 			break
@@ -353,6 +725,8 @@ func (p *Parser) parseDirectives(location ast.DirectiveLocation) (*ast.Directive
 	var directives *ast.Directives
 
 	for p.peek1(TokenKindPunctuator, "@") {
+		start := p.token
+
 		_, err := p.mustConsume1(TokenKindPunctuator, "@")
 		if err != nil {
 			return nil, err
@@ -372,8 +746,9 @@ func (p *Parser) parseDirectives(location ast.DirectiveLocation) (*ast.Directive
 		directive.Name = name.Literal
 		directive.Arguments = args
 		directive.Location = location
+		directive.Loc = p.location(start)
 
-		directives = directives.Add(directive)
+		directives = directives.AddIn(p.opts.Arena, directive)
 	}
 
 	if directives != nil {
@@ -399,6 +774,9 @@ func (p *Parser) parseSelectionSet(optional bool) (*ast.Selections, error) {
 		var selection ast.Selection
 		var err error
 
+		start := p.token
+		comments := p.leadingComments()
+
 		if p.skip1(TokenKindPunctuator, "...") {
 			if p.peek0(TokenKindName) && p.token.Literal != "on" {
 				selection, err = p.parseFragmentSpread()
@@ -418,7 +796,9 @@ func (p *Parser) parseSelectionSet(optional bool) (*ast.Selections, error) {
 			}
 		}
 
-		selections = selections.Add(selection)
+		selection.Loc = p.location(start)
+		selection.Comments = p.nodeComments(comments)
+		selections = selections.AddIn(p.opts.Arena, selection)
 
 		if p.peek1(TokenKindPunctuator, "}") || p.peek0(TokenKindEOF) {
 			break
@@ -430,6 +810,8 @@ func (p *Parser) parseSelectionSet(optional bool) (*ast.Selections, error) {
 		return nil, err
 	}
 
+	selections.Data.Comments = p.danglingComments(selections.Data.Comments, p.prev)
+
 	return selections.Reverse(), nil
 }
 
@@ -437,11 +819,20 @@ func (p *Parser) parseSelectionSet(optional bool) (*ast.Selections, error) {
 func (p *Parser) parseFragmentSpread() (ast.Selection, error) {
 	var selection ast.Selection
 
+	var arguments *ast.Arguments
+
 	tok, err := p.mustConsume0(TokenKindName)
 	if err != nil {
 		return selection, err
 	}
 
+	if p.opts.ExperimentalFragmentArguments {
+		arguments, err = p.parseArguments()
+		if err != nil {
+			return selection, err
+		}
+	}
+
 	directives, err := p.parseDirectives(ast.DirectiveLocationKindFragmentSpread)
 	if err != nil {
 		return selection, err
@@ -449,6 +840,7 @@ func (p *Parser) parseFragmentSpread() (ast.Selection, error) {
 
 	selection.Kind = ast.SelectionKindFragmentSpread
 	selection.Name = tok.Literal
+	selection.Arguments = arguments
 	selection.Directives = directives
 
 	return selection, nil
@@ -543,6 +935,8 @@ func (p *Parser) parseArguments() (*ast.Arguments, error) {
 	var arguments *ast.Arguments
 
 	for !p.skip1(TokenKindPunctuator, ")") {
+		start := p.token
+
 		name, err := p.mustConsume0(TokenKindName)
 		if err != nil {
 			return nil, err
@@ -561,8 +955,9 @@ func (p *Parser) parseArguments() (*ast.Arguments, error) {
 		argument := ast.Argument{}
 		argument.Name = name.Literal
 		argument.Value = value
+		argument.Loc = p.location(start)
 
-		arguments = arguments.Add(argument)
+		arguments = arguments.AddIn(p.opts.Arena, argument)
 	}
 
 	return arguments.Reverse(), nil
@@ -584,6 +979,20 @@ func (p *Parser) parseDefaultValue() (*ast.Value, error) {
 
 // parseValue ...
 func (p *Parser) parseValue() (ast.Value, error) {
+	start := p.token
+
+	value, err := p.parseValueLiteral()
+	if err != nil {
+		return value, err
+	}
+
+	value.Loc = p.location(start)
+
+	return value, nil
+}
+
+// parseValueLiteral ...
+func (p *Parser) parseValueLiteral() (ast.Value, error) {
 	if p.skip1(TokenKindPunctuator, "$") {
 		tok, err := p.mustConsume0(TokenKindName)
 		if err != nil {
@@ -596,27 +1005,26 @@ func (p *Parser) parseValue() (ast.Value, error) {
 		}, nil
 	}
 
+	// The lexer has already checked that numbers are well-formed, so the only possible errors here
+	// are range errors. Values that are out of range are still valid syntax, and the raw value is
+	// kept, so it's up to validation (or custom scalars) to decide what to do with them.
 	if tok, ok := p.consume0(TokenKindIntValue); ok {
-		iv, err := strconv.Atoi(tok.Literal)
-		if err != nil {
-			return ast.Value{}, err
-		}
+		iv, _ := strconv.Atoi(tok.Literal)
 
 		return ast.Value{
 			Kind:     ast.ValueKindInt,
 			IntValue: iv,
+			RawValue: tok.Literal,
 		}, nil
 	}
 
 	if tok, ok := p.consume0(TokenKindFloatValue); ok {
-		fv, err := strconv.ParseFloat(tok.Literal, 64)
-		if err != nil {
-			return ast.Value{}, err
-		}
+		fv, _ := strconv.ParseFloat(tok.Literal, 64)
 
 		return ast.Value{
 			Kind:       ast.ValueKindFloat,
 			FloatValue: fv,
+			RawValue:   tok.Literal,
 		}, nil
 	}
 
@@ -668,6 +1076,8 @@ func (p *Parser) parseValue() (ast.Value, error) {
 		object.Kind = ast.ValueKindObject
 
 		for !p.skip1(TokenKindPunctuator, "}") {
+			start := p.token
+
 			tok, err := p.mustConsume0(TokenKindName)
 			if err != nil {
 				return object, err
@@ -686,6 +1096,7 @@ func (p *Parser) parseValue() (ast.Value, error) {
 			field := ast.ObjectField{}
 			field.Name = tok.Literal
 			field.Value = value
+			field.Loc = p.location(start)
 
 			object.ObjectValue = append(object.ObjectValue, field)
 		}
@@ -693,7 +1104,13 @@ func (p *Parser) parseValue() (ast.Value, error) {
 		return object, nil
 	}
 
-	return ast.Value{}, errors.New("TODO: see `parseDefinition`")
+	return ast.Value{}, p.unexpected(p.token,
+		p.expected(TokenKindPunctuator, "$", "[", "{"),
+		p.expected(TokenKindIntValue),
+		p.expected(TokenKindFloatValue),
+		p.expected(TokenKindStringValue),
+		p.expected(TokenKindName),
+	)
 }
 
 // parseType ...
@@ -706,10 +1123,10 @@ func (p *Parser) parseType() (ast.Type, error) {
 
 		itemType, err := p.parseType()
 		if err != nil {
-			return astType, nil
+			return astType, err
 		}
 
-		astType.ListType = &itemType
+		astType.ListType = p.opts.Arena.NewType(itemType)
 
 		if _, err := p.mustConsume1(TokenKindPunctuator, "]"); err != nil {
 			return astType, err
@@ -745,9 +1162,9 @@ func (p *Parser) parseTypeExtension() (*ast.TypeExtension, error) {
 		return nil, err
 	}
 
-	te := &ast.TypeExtension{}
+	te := p.opts.Arena.NewTypeExtension(ast.TypeExtension{})
 
-	if kind.Literal == "type" && p.peek1(TokenKindName, "implements") {
+	if (kind.Literal == "type" || kind.Literal == "interface") && p.peek1(TokenKindName, "implements") {
 		ii, err := p.parseImplementsInterfaces()
 		if err != nil {
 			return nil, err
@@ -837,7 +1254,7 @@ func (p *Parser) parseTypeSystemDefinition(description string) (*ast.TypeSystemD
 			return nil, err
 		}
 
-		tsDefinition := &ast.TypeSystemDefinition{}
+		tsDefinition := p.opts.Arena.NewTypeSystemDefinition(ast.TypeSystemDefinition{})
 		tsDefinition.Kind = ast.TypeSystemDefinitionKindSchema
 		tsDefinition.SchemaDefinition = schemaDef
 
@@ -851,7 +1268,7 @@ func (p *Parser) parseTypeSystemDefinition(description string) (*ast.TypeSystemD
 			return nil, err
 		}
 
-		tsDefinition := &ast.TypeSystemDefinition{}
+		tsDefinition := p.opts.Arena.NewTypeSystemDefinition(ast.TypeSystemDefinition{})
 		tsDefinition.Kind = ast.TypeSystemDefinitionKindDirective
 		tsDefinition.DirectiveDefinition = directiveDef
 
@@ -870,19 +1287,21 @@ func (p *Parser) parseTypeSystemDefinition(description string) (*ast.TypeSystemD
 			typeDef.Description = description
 		}
 
-		tsDefinition := &ast.TypeSystemDefinition{}
+		tsDefinition := p.opts.Arena.NewTypeSystemDefinition(ast.TypeSystemDefinition{})
 		tsDefinition.Kind = ast.TypeSystemDefinitionKindType
 		tsDefinition.TypeDefinition = typeDef
 
 		return tsDefinition, nil
 	}
 
-	return &ast.TypeSystemDefinition{}, nil
+	return p.opts.Arena.NewTypeSystemDefinition(ast.TypeSystemDefinition{}), nil
 }
 
 // 3.4.3
 // parseTypeSystemExtension ...
 func (p *Parser) parseTypeSystemExtension() (*ast.TypeSystemExtension, error) {
+	start := p.token
+
 	if !p.skip1(TokenKindName, "extend") {
 		return nil, p.unexpected(p.token, p.expected(TokenKindName, "extend"))
 	}
@@ -894,7 +1313,7 @@ func (p *Parser) parseTypeSystemExtension() (*ast.TypeSystemExtension, error) {
 			return nil, err
 		}
 
-		tsExtension := &ast.TypeSystemExtension{}
+		tsExtension := p.opts.Arena.NewTypeSystemExtension(ast.TypeSystemExtension{})
 		tsExtension.Kind = ast.TypeSystemExtensionKindSchema
 		tsExtension.SchemaExtension = schemaExt
 
@@ -908,7 +1327,9 @@ func (p *Parser) parseTypeSystemExtension() (*ast.TypeSystemExtension, error) {
 			return nil, err
 		}
 
-		tsExtension := &ast.TypeSystemExtension{}
+		typeExt.Loc = p.location(start)
+
+		tsExtension := p.opts.Arena.NewTypeSystemExtension(ast.TypeSystemExtension{})
 		tsExtension.Kind = ast.TypeSystemExtensionKindType
 		tsExtension.TypeExtension = typeExt
 
@@ -959,10 +1380,10 @@ func (p *Parser) parseSchemaDefinition() (*ast.SchemaDefinition, error) {
 		}
 
 		if namedType.Kind != ast.TypeKindNamed {
-			return nil, p.unexpected(p.token, "NamedType")
+			return nil, p.unexpected(p.token, p.expected(TokenKindName))
 		}
 
-		operationTypeDefinitions = operationTypeDefinitions.Add(ast.OperationTypeDefinition{
+		operationTypeDefinitions = operationTypeDefinitions.AddIn(p.opts.Arena, ast.OperationTypeDefinition{
 			OperationType: opType,
 			NamedType:     namedType,
 		})
@@ -977,10 +1398,10 @@ func (p *Parser) parseSchemaDefinition() (*ast.SchemaDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.SchemaDefinition{
+	return p.opts.Arena.NewSchemaDefinition(ast.SchemaDefinition{
 		Directives:               directives,
 		OperationTypeDefinitions: operationTypeDefinitions.Reverse(),
-	}, nil
+	}), nil
 }
 
 // 3.2.2
@@ -1008,10 +1429,10 @@ func (p *Parser) parseSchemaExtension() (*ast.SchemaExtension, error) {
 		return nil, p.unexpected(p.token)
 	}
 
-	return &ast.SchemaExtension{
+	return p.opts.Arena.NewSchemaExtension(ast.SchemaExtension{
 		Directives:               directives,
 		OperationTypeDefinitions: operationTypeDefinitions.Reverse(),
-	}, nil
+	}), nil
 }
 
 func (p *Parser) parseOperationTypeDefinitions() (*ast.OperationTypeDefinitions, error) {
@@ -1037,10 +1458,10 @@ func (p *Parser) parseOperationTypeDefinitions() (*ast.OperationTypeDefinitions,
 		}
 
 		if namedType.Kind != ast.TypeKindNamed {
-			return nil, p.unexpected(p.token, "NamedType")
+			return nil, p.unexpected(p.token, p.expected(TokenKindName))
 		}
 
-		operationTypeDefinitions = operationTypeDefinitions.Add(ast.OperationTypeDefinition{
+		operationTypeDefinitions = operationTypeDefinitions.AddIn(p.opts.Arena, ast.OperationTypeDefinition{
 			OperationType: opType,
 			NamedType:     namedType,
 		})
@@ -1072,7 +1493,7 @@ func (p *Parser) parseArgumentsDefinition() (*ast.InputValueDefinitions, error)
 			return nil, err
 		}
 
-		defs = defs.Add(def)
+		defs = defs.AddIn(p.opts.Arena, def)
 
 		if p.peek1(TokenKindPunctuator, ")") || p.peek0(TokenKindEOF) {
 			break
@@ -1084,6 +1505,8 @@ func (p *Parser) parseArgumentsDefinition() (*ast.InputValueDefinitions, error)
 		return nil, err
 	}
 
+	defs.Data.Comments = p.danglingComments(defs.Data.Comments, p.prev)
+
 	return defs.Reverse(), nil
 }
 
@@ -1091,6 +1514,9 @@ func (p *Parser) parseArgumentsDefinition() (*ast.InputValueDefinitions, error)
 func (p *Parser) parseInputValueDefinition(directiveLocation ast.DirectiveLocation) (ast.InputValueDefinition, error) {
 	var description string
 
+	start := p.token
+	comments := p.leadingComments()
+
 	descriptionTok, ok := p.consume0(TokenKindStringValue)
 	if ok {
 		description = descriptionTok.Literal
@@ -1121,6 +1547,8 @@ func (p *Parser) parseInputValueDefinition(directiveLocation ast.DirectiveLocati
 	}
 
 	def := ast.InputValueDefinition{
+		Loc:          p.location(start),
+		Comments:     p.nodeComments(comments),
 		Description:  description,
 		Name:         nameTok.Literal,
 		Type:         inputValType,
@@ -1151,8 +1579,14 @@ func (p *Parser) parseDirectiveDefinition(description string) (*ast.DirectiveDef
 		return nil, err
 	}
 
+	repeatable := p.skip1(TokenKindName, "repeatable")
+
 	if !p.skip1(TokenKindName, "on") {
-		return nil, p.unexpected(p.token, p.expected(TokenKindName, "on"))
+		if repeatable {
+			return nil, p.unexpected(p.token, p.expected(TokenKindName, "on"))
+		}
+
+		return nil, p.unexpected(p.token, p.expected(TokenKindName, "repeatable", "on"))
 	}
 
 	locations, err := p.parseDirectiveLocations()
@@ -1160,12 +1594,13 @@ func (p *Parser) parseDirectiveDefinition(description string) (*ast.DirectiveDef
 		return nil, err
 	}
 
-	return &ast.DirectiveDefinition{
+	return p.opts.Arena.NewDirectiveDefinition(ast.DirectiveDefinition{
 		Description:         description,
 		Name:                nameTok.Literal,
 		DirectiveLocations:  locations,
 		ArgumentsDefinition: arguments,
-	}, nil
+		Repeatable:          repeatable,
+	}), nil
 }
 
 // parseTypeDefinition ...
@@ -1205,11 +1640,11 @@ func (p *Parser) parseScalarTypeDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
 		Kind:       ast.TypeDefinitionKindScalar,
 		Name:       name.Literal,
 		Directives: directives,
-	}, nil
+	}), nil
 }
 
 // parseObjectDefinition ...
@@ -1234,13 +1669,13 @@ func (p *Parser) parseObjectDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
 		Kind:                ast.TypeDefinitionKindObject,
 		Name:                name.Literal,
 		ImplementsInterface: implements,
 		Directives:          directives,
 		FieldsDefinition:    fieldDefs,
-	}, nil
+	}), nil
 }
 
 // parseInterfaceDefinition ...
@@ -1250,6 +1685,11 @@ func (p *Parser) parseInterfaceDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
+	implements, err := p.parseImplementsInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
 	directives, err := p.parseDirectives(ast.DirectiveLocationKindInterface)
 	if err != nil {
 		return nil, err
@@ -1260,12 +1700,13 @@ func (p *Parser) parseInterfaceDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
-		Kind:             ast.TypeDefinitionKindInterface,
-		Name:             name.Literal,
-		Directives:       directives,
-		FieldsDefinition: fieldDefs,
-	}, nil
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
+		Kind:                ast.TypeDefinitionKindInterface,
+		Name:                name.Literal,
+		ImplementsInterface: implements,
+		Directives:          directives,
+		FieldsDefinition:    fieldDefs,
+	}), nil
 }
 
 // parseUnionDefinition ...
@@ -1285,12 +1726,12 @@ func (p *Parser) parseUnionDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
 		Kind:             ast.TypeDefinitionKindUnion,
 		Name:             name.Literal,
 		Directives:       directives,
 		UnionMemberTypes: memberTypes,
-	}, nil
+	}), nil
 }
 
 // parseEnumDefinition ...
@@ -1310,12 +1751,12 @@ func (p *Parser) parseEnumDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
 		Kind:                 ast.TypeDefinitionKindEnum,
 		Name:                 name.Literal,
 		Directives:           directives,
 		EnumValuesDefinition: enumValues,
-	}, nil
+	}), nil
 }
 
 // parseInputObjectDefinition ...
@@ -1335,12 +1776,12 @@ func (p *Parser) parseInputObjectDefinition() (*ast.TypeDefinition, error) {
 		return nil, err
 	}
 
-	return &ast.TypeDefinition{
+	return p.opts.Arena.NewTypeDefinition(ast.TypeDefinition{
 		Kind:                  ast.TypeDefinitionKindInputObject,
 		Name:                  name.Literal,
 		Directives:            directives,
 		InputFieldsDefinition: inputFields,
-	}, nil
+	}), nil
 }
 
 // parseImplementsInterfaces ...
@@ -1359,7 +1800,7 @@ func (p *Parser) parseImplementsInterfaces() (*ast.Types, error) {
 			return nil, err
 		}
 
-		interfaceTypes = interfaceTypes.Add(interfaceType)
+		interfaceTypes = interfaceTypes.AddIn(p.opts.Arena, interfaceType)
 
 		if !p.skip1(TokenKindPunctuator, "&") {
 			break
@@ -1378,6 +1819,9 @@ func (p *Parser) parseFieldsDefinition() (*ast.FieldDefinitions, error) {
 	var fieldDefs *ast.FieldDefinitions
 
 	for {
+		start := p.token
+		comments := p.leadingComments()
+
 		var description string
 		if tok, ok := p.consume0(TokenKindStringValue); ok {
 			description = tok.Literal
@@ -1408,6 +1852,8 @@ func (p *Parser) parseFieldsDefinition() (*ast.FieldDefinitions, error) {
 		}
 
 		fieldDef := ast.FieldDefinition{
+			Loc:                 p.location(start),
+			Comments:            p.nodeComments(comments),
 			Description:         description,
 			Name:                name.Literal,
 			ArgumentsDefinition: arguments,
@@ -1415,9 +1861,10 @@ func (p *Parser) parseFieldsDefinition() (*ast.FieldDefinitions, error) {
 			Directives:          directives,
 		}
 
-		fieldDefs = fieldDefs.Add(fieldDef)
+		fieldDefs = fieldDefs.AddIn(p.opts.Arena, fieldDef)
 
 		if p.skip1(TokenKindPunctuator, "}") {
+			fieldDefs.Data.Comments = p.danglingComments(fieldDefs.Data.Comments, p.prev)
 			break
 		}
 	}
@@ -1445,7 +1892,7 @@ func (p *Parser) parseUnionMemberTypes() (*ast.Types, error) {
 			return nil, err
 		}
 
-		memberTypes = memberTypes.Add(memberType)
+		memberTypes = memberTypes.AddIn(p.opts.Arena, memberType)
 
 		if !p.skip1(TokenKindPunctuator, "|") {
 			break
@@ -1464,6 +1911,9 @@ func (p *Parser) parseEnumValuesDefinition() (*ast.EnumValueDefinitions, error)
 	var valDefs *ast.EnumValueDefinitions
 
 	for {
+		start := p.token
+		comments := p.leadingComments()
+
 		var description string
 		if tok, ok := p.consume0(TokenKindStringValue); ok {
 			description = tok.Literal
@@ -1485,14 +1935,17 @@ func (p *Parser) parseEnumValuesDefinition() (*ast.EnumValueDefinitions, error)
 		}
 
 		valDef := ast.EnumValueDefinition{
+			Loc:         p.location(start),
+			Comments:    p.nodeComments(comments),
 			Description: description,
 			EnumValue:   enumValue.Literal,
 			Directives:  directives,
 		}
 
-		valDefs = valDefs.Add(valDef)
+		valDefs = valDefs.AddIn(p.opts.Arena, valDef)
 
 		if p.skip1(TokenKindPunctuator, "}") {
+			valDefs.Data.Comments = p.danglingComments(valDefs.Data.Comments, p.prev)
 			break
 		}
 	}
@@ -1514,9 +1967,10 @@ func (p *Parser) parseInputFieldsDefinition() (*ast.InputValueDefinitions, error
 			return nil, err
 		}
 
-		valDefs = valDefs.Add(valDef)
+		valDefs = valDefs.AddIn(p.opts.Arena, valDef)
 
 		if p.skip1(TokenKindPunctuator, "}") {
+			valDefs.Data.Comments = p.danglingComments(valDefs.Data.Comments, p.prev)
 			break
 		}
 	}
@@ -1578,7 +2032,7 @@ func (p *Parser) consume0(t TokenKind) (Token, bool) {
 	ok := p.token.Kind == t
 
 	if ok {
-		p.token = p.lexer.Scan()
+		p.scan()
 	}
 
 	return tok, ok
@@ -1590,7 +2044,7 @@ func (p *Parser) consume1(t TokenKind, l string) (Token, bool) {
 	ok := p.token.Kind == t && p.token.Literal == l
 
 	if ok {
-		p.token = p.lexer.Scan()
+		p.scan()
 	}
 
 	return tok, ok
@@ -1604,7 +2058,7 @@ func (p *Parser) consumen(t TokenKind, ls ...string) (Token, bool) {
 	}
 
 	if len(ls) == 0 {
-		p.token = p.lexer.Scan()
+		p.scan()
 		return tok, true
 	}
 
@@ -1613,7 +2067,7 @@ func (p *Parser) consumen(t TokenKind, ls ...string) (Token, bool) {
 			continue
 		}
 
-		p.token = p.lexer.Scan()
+		p.scan()
 		return tok, true
 	}
 
@@ -1628,7 +2082,7 @@ func (p *Parser) mustConsume0(t TokenKind) (Token, error) {
 		return tok, p.unexpected(tok, p.expected(t))
 	}
 
-	p.token = p.lexer.Scan()
+	p.scan()
 
 	return tok, nil
 }
@@ -1641,7 +2095,7 @@ func (p *Parser) mustConsume1(t TokenKind, l string) (Token, error) {
 		return tok, p.unexpected(tok, p.expected(t, l))
 	}
 
-	p.token = p.lexer.Scan()
+	p.scan()
 
 	return tok, nil
 }
@@ -1691,7 +2145,7 @@ func (p *Parser) skip0(t TokenKind) bool {
 		return false
 	}
 
-	p.token = p.lexer.Scan()
+	p.scan()
 
 	return true
 }
@@ -1702,7 +2156,7 @@ func (p *Parser) skip1(t TokenKind, l string) bool {
 		return false
 	}
 
-	p.token = p.lexer.Scan()
+	p.scan()
 
 	return true
 }
@@ -1714,53 +2168,254 @@ func (p *Parser) skip(t TokenKind, ls ...string) bool {
 		return false
 	}
 
-	p.token = p.lexer.Scan()
+	p.scan()
 
 	return true
 }
 
-// scan ...
+// scan advances the parser to the next token, keeping track of how deeply nested in brackets the
+// new token is, and how many tokens have been read so far. If any limit is exceeded, the current
+// token is replaced with an illegal one so that parsing stops.
 func (p *Parser) scan() {
-	p.token = p.lexer.Scan()
-}
-
-// expected ...
-func (p *Parser) expected(t TokenKind, ls ...string) string {
-	buf := &bytes.Buffer{}
-	buf.WriteString(t.String())
-	buf.WriteString(" '")
-	buf.WriteString(strings.Join(ls, "|"))
-	buf.WriteString("'")
-	return btos(buf.Bytes())
-}
-
-// unexpected ...
-func (p *Parser) unexpected(token Token, wants ...string) error {
-	//_, file, line, _ := runtime.Caller(2)
-	//fmt.Println(file, line)
-
-	buf := &bytes.Buffer{}
-	buf.WriteString("parser error: unexpected token found at ")
-	buf.WriteString("line: ")
-	buf.WriteString(strconv.Itoa(token.Line))
-	buf.WriteString(", column: ")
-	buf.WriteString(strconv.Itoa(token.Column))
-	buf.WriteString(". Found: ")
-	buf.WriteString(token.Kind.String())
-	buf.WriteString(" '")
-	buf.WriteString(token.Literal)
-	buf.WriteString("'.")
-
-	if len(wants) > 0 {
-		buf.WriteString(" Wanted: ")
-		for i, want := range wants {
-			buf.WriteString(want)
-			if i < len(wants)-1 {
-				buf.WriteString(" or ")
+	if p.limit != nil {
+		return
+	}
+
+	p.closed = false
+
+	// Brackets are always a single byte, so only the first byte of punctuators needs checking.
+	if p.token.Kind == TokenKindPunctuator {
+		switch p.token.Literal[0] {
+		case '{', '(', '[':
+			p.depth++
+		case '}', ')', ']':
+			if p.depth > 0 {
+				p.depth--
+			}
+
+			p.closed = p.token.Literal[0] == '}'
+		}
+	}
+
+	p.prev = p.token
+	p.prevFirst = p.first
+
+	if p.unscanned {
+		p.token = p.next
+		p.unscanned = false
+	} else {
+		p.lexer.scan(&p.token)
+		p.tokens++
+
+		// The lexer only produces ignored tokens if we're parsing comments. Comments are kept
+		// until they can be attached to a node, and everything else is skipped.
+		for p.token.Kind >= TokenKindUnicodeBOM {
+			if p.token.Kind == TokenKindComment {
+				p.comments = append(p.comments, p.token)
 			}
+
+			p.lexer.scan(&p.token)
 		}
-		buf.WriteString(".")
 	}
 
-	return errors.New(btos(buf.Bytes()))
+	p.first = p.token.Line > p.prev.EndLine
+
+	switch {
+	case p.depth > p.maxDepth():
+		p.exceeded(LimitKindDepth, p.maxDepth(), p.prev)
+	case p.opts.MaxSize > 0 && p.token.End > p.opts.MaxSize:
+		p.exceeded(LimitKindSize, p.opts.MaxSize, p.token)
+	case p.opts.MaxTokens > 0 && p.tokens > p.opts.MaxTokens && p.token.Kind != TokenKindEOF:
+		p.exceeded(LimitKindTokens, p.opts.MaxTokens, p.token)
+	case p.opts.MaxStringLength > 0 && p.token.Kind == TokenKindStringValue && len(p.token.Literal) > p.opts.MaxStringLength:
+		p.exceeded(LimitKindStringLength, p.opts.MaxStringLength, p.token)
+	}
+}
+
+// unscan makes the given token, which was consumed just before the current one, current again.
+// The current token becomes current once more on the next scan. It's only used when recovering
+// from errors, and the given token must have been the first on its line.
+func (p *Parser) unscan(tok Token) {
+	p.next = p.token
+	p.unscanned = true
+	p.token = tok
+	p.first = true
+}
+
+// maxDepth returns the limit on how deeply brackets may be nested, see ParserOptions.MaxDepth.
+func (p *Parser) maxDepth() int {
+	switch {
+	case p.opts.MaxDepth > 0:
+		return p.opts.MaxDepth
+	case p.opts.MaxDepth < 0:
+		return math.MaxInt32
+	}
+
+	return DefaultMaxDepth
+}
+
+// exceeded records that the given limit was exceeded at the given token, and replaces the current
+// token with an illegal one, so that parsing stops. The resulting LimitError is returned.
+func (p *Parser) exceeded(kind LimitKind, max int, at Token) *LimitError {
+	p.limit = &LimitError{
+		Kind:     kind,
+		Max:      max,
+		Location: tokenLocation(at),
+		Position: p.position(at),
+	}
+
+	p.token = Token{
+		Kind:      TokenKindIllegal,
+		Literal:   p.limit.Message(),
+		Line:      p.token.Line,
+		Column:    p.token.Column,
+		EndLine:   p.token.Line,
+		EndColumn: p.token.Column,
+		Start:     p.token.Start,
+		End:       p.token.Start,
+	}
+
+	return p.limit
+}
+
+// leadingComments takes all comments that have been read but not yet attached to a node, to be
+// attached to the node that starts at the current token.
+func (p *Parser) leadingComments() []ast.Comment {
+	if len(p.comments) == 0 {
+		return nil
+	}
+
+	comments := make([]ast.Comment, len(p.comments))
+	for i, t := range p.comments {
+		comments[i] = comment(t, ast.CommentKindLeading)
+	}
+
+	p.comments = p.comments[:0]
+
+	return comments
+}
+
+// comment returns the comment of the given kind for the given comment token.
+func comment(t Token, kind ast.CommentKind) ast.Comment {
+	return ast.Comment{
+		Loc:  tokenLocation(t),
+		Text: t.Literal,
+		Kind: kind,
+	}
+}
+
+// nodeComments returns the comments to attach to a node that ends with the last token consumed,
+// given the leading comments that were taken when it started. A comment that follows that token on
+// the same line is taken as a trailing comment.
+func (p *Parser) nodeComments(leading []ast.Comment) *ast.Comments {
+	comments := leading
+
+	for i, t := range p.comments {
+		if t.Line == p.prev.EndLine && t.Start >= p.prev.End {
+			comments = append(comments, comment(t, ast.CommentKindTrailing))
+
+			p.comments = append(p.comments[:i], p.comments[i+1:]...)
+			break
+		}
+	}
+
+	if len(comments) == 0 {
+		return nil
+	}
+
+	return ast.CommentsFromSlice(comments)
+}
+
+// danglingComments appends any comments read before the given token, usually the end of a block,
+// to the given comments as trailing comments. It's used to attach comments that no node follows
+// to the node that precedes them instead.
+func (p *Parser) danglingComments(comments *ast.Comments, before Token) *ast.Comments {
+	var n int
+	for n < len(p.comments) && p.comments[n].End <= before.Start {
+		n++
+	}
+
+	if n == 0 {
+		return comments
+	}
+
+	var cs []ast.Comment
+	comments.ForEach(func(c ast.Comment, i int) {
+		cs = append(cs, c)
+	})
+
+	for _, t := range p.comments[:n] {
+		cs = append(cs, comment(t, ast.CommentKindTrailing))
+	}
+
+	p.comments = append(p.comments[:0], p.comments[n:]...)
+
+	return ast.CommentsFromSlice(cs)
+}
+
+// location returns a Location spanning from the start of the given token to the end of the last
+// token that was consumed.
+func (p *Parser) location(start Token) ast.Location {
+	if p.opts.SkipLocations {
+		return ast.Location{}
+	}
+
+	return ast.Location{
+		Start: start.Start,
+		End:   p.prev.End,
+	}
+}
+
+// tokenLocation returns a Location spanning the given token.
+func tokenLocation(t Token) ast.Location {
+	return ast.Location{
+		Start: t.Start,
+		End:   t.End,
+	}
+}
+
+// position returns the Position of the start of the given token, in the source being parsed.
+func (p *Parser) position(t Token) ast.Position {
+	position := tokenPosition(t)
+	position.Source = p.source
+
+	return position
+}
+
+// tokenPosition returns the Position of the start of the given token.
+func tokenPosition(t Token) ast.Position {
+	return ast.Position{
+		Line:   t.Line,
+		Column: t.Column,
+	}
+}
+
+// expected returns an Expectation for a token of the given kind, optionally with one of the given
+// literal values. The literals are copied, so that the slices of them that are passed around while
+// parsing successfully don't escape to the heap.
+func (p *Parser) expected(t TokenKind, ls ...string) Expectation {
+	var literals []string
+	if len(ls) > 0 {
+		literals = make([]string, len(ls))
+		copy(literals, ls)
+	}
+
+	return Expectation{
+		Kind:     t,
+		Literals: literals,
+	}
+}
+
+// unexpected returns a SyntaxError for the given token, which was not expected at this point in
+// the input. The given expectations are what would have been accepted instead.
+func (p *Parser) unexpected(token Token, wants ...Expectation) error {
+	return p.syntaxError(token, wants)
+}
+
+// syntaxError returns a SyntaxError for the given token, in the source being parsed.
+func (p *Parser) syntaxError(token Token, wants []Expectation) *SyntaxError {
+	err := newSyntaxError(token, wants)
+	err.Position = p.position(token)
+
+	return err
 }
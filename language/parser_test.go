@@ -2,7 +2,9 @@ package language_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/bucketd/go-graphqlparser/ast"
 	"github.com/bucketd/go-graphqlparser/language"
@@ -63,6 +65,22 @@ func BenchmarkTypeSystemParser(b *testing.B) {
 				runBucketdParser(b, t.query)
 			})
 
+			b.Run("bucketd-skip-locations", func(b *testing.B) {
+				runBucketdParserSkipLocations(b, t.query)
+			})
+
+			b.Run("bucketd-reset", func(b *testing.B) {
+				runBucketdParserReset(b, t.query)
+			})
+
+			b.Run("bucketd-pool", func(b *testing.B) {
+				runBucketdParseBytes(b, t.query)
+			})
+
+			b.Run("bucketd-arena", func(b *testing.B) {
+				runBucketdParserArena(b, t.query)
+			})
+
 			b.Run("vektah", func(b *testing.B) {
 				runVektahGQLSchemaParser(b, t.query)
 			})
@@ -96,6 +114,22 @@ func BenchmarkParser(b *testing.B) {
 				runBucketdParser(b, t.query)
 			})
 
+			b.Run("bucketd-skip-locations", func(b *testing.B) {
+				runBucketdParserSkipLocations(b, t.query)
+			})
+
+			b.Run("bucketd-reset", func(b *testing.B) {
+				runBucketdParserReset(b, t.query)
+			})
+
+			b.Run("bucketd-pool", func(b *testing.B) {
+				runBucketdParseBytes(b, t.query)
+			})
+
+			b.Run("bucketd-arena", func(b *testing.B) {
+				runBucketdParserArena(b, t.query)
+			})
+
 			b.Run("graphql-go", func(b *testing.B) {
 				runGraphQLGoParser(b, t.query)
 			})
@@ -122,14 +156,587 @@ func TestParser_Parse(t *testing.T) {
 		var found bool
 
 		doc.Definitions.ForEach(func(d ast.Definition, i int) {
-			assert.Equal(t, 3, d.Location.Line)
-			assert.Equal(t, 4, d.Location.Column)
+			assert.Equal(t, ast.Position{Line: 3, Column: 4}, doc.Position(d.Loc))
 
 			found = true
 		})
 
 		assert.True(t, found)
 	})
+
+	t.Run("should find the end positions of nodes", func(t *testing.T) {
+		query := "{\n  foo(bar: \"😃\") {\n    baz\n  }\n}\n# Trailing."
+		opts := language.ParserOptions{ColumnUnit: language.ColumnUnitUTF16}
+
+		parsers := map[string]*language.Parser{
+			"buffered": language.NewParserWithOptions([]byte(query), opts),
+			"stream":   language.NewParserReaderWithOptions(iotest.OneByteReader(strings.NewReader(query)), opts),
+		}
+
+		for name, psr := range parsers {
+			t.Run(name, func(t *testing.T) {
+				doc, err := psr.Parse()
+				require.NoError(t, err)
+				require.Equal(t, 1, doc.Definitions.Len())
+
+				def := doc.Definitions.Data
+				sel := def.ExecutableDefinition.OperationDefinition.SelectionSet.Data
+
+				assert.Equal(t, ast.Position{Line: 5, Column: 2}, doc.EndPosition(def.Loc))
+				assert.Equal(t, ast.Position{Line: 2, Column: 3}, doc.Position(sel.Loc))
+				assert.Equal(t, ast.Position{Line: 4, Column: 4}, doc.EndPosition(sel.Loc))
+				assert.Equal(t, ast.Position{Line: 2, Column: 16}, doc.EndPosition(sel.Arguments.Data.Loc))
+			})
+		}
+	})
+
+	t.Run("should recover from syntax errors", func(t *testing.T) {
+		query := []byte(`
+			type Query {
+				foo: String
+			}
+
+			type Broken {
+				bar(: String
+			}
+
+			type Mutation {
+				baz: String
+			}
+
+			query ($a: ) { hello }
+
+			fragment Frag on Query {
+				foo
+			}
+		`)
+
+		psr := language.NewParserWithOptions(query, language.ParserOptions{
+			RecoverErrors: true,
+		})
+
+		doc, err := psr.Parse()
+		require.Error(t, err)
+
+		errs, ok := err.(language.SyntaxErrors)
+		require.True(t, ok, "expected language.SyntaxErrors")
+		require.Len(t, errs, 2)
+
+		assert.Equal(t, 7, errs[0].Position.Line)
+		assert.Equal(t, 14, errs[1].Position.Line)
+
+		var names []string
+		doc.Definitions.ForEach(func(d ast.Definition, i int) {
+			switch d.Kind {
+			case ast.DefinitionKindTypeSystem:
+				names = append(names, d.TypeSystemDefinition.TypeDefinition.Name)
+			case ast.DefinitionKindExecutable:
+				names = append(names, d.ExecutableDefinition.String())
+			}
+		})
+
+		assert.Equal(t, []string{"Query", "Mutation", "Frag"}, names)
+		assert.Equal(t, int32(2), doc.TypeDefinitions)
+		assert.Equal(t, int32(1), doc.FragmentDefinitions)
+	})
+
+	t.Run("should recover from syntax errors in unclosed blocks", func(t *testing.T) {
+		doc, err := language.NewParserWithOptions([]byte("type A { a: Int\ntype B { b: Int }"), language.ParserOptions{
+			RecoverErrors: true,
+		}).Parse()
+		require.Error(t, err)
+
+		errs, ok := err.(language.SyntaxErrors)
+		require.True(t, ok, "expected language.SyntaxErrors")
+		require.Len(t, errs, 1)
+
+		assert.Equal(t, 2, errs[0].Position.Line)
+		require.Equal(t, 1, doc.Definitions.Len())
+		assert.Equal(t, "B", doc.Definitions.Data.TypeSystemDefinition.TypeDefinition.Name)
+	})
+
+	t.Run("should recover from syntax errors in arguments", func(t *testing.T) {
+		doc, err := language.NewParserWithOptions([]byte("{ a(x: ) }\n{ b }"), language.ParserOptions{
+			RecoverErrors: true,
+		}).Parse()
+		require.Error(t, err)
+
+		errs, ok := err.(language.SyntaxErrors)
+		require.True(t, ok, "expected language.SyntaxErrors")
+		require.Len(t, errs, 1)
+
+		assert.Equal(t, ast.Position{Line: 1, Column: 8}, errs[0].Position)
+		require.Equal(t, 1, doc.Definitions.Len())
+
+		selections := doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet
+		require.Equal(t, 1, selections.Len())
+		assert.Equal(t, "b", selections.Data.Name)
+	})
+
+	t.Run("should not mistake fields named like definition keywords for definitions", func(t *testing.T) {
+		doc, err := language.NewParserWithOptions([]byte("type A { a(: Int\n  type: String\n}\ntype B { b: Int }"), language.ParserOptions{
+			RecoverErrors: true,
+		}).Parse()
+		require.Error(t, err)
+		require.Len(t, err.(language.SyntaxErrors), 1)
+
+		require.Equal(t, 1, doc.Definitions.Len())
+		assert.Equal(t, "B", doc.Definitions.Data.TypeSystemDefinition.TypeDefinition.Name)
+	})
+
+	t.Run("should stop at the first syntax error by default", func(t *testing.T) {
+		doc, err := language.NewParser([]byte(`type Broken { bar(: String } type Query { foo: String }`)).Parse()
+		require.Error(t, err)
+
+		_, ok := err.(*language.SyntaxError)
+		assert.True(t, ok, "expected *language.SyntaxError")
+		assert.Equal(t, 0, doc.Definitions.Len())
+	})
+
+	t.Run("should return an error if a fragment has no name", func(t *testing.T) {
+		_, err := language.NewParser([]byte(`fragment { foo }`)).Parse()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Unexpected Punctuator "{", expected Name.`)
+	})
+
+	t.Run("should record the start and end of each definition", func(t *testing.T) {
+		query := []byte("query Foo { foo }\n\n  type Query {\n    \"ü\" foo: String\n  }\n")
+
+		doc, err := language.NewParser(query).Parse()
+		require.NoError(t, err)
+
+		var locations []ast.Location
+		var positions []ast.Position
+		var texts []string
+		doc.Definitions.ForEach(func(d ast.Definition, i int) {
+			locations = append(locations, d.Loc)
+			positions = append(positions, doc.Position(d.Loc))
+			texts = append(texts, string(query[d.Loc.Start:d.Loc.End]))
+		})
+
+		assert.Equal(t, []ast.Location{
+			{Start: 0, End: 17},
+			{Start: 21, End: 58},
+		}, locations)
+
+		assert.Equal(t, []ast.Position{
+			{Line: 1, Column: 1},
+			{Line: 3, Column: 3},
+		}, positions)
+
+		assert.Equal(t, []string{
+			"query Foo { foo }",
+			"type Query {\n    \"ü\" foo: String\n  }",
+		}, texts)
+	})
+
+	t.Run("should record the location of nodes within definitions", func(t *testing.T) {
+		query := []byte(`query ($a: Int = 1) { foo(bar: {baz: [$a]}) @skip(if: true) ...Frag }`)
+
+		doc, err := language.NewParser(query).Parse()
+		require.NoError(t, err)
+
+		text := func(l ast.Location) string {
+			return string(query[l.Start:l.End])
+		}
+
+		opDef := doc.Definitions.Data.ExecutableDefinition.OperationDefinition
+		varDef := opDef.VariableDefinitions.Data
+		assert.Equal(t, "$a: Int = 1", text(varDef.Loc))
+		assert.Equal(t, "1", text(varDef.DefaultValue.Loc))
+
+		field := opDef.SelectionSet.Data
+		assert.Equal(t, "foo(bar: {baz: [$a]}) @skip(if: true)", text(field.Loc))
+
+		arg := field.Arguments.Data
+		assert.Equal(t, "bar: {baz: [$a]}", text(arg.Loc))
+		assert.Equal(t, "{baz: [$a]}", text(arg.Value.Loc))
+		assert.Equal(t, "baz: [$a]", text(arg.Value.ObjectValue[0].Loc))
+		assert.Equal(t, "$a", text(arg.Value.ObjectValue[0].Value.ListValue[0].Loc))
+
+		directive := field.Directives.Data
+		assert.Equal(t, "@skip(if: true)", text(directive.Loc))
+		assert.Equal(t, ast.DirectiveLocationKindField, directive.Location)
+
+		var spread ast.Selection
+		opDef.SelectionSet.ForEach(func(s ast.Selection, i int) {
+			spread = s
+		})
+
+		assert.Equal(t, "...Frag", text(spread.Loc))
+		assert.Equal(t, ast.Position{Line: 1, Column: 61}, doc.Position(spread.Loc))
+	})
+
+	t.Run("should record the location of type system nodes", func(t *testing.T) {
+		query := []byte(`
+			type Query { "Foo." foo(bar: Int): String }
+			enum Baz { QUX }
+			extend input Quux { corge: Int }
+		`)
+
+		doc, err := language.NewParser(query).Parse()
+		require.NoError(t, err)
+
+		text := func(l ast.Location) string {
+			return string(query[l.Start:l.End])
+		}
+
+		var defs []ast.Definition
+		doc.Definitions.ForEach(func(d ast.Definition, i int) {
+			defs = append(defs, d)
+		})
+
+		require.Len(t, defs, 3)
+
+		queryDef := defs[0].TypeSystemDefinition.TypeDefinition
+		assert.Equal(t, `"Foo." foo(bar: Int): String`, text(queryDef.FieldsDefinition.Data.Loc))
+		assert.Equal(t, "bar: Int", text(queryDef.FieldsDefinition.Data.ArgumentsDefinition.Data.Loc))
+
+		enumDef := defs[1].TypeSystemDefinition.TypeDefinition
+		assert.Equal(t, "QUX", text(enumDef.EnumValuesDefinition.Data.Loc))
+
+		ext := defs[2].TypeSystemExtension.TypeExtension
+		assert.Equal(t, "extend input Quux { corge: Int }", text(ext.Loc))
+		assert.Equal(t, "corge: Int", text(ext.InputFieldsDefinition.Data.Loc))
+	})
+
+	t.Run("should attach comments to nodes if ParseComments is set", func(t *testing.T) {
+		query := []byte(`
+			# Leading.
+			type Query {
+				foo: String # Trailing.
+				# Dangling.
+			}
+		`)
+
+		psr := language.NewParserWithOptions(query, language.ParserOptions{
+			ParseComments: true,
+		})
+
+		doc, err := psr.Parse()
+		require.NoError(t, err)
+
+		type comment struct {
+			Text string
+			Kind ast.CommentKind
+			Line int
+		}
+
+		comments := func(cs *ast.Comments) []comment {
+			var out []comment
+			cs.ForEach(func(c ast.Comment, i int) {
+				out = append(out, comment{c.Text, c.Kind, doc.Position(c.Loc).Line})
+			})
+			return out
+		}
+
+		def := doc.Definitions.Data
+		assert.Equal(t, []comment{{" Leading.", ast.CommentKindLeading, 2}}, comments(def.Comments))
+
+		field := def.TypeSystemDefinition.TypeDefinition.FieldsDefinition.Data
+		assert.Equal(t, []comment{
+			{" Trailing.", ast.CommentKindTrailing, 4},
+			{" Dangling.", ast.CommentKindTrailing, 5},
+		}, comments(field.Comments))
+	})
+
+	t.Run("should discard comments by default", func(t *testing.T) {
+		doc, err := language.NewParser([]byte("# Leading.\n{ foo } # Trailing.")).Parse()
+		require.NoError(t, err)
+		assert.Nil(t, doc.Definitions.Data.Comments)
+	})
+
+	t.Run("should keep the raw value of numbers, even if out of range", func(t *testing.T) {
+		doc, err := language.NewParser([]byte(`{ foo(a: 9223372036854775808, b: 1.5e400, c: 0.1) }`)).Parse()
+		require.NoError(t, err)
+
+		var values []ast.Value
+		doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet.Data.Arguments.ForEach(func(a ast.Argument, i int) {
+			values = append(values, a.Value)
+		})
+
+		require.Len(t, values, 3)
+		a, b, c := values[0], values[1], values[2]
+
+		assert.Equal(t, ast.ValueKindInt, a.Kind)
+		assert.Equal(t, "9223372036854775808", a.RawValue)
+
+		bi, ok := a.BigInt()
+		require.True(t, ok)
+		assert.Equal(t, "9223372036854775808", bi.String())
+
+		assert.Equal(t, ast.ValueKindFloat, b.Kind)
+		assert.Equal(t, "1.5e400", b.RawValue)
+
+		assert.Equal(t, "0.1", c.RawValue)
+		assert.Equal(t, 0.1, c.FloatValue)
+	})
+
+	t.Run("should not record locations if SkipLocations is set", func(t *testing.T) {
+		psr := language.NewParserWithOptions([]byte(`{ foo(bar: 1) @baz }`), language.ParserOptions{
+			SkipLocations: true,
+		})
+
+		doc, err := psr.Parse()
+		require.NoError(t, err)
+
+		field := doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet.Data
+		assert.Equal(t, ast.Location{}, doc.Definitions.Data.Loc)
+		assert.Equal(t, ast.Location{}, field.Loc)
+		assert.Equal(t, ast.Location{}, field.Arguments.Data.Loc)
+		assert.Equal(t, ast.Location{}, field.Arguments.Data.Value.Loc)
+		assert.Equal(t, ast.Location{}, field.Directives.Data.Loc)
+		assert.Nil(t, doc.Sources)
+	})
+
+	t.Run("should measure columns in the configured unit", func(t *testing.T) {
+		input := []byte(`{ foo(bar: "😃") baz }` + "\n" + `{ "😃" }`)
+
+		_, err := language.NewParserWithOptions(input, language.ParserOptions{
+			ColumnUnit: language.ColumnUnitUTF16,
+		}).Parse()
+		require.Error(t, err)
+
+		synErr, ok := err.(*language.SyntaxError)
+		require.True(t, ok)
+		assert.Equal(t, ast.Position{Line: 2, Column: 3}, synErr.Position)
+
+		doc, err := language.NewParserWithOptions(input[:bytes.IndexByte(input, '\n')], language.ParserOptions{
+			ColumnUnit: language.ColumnUnitUTF16,
+		}).Parse()
+		require.NoError(t, err)
+
+		streamed, err := language.NewParserReaderWithOptions(bytes.NewReader(input[:bytes.IndexByte(input, '\n')]), language.ParserOptions{
+			ColumnUnit: language.ColumnUnitUTF16,
+		}).Parse()
+		require.NoError(t, err)
+
+		var columns, streamedColumns []int
+		doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet.ForEach(func(s ast.Selection, i int) {
+			columns = append(columns, doc.Position(s.Loc).Column)
+			streamedColumns = append(streamedColumns, streamed.Position(s.Loc).Column)
+		})
+
+		assert.Equal(t, []int{3, 18}, columns)
+		assert.Equal(t, []int{3, 18}, streamedColumns)
+	})
+}
+
+func TestParser_Reset(t *testing.T) {
+	t.Run("should parse each input as if the parser were new", func(t *testing.T) {
+		inputs := [][]byte{tsQuery, normalQuery, []byte(`extend type Foo { bar: Int }`), tinyQuery}
+
+		psr := language.NewParser(nil)
+
+		for _, input := range inputs {
+			expected, err := language.NewParser(input).Parse()
+			require.NoError(t, err)
+
+			psr.Reset(input)
+
+			actual, err := psr.Parse()
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("should keep options, but not errors", func(t *testing.T) {
+		psr := language.NewParserWithOptions([]byte(`{ foo(`), language.ParserOptions{
+			RecoverErrors: true,
+		})
+
+		_, err := psr.Parse()
+		require.Error(t, err)
+
+		psr.Reset([]byte(`{ foo } { bar(`))
+
+		doc, err := psr.Parse()
+		require.Error(t, err)
+
+		errs, ok := err.(language.SyntaxErrors)
+		require.True(t, ok, "expected language.SyntaxErrors")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 1, doc.Definitions.Len())
+	})
+}
+
+func TestParser_Arena(t *testing.T) {
+	arena := ast.NewArena()
+
+	for _, input := range [][]byte{tsQuery, normalQuery, []byte(`extend type Foo implements Bar { baz: [[Int!]] }`)} {
+		expected, err := language.NewParser(input).Parse()
+		require.NoError(t, err)
+
+		actual, err := language.NewParserWithOptions(input, language.ParserOptions{
+			Arena: arena,
+		}).Parse()
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, actual)
+
+		arena.Reset()
+	}
+}
+
+func TestParser_ExperimentalFragmentArguments(t *testing.T) {
+	input := []byte(`{ ...F(size: 20) } fragment F($size: Int = 10) on User { pic(size: $size) }`)
+
+	t.Run("should parse fragment arguments when enabled", func(t *testing.T) {
+		doc, err := language.NewParserWithOptions(input, language.ParserOptions{
+			ExperimentalFragmentArguments: true,
+		}).Parse()
+		require.NoError(t, err)
+		require.Equal(t, 2, doc.Definitions.Len())
+
+		spread := doc.Definitions.Data.ExecutableDefinition.OperationDefinition.SelectionSet.Data
+		assert.Equal(t, ast.SelectionKindFragmentSpread, spread.Kind)
+		require.Equal(t, 1, spread.Arguments.Len())
+		assert.Equal(t, "size", spread.Arguments.Data.Name)
+		assert.Equal(t, 20, spread.Arguments.Data.Value.IntValue)
+
+		var fragment *ast.FragmentDefinition
+		doc.Definitions.ForEach(func(def ast.Definition, i int) {
+			if i == 1 {
+				fragment = def.ExecutableDefinition.FragmentDefinition
+			}
+		})
+
+		require.NotNil(t, fragment)
+		require.Equal(t, 1, fragment.VariableDefinitions.Len())
+		assert.Equal(t, "size", fragment.VariableDefinitions.Data.Name)
+		assert.Equal(t, "Int", fragment.VariableDefinitions.Data.Type.NamedType)
+		assert.Equal(t, 10, fragment.VariableDefinitions.Data.DefaultValue.IntValue)
+		assert.Equal(t, "User", fragment.TypeCondition.NamedType.NamedType)
+	})
+
+	t.Run("should return an error when disabled", func(t *testing.T) {
+		_, err := language.NewParser(input).Parse()
+		assert.Error(t, err)
+
+		_, err = language.NewParser([]byte(`fragment F($size: Int) on User { pic }`)).Parse()
+		assert.Error(t, err)
+	})
+}
+
+func TestParseBytes(t *testing.T) {
+	for _, input := range [][]byte{tsQuery, normalQuery, tinyQuery} {
+		expected, err := language.NewParser(input).Parse()
+		require.NoError(t, err)
+
+		actual, err := language.ParseBytes(input)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, actual)
+	}
+
+	_, err := language.ParseBytes([]byte(`{ foo(`))
+	assert.Error(t, err)
+}
+
+func TestParseValue(t *testing.T) {
+	t.Run("should parse a value", func(t *testing.T) {
+		value, err := language.ParseValue([]byte(`{ foo: [1, "bar", $baz] }`))
+		require.NoError(t, err)
+
+		require.Equal(t, ast.ValueKindObject, value.Kind)
+		require.Len(t, value.ObjectValue, 1)
+		assert.Equal(t, "foo", value.ObjectValue[0].Name)
+
+		list := value.ObjectValue[0].Value
+		require.Equal(t, ast.ValueKindList, list.Kind)
+		require.Len(t, list.ListValue, 3)
+		assert.Equal(t, "1", list.ListValue[0].RawValue)
+		assert.Equal(t, "bar", list.ListValue[1].StringValue)
+		assert.Equal(t, ast.ValueKindVariable, list.ListValue[2].Kind)
+		assert.Equal(t, "baz", list.ListValue[2].StringValue)
+	})
+
+	t.Run("should reject trailing tokens", func(t *testing.T) {
+		_, err := language.ParseValue([]byte(`123 456`))
+		require.Error(t, err)
+
+		serr, ok := err.(*language.SyntaxError)
+		require.True(t, ok, "expected a *language.SyntaxError")
+		assert.Equal(t, `Unexpected IntValue "456", expected EOF.`, serr.Message)
+	})
+
+	t.Run("should reject empty input", func(t *testing.T) {
+		_, err := language.ParseValue([]byte(`  `))
+		assert.Error(t, err)
+	})
+
+	t.Run("should enforce limits", func(t *testing.T) {
+		psr := language.NewParserWithOptions([]byte(`[[[1]]]`), language.ParserOptions{MaxDepth: 2})
+
+		_, err := psr.ParseValue()
+		require.Error(t, err)
+
+		_, ok := err.(*language.LimitError)
+		assert.True(t, ok, "expected a *language.LimitError")
+	})
+}
+
+func TestParseType(t *testing.T) {
+	t.Run("should parse a type", func(t *testing.T) {
+		astType, err := language.ParseType([]byte(`[ID!]!`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "[ID!]!", astType.String())
+	})
+
+	t.Run("should reject trailing tokens", func(t *testing.T) {
+		_, err := language.ParseType([]byte(`ID! ID`))
+		assert.Error(t, err)
+	})
+}
+
+func TestParseSelectionSet(t *testing.T) {
+	t.Run("should parse a selection set", func(t *testing.T) {
+		selections, err := language.ParseSelectionSet([]byte(`{ foo { bar } ...Baz }`))
+		require.NoError(t, err)
+
+		require.Equal(t, 2, selections.Len())
+		assert.Equal(t, "foo", selections.Data.Name)
+		assert.Equal(t, "bar", selections.Data.SelectionSet.Data.Name)
+	})
+
+	t.Run("should require braces", func(t *testing.T) {
+		_, err := language.ParseSelectionSet([]byte(`foo`))
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject trailing tokens", func(t *testing.T) {
+		_, err := language.ParseSelectionSet([]byte(`{ foo } { bar }`))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewParserReader(t *testing.T) {
+	queries := map[string][]byte{
+		"tsQuery":     tsQuery,
+		"normalQuery": normalQuery,
+		"many":        bytes.Repeat(tsQuery, 50),
+		"ignored":     bytes.Join([][]byte{normalQuery, tsQuery}, bytes.Repeat([]byte("\n# 😃 \r\n ,\t"), 1<<16)),
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			expected, err := language.NewParser(query).Parse()
+			require.NoError(t, err)
+
+			actual, err := language.NewParserReader(bytes.NewReader(query)).Parse()
+			require.NoError(t, err)
+
+			// The input isn't kept when it's read incrementally, but positions can still be found.
+			assert.Equal(t, expected.Definitions, actual.Definitions)
+			assert.Nil(t, actual.Sources[0].Body)
+
+			expected.Definitions.ForEach(func(def ast.Definition, i int) {
+				assert.Equal(t, expected.Position(def.Loc), actual.Position(def.Loc))
+			})
+		})
+	}
 }
 
 func runBucketdParser(b *testing.B, query []byte) {
@@ -147,6 +754,74 @@ func runBucketdParser(b *testing.B, query []byte) {
 	}
 }
 
+func runBucketdParserSkipLocations(b *testing.B, query []byte) {
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		psr := language.NewParserWithOptions(query, language.ParserOptions{
+			SkipLocations: true,
+		})
+
+		doc, err := psr.Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_ = doc
+	}
+}
+
+func runBucketdParserReset(b *testing.B, query []byte) {
+	psr := language.NewParser(nil)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		psr.Reset(query)
+
+		doc, err := psr.Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_ = doc
+	}
+}
+
+func runBucketdParseBytes(b *testing.B, query []byte) {
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		doc, err := language.ParseBytes(query)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_ = doc
+	}
+}
+
+func runBucketdParserArena(b *testing.B, query []byte) {
+	arena := ast.NewArena()
+	psr := language.NewParserWithOptions(nil, language.ParserOptions{
+		Arena: arena,
+	})
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		arena.Reset()
+		psr.Reset(query)
+
+		doc, err := psr.Parse()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_ = doc
+	}
+}
+
 func runGraphQLGoParser(b *testing.B, query []byte) {
 	b.ResetTimer()
 
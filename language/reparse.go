@@ -0,0 +1,138 @@
+package language
+
+import (
+	"github.com/bucketd/go-graphqlparser/ast"
+)
+
+// Edit describes a change to the text of a document, where the bytes from Start to End of the
+// original text are replaced with Text.
+type Edit struct {
+	Start int
+	End   int
+	Text  []byte
+}
+
+// Apply returns a copy of the given input, with this edit applied to it.
+func (e Edit) Apply(input []byte) []byte {
+	out := make([]byte, 0, len(input)-(e.End-e.Start)+len(e.Text))
+	out = append(out, input[:e.Start]...)
+	out = append(out, e.Text...)
+
+	return append(out, input[e.End:]...)
+}
+
+// Reparse parses the given input, which is the result of applying the given edit to the input that
+// the given document was parsed from, with the same options. Only the definitions around the edit
+// are parsed again. The definitions before the edit are reused as they are, and the definitions
+// after it are reused with their locations shifted in place, so the given document must not be
+// used afterwards.
+//
+// If the document was parsed without locations, or with comments, or if the input can't be parsed
+// without error, the whole input is parsed again instead. So, the result is always the same as if
+// the input was parsed from scratch.
+func Reparse(doc ast.Document, input []byte, edit Edit, opts ParserOptions) (ast.Document, error) {
+	if opts.SkipLocations || opts.ParseComments || opts.MaxTokens > 0 || opts.MaxDefinitions > 0 || doc.Definitions == nil {
+		return reparseAll(doc, input, opts)
+	}
+
+	defs := make([]ast.Definition, 0, doc.Definitions.Len())
+	doc.Definitions.ForEach(func(def ast.Definition, i int) {
+		defs = append(defs, def)
+	})
+
+	// Find the first definition that may be affected by the edit. Parsing starts from the start of
+	// it, or of the edit, whichever is first.
+	first := 0
+	for first < len(defs) && defs[first].Loc.End < edit.Start {
+		first++
+	}
+
+	start := edit.Start
+	if first < len(defs) && defs[first].Loc.Start < start {
+		start = defs[first].Loc.Start
+	}
+
+	p := NewParserWithOptions(input[start:], opts)
+	p.lexer.base = start
+
+	// Parse definitions until the parser reaches the start of one that's after the edit, at which
+	// point the remaining definitions are unchanged. Only offsets are stored in locations, so they
+	// stay accurate once they've been shifted, even if the lines or columns they're at changed.
+	delta := len(edit.Text) - (edit.End - edit.Start)
+	next := first
+
+	var parsed []ast.Definition
+
+	p.scan()
+
+	for !p.peek0(TokenKindEOF) {
+		for next < len(defs) && defs[next].Loc.Start+delta < p.token.Start {
+			next++
+		}
+
+		if next < len(defs) && defs[next].Loc.Start >= edit.End && defs[next].Loc.Start+delta == p.token.Start {
+			break
+		}
+
+		def, err := p.parseDefinition(ast.Document{})
+		if err != nil || p.peek0(TokenKindIllegal) {
+			return reparseAll(doc, input, opts)
+		}
+
+		parsed = append(parsed, def)
+	}
+
+	if p.peek0(TokenKindEOF) {
+		next = len(defs)
+	}
+
+	if first+len(parsed)+len(defs)-next == 0 {
+		return reparseAll(doc, input, opts)
+	}
+
+	var definitions *ast.Definitions
+
+	for _, def := range defs[:first] {
+		definitions = definitions.Add(def)
+	}
+
+	for _, def := range parsed {
+		definitions = definitions.Add(def)
+	}
+
+	for _, def := range defs[next:] {
+		def.ShiftLocations(delta)
+		definitions = definitions.Add(def)
+	}
+
+	// The positions of locations are found from the new input from now on.
+	document := ast.Document{
+		Definitions: definitions.Reverse(),
+		Sources: []*ast.Source{{
+			Name:       sourceName(doc),
+			Body:       input,
+			ColumnUnit: opts.ColumnUnit,
+		}},
+	}
+
+	document.CountDefinitions()
+
+	return document, nil
+}
+
+// reparseAll parses the whole of the given input, as the given document was parsed.
+func reparseAll(doc ast.Document, input []byte, opts ParserOptions) (ast.Document, error) {
+	p := NewParserWithOptions(input, opts)
+	p.source = sourceName(doc)
+
+	return p.Parse()
+}
+
+// sourceName returns the name of the source that the given document was parsed from, if known.
+func sourceName(doc ast.Document) string {
+	if len(doc.Sources) == 0 {
+		return ""
+	}
+
+	return doc.Sources[0].Name
+}
@@ -0,0 +1,121 @@
+package language_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReparse(t *testing.T) {
+	input := strings.Join([]string{
+		`query Foo($a: Int = 1) { foo(a: $a) { bar } }`,
+		``,
+		`"Bar."`,
+		`type Bar implements Baz @qux(a: [1, {b: "c"}]) {`,
+		`  "Field."`,
+		`  bar(a: Int = 1): String @deprecated`,
+		`}`,
+		`fragment Frag on Bar { bar } scalar Qux`,
+		``,
+		`extend type Bar { baz: [Int!]! }`,
+		`enum Quux { A B }`,
+	}, "\n")
+
+	edit := func(old, new string) language.Edit {
+		start := strings.Index(input, old)
+		require.True(t, start >= 0, "edit not found: %q", old)
+
+		return language.Edit{Start: start, End: start + len(old), Text: []byte(new)}
+	}
+
+	tests := []struct {
+		msg  string
+		edit language.Edit
+	}{
+		{"change a field in the first definition", edit("foo(a", "fooBar(a")},
+		{"add lines in the first definition", edit("{ bar }", "{\n\n bar\n }")},
+		{"change a description", edit(`"Bar."`, `"Bar, in more detail."`)},
+		{"remove lines", edit("\n\n\"Bar.\"", "")},
+		{"change a definition on the same line as another", edit("Frag on", "Fragment on")},
+		{"change the definition after another on the same line", edit("scalar Qux", "scalar Quxx")},
+		{"add a definition between others", edit("\n\nextend", "\nscalar Corge\nextend")},
+		{"add a definition at the end", edit("A B }", "A B }\nscalar Corge")},
+		{"add a definition at the start", edit("query Foo", "scalar Corge query Foo")},
+		{"remove a definition", edit("\nenum Quux { A B }", "")},
+		{"remove a brace, merging definitions", edit("} scalar Qux", " scalar Qux")},
+		{"remove everything", language.Edit{Start: 0, End: len(input)}},
+		{"change a type with CRLF line terminators", edit("baz: [Int!]!", "baz: [Int!]!\r\n  qux: Int")},
+		{"introduce an illegal token", edit("bar } }", "bar } } \"")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			edited := test.edit.Apply([]byte(input))
+
+			expected, expectedErr := language.NewParser(edited).Parse()
+
+			doc, err := language.NewParser([]byte(input)).Parse()
+			require.NoError(t, err)
+
+			actual, actualErr := language.Reparse(doc, edited, test.edit, language.ParserOptions{})
+
+			assert.Equal(t, expectedErr, actualErr)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	t.Run("should reuse definitions that are not affected", func(t *testing.T) {
+		doc, err := language.NewParser([]byte(input)).Parse()
+		require.NoError(t, err)
+
+		definitions := func(doc ast.Document) []ast.Definition {
+			var defs []ast.Definition
+			doc.Definitions.ForEach(func(def ast.Definition, i int) {
+				defs = append(defs, def)
+			})
+			return defs
+		}
+
+		before := definitions(doc)
+
+		e := edit("Frag on", "Fragment\non")
+		actual, err := language.Reparse(doc, e.Apply([]byte(input)), e, language.ParserOptions{})
+		require.NoError(t, err)
+
+		after := definitions(actual)
+		require.Len(t, after, len(before))
+
+		assert.True(t, before[0].ExecutableDefinition == after[0].ExecutableDefinition)
+		assert.True(t, before[1].TypeSystemDefinition == after[1].TypeSystemDefinition)
+		assert.False(t, before[2].ExecutableDefinition == after[2].ExecutableDefinition)
+
+		// The definition after the edit, on the same line, has moved to another line, but only
+		// offsets are stored in locations, so it's still reused.
+		assert.True(t, before[3].TypeSystemDefinition == after[3].TypeSystemDefinition)
+		assert.True(t, before[4].TypeSystemExtension == after[4].TypeSystemExtension)
+		assert.True(t, before[5].TypeSystemDefinition == after[5].TypeSystemDefinition)
+
+		// The locations of reused definitions after the edit are moved.
+		assert.Equal(t, 11, actual.Position(after[4].Loc).Line)
+		assert.Equal(t, 11, actual.Position(after[4].TypeSystemExtension.TypeExtension.FieldsDefinition.Data.Loc).Line)
+	})
+
+	t.Run("should record the source name", func(t *testing.T) {
+		source := language.Source{Name: "foo.graphql", Body: []byte(input)}
+
+		doc, err := language.NewParserSource(source, language.ParserOptions{}).Parse()
+		require.NoError(t, err)
+
+		e := edit("foo(a", "fooBar(a")
+		actual, err := language.Reparse(doc, e.Apply([]byte(input)), e, language.ParserOptions{})
+		require.NoError(t, err)
+
+		actual.Definitions.ForEach(func(def ast.Definition, i int) {
+			assert.Equal(t, "foo.graphql", actual.Position(def.Loc).Source)
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package language
+
+import (
+	"github.com/bucketd/go-graphqlparser/ast"
+)
+
+// Source is a named GraphQL document, e.g. the contents of a file. The name is recorded on the
+// document and errors produced when it's parsed, so that the positions of errors can include it.
+type Source struct {
+	Name string
+	Body []byte
+}
+
+// NewParserSource returns a new Parser instance for the given source, configured with the given
+// options.
+func NewParserSource(source Source, opts ParserOptions) *Parser {
+	p := NewParserWithOptions(source.Body, opts)
+	p.source = source.Name
+
+	return p
+}
+
+// ParseSources parses each of the given sources, and combines them into a single document, with
+// the definitions in the same order as the sources. Any limits set in the given options apply to
+// each source separately.
+//
+// The offsets in the locations of each source carry on from the end of the source before it, with
+// a gap of one byte, so that the source that each location is in can be found. The start of each
+// source is recorded on the document's Sources.
+//
+// Parsing stops at the first source that contains a syntax error, unless error recovery is
+// enabled, in which case every source is parsed, and the syntax errors from all of them are
+// returned alongside the partial document.
+func ParseSources(sources []Source, opts ParserOptions) (ast.Document, error) {
+	var document ast.Document
+	var definitions *ast.Definitions
+	var errs SyntaxErrors
+
+	var base int
+
+	for _, source := range sources {
+		p := NewParserSource(source, opts)
+		p.lexer.base = base
+
+		base += len(source.Body) + 1
+
+		doc, err := p.Parse()
+		if err != nil {
+			serrs, ok := err.(SyntaxErrors)
+			if !ok {
+				return ast.Document{}, err
+			}
+
+			errs = append(errs, serrs...)
+		}
+
+		doc.Definitions.ForEach(func(def ast.Definition, i int) {
+			definitions = definitions.Add(def)
+		})
+
+		document.Sources = append(document.Sources, doc.Sources...)
+	}
+
+	document.Definitions = definitions.Reverse()
+	document.CountDefinitions()
+
+	if len(errs) > 0 {
+		return document, errs
+	}
+
+	return document, nil
+}
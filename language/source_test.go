@@ -0,0 +1,72 @@
+package language_test
+
+import (
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSources(t *testing.T) {
+	t.Run("should combine sources into one document", func(t *testing.T) {
+		doc, err := language.ParseSources([]language.Source{
+			{Name: "a.graphql", Body: []byte("type Query { foo: Foo }\nextend type Foo @a")},
+			{Name: "b.graphql", Body: []byte("\n\ntype Foo { bar: String }\nextend type Foo @b")},
+		}, language.ParserOptions{})
+
+		require.NoError(t, err)
+
+		var names []string
+		var positions []ast.Position
+		doc.Definitions.ForEach(func(d ast.Definition, i int) {
+			if d.Kind == ast.DefinitionKindTypeSystem {
+				names = append(names, d.TypeSystemDefinition.TypeDefinition.Name)
+				positions = append(positions, doc.Position(d.Loc))
+			}
+		})
+
+		assert.Equal(t, []string{"Query", "Foo"}, names)
+		assert.Equal(t, ast.Position{Line: 1, Column: 1, Source: "a.graphql"}, positions[0])
+		assert.Equal(t, ast.Position{Line: 3, Column: 1, Source: "b.graphql"}, positions[1])
+		require.Len(t, doc.Sources, 2)
+		assert.Equal(t, 0, doc.Sources[0].Base)
+		assert.Equal(t, 43, doc.Sources[1].Base)
+
+		assert.Equal(t, 4, doc.Definitions.Len())
+		assert.Equal(t, int32(2), doc.TypeDefinitions)
+		assert.Equal(t, int32(1), doc.TypeExtensions)
+	})
+
+	t.Run("should include the source name in syntax errors", func(t *testing.T) {
+		_, err := language.ParseSources([]language.Source{
+			{Name: "a.graphql", Body: []byte("type Query { foo: String }")},
+			{Name: "b.graphql", Body: []byte("type Foo {")},
+		}, language.ParserOptions{})
+
+		require.Error(t, err)
+
+		serr, ok := err.(*language.SyntaxError)
+		require.True(t, ok, "expected a *language.SyntaxError")
+		assert.Equal(t, "b.graphql", serr.Position.Source)
+	})
+
+	t.Run("should collect syntax errors from all sources when recovering", func(t *testing.T) {
+		doc, err := language.ParseSources([]language.Source{
+			{Name: "a.graphql", Body: []byte("type Query { foo( }")},
+			{Name: "b.graphql", Body: []byte("type Foo { bar: String }")},
+			{Name: "c.graphql", Body: []byte("type Bar {")},
+		}, language.ParserOptions{RecoverErrors: true})
+
+		require.Error(t, err)
+
+		errs, ok := err.(language.SyntaxErrors)
+		require.True(t, ok, "expected language.SyntaxErrors")
+		require.Len(t, errs, 2)
+		assert.Equal(t, "a.graphql", errs[0].Position.Source)
+		assert.Equal(t, "c.graphql", errs[1].Position.Source)
+
+		assert.Equal(t, 1, doc.Definitions.Len())
+	})
+}
@@ -39,10 +39,14 @@ var TokenKindNames = map[TokenKind]string{
 // Token represents a small, easily categorisable data structure that is fed to the parser to
 // produce the abstract syntax tree (AST).
 type Token struct {
-	Kind    TokenKind // The token type.
-	Literal string    // The literal value consumed.
-	Line    int       // The line number at the start of this item.
-	Column  int       // The starting position, in runes, of this token on this line.
+	Kind      TokenKind // The token type.
+	Literal   string    // The literal value consumed.
+	Line      int       // The line number at the start of this item.
+	Column    int       // The starting position of this token on this line, in runes by default.
+	EndLine   int       // The line number at the end of this item.
+	EndColumn int       // The position immediately after the end of this token on its last line.
+	Start     int       // The byte offset of the start of this token in the input.
+	End       int       // The byte offset immediately after the end of this token in the input.
 }
 
 // TokenKind represents a type of token. The types are predefined as constants.
@@ -0,0 +1,369 @@
+package language
+
+// All different semantic token classes.
+const (
+	TokenClassNone TokenClass = iota
+	TokenClassKeyword
+	TokenClassTypeName
+	TokenClassField
+	TokenClassArgument
+	TokenClassVariable
+	TokenClassDirective
+	TokenClassString
+	TokenClassDescription
+	TokenClassNumber
+	TokenClassComment
+	TokenClassPunctuation
+)
+
+// TokenClassNames is a map of token classes to their names as strings.
+var TokenClassNames = map[TokenClass]string{
+	TokenClassNone:        "None",
+	TokenClassKeyword:     "Keyword",
+	TokenClassTypeName:    "TypeName",
+	TokenClassField:       "Field",
+	TokenClassArgument:    "Argument",
+	TokenClassVariable:    "Variable",
+	TokenClassDirective:   "Directive",
+	TokenClassString:      "String",
+	TokenClassDescription: "Description",
+	TokenClassNumber:      "Number",
+	TokenClassComment:     "Comment",
+	TokenClassPunctuation: "Punctuation",
+}
+
+// TokenClass is a semantic classification of a token, describing what it represents in a document,
+// e.g. for syntax highlighting. Tokens that don't fit any other class, like whitespace, enum values,
+// and the names of operations and fragments, are classed as TokenClassNone.
+type TokenClass int
+
+// String returns the name of this class.
+func (c TokenClass) String() string {
+	return TokenClassNames[c]
+}
+
+// SemanticToken is a token, along with its semantic classification.
+type SemanticToken struct {
+	Token
+	Class TokenClass
+}
+
+// Tokenize returns every token in the given input, including ignored tokens such as whitespace,
+// commas, comments, and the Unicode BOM, each with a semantic classification. Together, the tokens
+// cover the whole input, up to the end of it, or the first illegal token. If an illegal token is
+// found, it's included in the returned tokens, and a SyntaxError is returned too.
+//
+// Tokens are classified by the context they appear in, without parsing the document, so invalid
+// documents are classified on a best-effort basis, rather than rejected.
+func Tokenize(input []byte) ([]SemanticToken, error) {
+	return TokenizeWithOptions(input, LexerOptions{})
+}
+
+// TokenizeWithOptions is like Tokenize, but scans the input with the given lexer options. Ignored
+// tokens are always scanned.
+func TokenizeWithOptions(input []byte, opts LexerOptions) ([]SemanticToken, error) {
+	opts.ScanIgnored = true
+
+	lexer := NewLexerWithOptions(input, opts)
+	classifier := tokenClassifier{}
+
+	var tokens []SemanticToken
+
+	for {
+		tok := lexer.Scan()
+
+		switch tok.Kind {
+		case TokenKindEOF:
+			return tokens, nil
+		case TokenKindIllegal:
+			return append(tokens, SemanticToken{Token: tok}), newSyntaxError(tok, nil)
+		}
+
+		tokens = append(tokens, SemanticToken{
+			Token: tok,
+			Class: classifier.classify(tok),
+		})
+	}
+}
+
+// tokenContext is a kind of bracketed block that a token may appear in.
+type tokenContext int
+
+// All different token contexts.
+const (
+	tokenContextSelectionSet tokenContext = iota
+	tokenContextFields
+	tokenContextEnumValues
+	tokenContextSchema
+	tokenContextArguments
+	tokenContextVariables
+	tokenContextArgumentDefinitions
+	tokenContextObjectValue
+	tokenContextListValue
+	tokenContextListType
+)
+
+// tokenClassifier classifies tokens based on the tokens that preceded them. It tracks the brackets
+// that are open, and what they contain, along with the kind of top-level definition it's in.
+type tokenClassifier struct {
+	stack     []tokenContext
+	def       string     // The keyword that started the current top-level definition.
+	prev      Token      // The last significant token.
+	prevClass TokenClass // The class of the last significant token.
+}
+
+// classify returns the class of the given token, which must be the token following the last one
+// given to it.
+func (c *tokenClassifier) classify(tok Token) TokenClass {
+	var class TokenClass
+
+	switch tok.Kind {
+	case TokenKindComment:
+		return TokenClassComment
+	case TokenKindIntValue, TokenKindFloatValue:
+		class = TokenClassNumber
+	case TokenKindStringValue:
+		class = c.classifyString()
+	case TokenKindPunctuator:
+		class = TokenClassPunctuation
+		c.enter(tok.Literal)
+	case TokenKindName:
+		class = c.classifyName(tok.Literal)
+	default:
+		return TokenClassNone
+	}
+
+	c.prev = tok
+	c.prevClass = class
+
+	return class
+}
+
+// classifyString returns the class of a string token, which is either a description, or a value.
+func (c *tokenClassifier) classifyString() TokenClass {
+	if c.inValue() {
+		return TokenClassString
+	}
+
+	ctx, ok := c.top()
+	if !ok || ctx == tokenContextFields || ctx == tokenContextArgumentDefinitions || ctx == tokenContextEnumValues {
+		return TokenClassDescription
+	}
+
+	return TokenClassString
+}
+
+// classifyName returns the class of a name token with the given literal value.
+func (c *tokenClassifier) classifyName(name string) TokenClass {
+	switch {
+	case c.prevIs(TokenKindPunctuator, "$"):
+		return TokenClassVariable
+	case c.prevIs(TokenKindPunctuator, "@"):
+		return TokenClassDirective
+	case c.inValue():
+		if name == "true" || name == "false" || name == "null" {
+			return TokenClassKeyword
+		}
+
+		return TokenClassNone
+	case c.inType():
+		return TokenClassTypeName
+	}
+
+	ctx, ok := c.top()
+	if !ok {
+		return c.classifyDefinitionName(name)
+	}
+
+	switch ctx {
+	case tokenContextSelectionSet:
+		if c.prevIs(TokenKindPunctuator, "...") {
+			if name == "on" {
+				return TokenClassKeyword
+			}
+
+			return TokenClassNone
+		}
+
+		return TokenClassField
+	case tokenContextFields:
+		return TokenClassField
+	case tokenContextSchema:
+		return TokenClassKeyword
+	case tokenContextArguments, tokenContextVariables, tokenContextArgumentDefinitions, tokenContextObjectValue:
+		return TokenClassArgument
+	}
+
+	return TokenClassNone
+}
+
+// classifyDefinitionName returns the class of a name token that appears at the top-level of a
+// document, outside of any brackets.
+func (c *tokenClassifier) classifyDefinitionName(name string) TokenClass {
+	// Directive locations, e.g. "directive @foo on FIELD | FRAGMENT_SPREAD".
+	if c.def == "directive" && (c.prevIs(TokenKindName, "on") || c.prevIs(TokenKindPunctuator, "|")) {
+		return TokenClassKeyword
+	}
+
+	// The name of an operation, or fragment, e.g. "query query { foo }".
+	if c.prevClass == TokenClassKeyword && c.prev.Literal != "extend" && isDefinitionKeyword(c.prev.Literal) {
+		return TokenClassNone
+	}
+
+	if isDefinitionKeyword(name) {
+		if name != "extend" {
+			c.def = name
+		}
+
+		return TokenClassKeyword
+	}
+
+	if name == "on" || name == "implements" || name == "repeatable" {
+		return TokenClassKeyword
+	}
+
+	return TokenClassNone
+}
+
+// enter updates the stack of open brackets for the given punctuator.
+func (c *tokenClassifier) enter(punctuator string) {
+	switch punctuator {
+	case "{":
+		c.push(c.braceContext())
+	case "(":
+		c.push(c.parenContext())
+	case "[":
+		if c.inValue() {
+			c.push(tokenContextListValue)
+		} else {
+			c.push(tokenContextListType)
+		}
+	case "}", ")", "]":
+		if len(c.stack) > 0 {
+			c.stack = c.stack[:len(c.stack)-1]
+		}
+
+		// Once a top-level definition's body is closed, another definition may follow it.
+		if len(c.stack) == 0 && punctuator == "}" {
+			c.def = ""
+		}
+	}
+}
+
+// braceContext returns the context opened by a "{" punctuator.
+func (c *tokenClassifier) braceContext() tokenContext {
+	if c.inValue() {
+		return tokenContextObjectValue
+	}
+
+	if _, ok := c.top(); ok {
+		return tokenContextSelectionSet
+	}
+
+	switch c.def {
+	case "type", "interface", "input":
+		return tokenContextFields
+	case "enum":
+		return tokenContextEnumValues
+	case "schema":
+		return tokenContextSchema
+	}
+
+	return tokenContextSelectionSet
+}
+
+// parenContext returns the context opened by a "(" punctuator.
+func (c *tokenClassifier) parenContext() tokenContext {
+	ctx, ok := c.top()
+
+	switch {
+	case !ok && c.def == "directive":
+		return tokenContextArgumentDefinitions
+	case c.prevClass == TokenClassDirective:
+		return tokenContextArguments
+	case !ok && c.def != "":
+		return tokenContextVariables
+	case ok && ctx == tokenContextFields:
+		return tokenContextArgumentDefinitions
+	}
+
+	return tokenContextArguments
+}
+
+// inValue returns true if the next token is part of a value.
+func (c *tokenClassifier) inValue() bool {
+	ctx, ok := c.top()
+	if !ok {
+		return false
+	}
+
+	switch ctx {
+	case tokenContextListValue:
+		return true
+	case tokenContextArguments, tokenContextObjectValue:
+		return c.prevIs(TokenKindPunctuator, ":")
+	case tokenContextVariables, tokenContextArgumentDefinitions, tokenContextFields:
+		return c.prevIs(TokenKindPunctuator, "=")
+	}
+
+	return false
+}
+
+// inType returns true if the next token is part of a type reference.
+func (c *tokenClassifier) inType() bool {
+	ctx, ok := c.top()
+	if ok {
+		switch ctx {
+		case tokenContextListType:
+			return true
+		case tokenContextVariables, tokenContextArgumentDefinitions, tokenContextFields, tokenContextSchema:
+			return c.prevIs(TokenKindPunctuator, ":")
+		}
+	}
+
+	switch {
+	case c.prevIs(TokenKindPunctuator, "&"):
+		return true
+	case c.prevIs(TokenKindName, "on"), c.prevIs(TokenKindName, "implements"):
+		return c.prevClass == TokenClassKeyword && c.def != "directive"
+	case c.prevIs(TokenKindPunctuator, "="), c.prevIs(TokenKindPunctuator, "|"):
+		return !ok && c.def == "union"
+	case c.prevClass == TokenClassKeyword && !ok:
+		switch c.prev.Literal {
+		case "scalar", "type", "interface", "union", "enum", "input":
+			return true
+		}
+	}
+
+	return false
+}
+
+// prevIs returns true if the last significant token has the given kind and literal value.
+func (c *tokenClassifier) prevIs(kind TokenKind, literal string) bool {
+	return c.prev.Kind == kind && c.prev.Literal == literal
+}
+
+// top returns the innermost open bracket's context, if there is one.
+func (c *tokenClassifier) top() (tokenContext, bool) {
+	if len(c.stack) == 0 {
+		return 0, false
+	}
+
+	return c.stack[len(c.stack)-1], true
+}
+
+// push records that a bracket with the given context has been opened.
+func (c *tokenClassifier) push(ctx tokenContext) {
+	c.stack = append(c.stack, ctx)
+}
+
+// isDefinitionKeyword returns true if the given name may start a top-level definition.
+func isDefinitionKeyword(name string) bool {
+	for _, keyword := range definitionKeywords {
+		if name == keyword {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package language_test
+
+import (
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser/language"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	type token struct {
+		Literal string
+		Class   language.TokenClass
+	}
+
+	var (
+		none        = language.TokenClassNone
+		keyword     = language.TokenClassKeyword
+		typeName    = language.TokenClassTypeName
+		field       = language.TokenClassField
+		argument    = language.TokenClassArgument
+		variable    = language.TokenClassVariable
+		directive   = language.TokenClassDirective
+		str         = language.TokenClassString
+		description = language.TokenClassDescription
+		number      = language.TokenClassNumber
+		comment     = language.TokenClassComment
+		punctuation = language.TokenClassPunctuation
+	)
+
+	tests := []struct {
+		msg      string
+		input    string
+		expected []token
+	}{
+		{
+			msg:   "operation",
+			input: `query Foo($a: [Int!] = [1]) @dir { foo: bar(b: $a, c: {d: "e", f: ENUM, g: null}) ...Frag ... on Bar { baz } }`,
+			expected: []token{
+				{"query", keyword}, {"Foo", none}, {"(", punctuation}, {"$", punctuation}, {"a", variable},
+				{":", punctuation}, {"[", punctuation}, {"Int", typeName}, {"!", punctuation},
+				{"]", punctuation}, {"=", punctuation}, {"[", punctuation}, {"1", number},
+				{"]", punctuation}, {")", punctuation}, {"@", punctuation}, {"dir", directive},
+				{"{", punctuation}, {"foo", field}, {":", punctuation}, {"bar", field},
+				{"(", punctuation}, {"b", argument}, {":", punctuation}, {"$", punctuation},
+				{"a", variable}, {"c", argument}, {":", punctuation}, {"{", punctuation},
+				{"d", argument}, {":", punctuation}, {"e", str}, {"f", argument}, {":", punctuation},
+				{"ENUM", none}, {"g", argument}, {":", punctuation}, {"null", keyword},
+				{"}", punctuation}, {")", punctuation}, {"...", punctuation}, {"Frag", none},
+				{"...", punctuation}, {"on", keyword}, {"Bar", typeName}, {"{", punctuation},
+				{"baz", field}, {"}", punctuation}, {"}", punctuation},
+			},
+		},
+		{
+			msg:   "fragment",
+			input: "# Comment.\nfragment Frag on Query @include(if: true) { foo }",
+			expected: []token{
+				{" Comment.", comment}, {"fragment", keyword}, {"Frag", none}, {"on", keyword},
+				{"Query", typeName}, {"@", punctuation}, {"include", directive}, {"(", punctuation},
+				{"if", argument}, {":", punctuation}, {"true", keyword}, {")", punctuation},
+				{"{", punctuation}, {"foo", field}, {"}", punctuation},
+			},
+		},
+		{
+			msg:   "object type",
+			input: `"Foo." type Foo implements Bar & Baz { "Field." foo("Arg." a: Int = 1, b: String = "b"): [String]! }`,
+			expected: []token{
+				{"Foo.", description}, {"type", keyword}, {"Foo", typeName}, {"implements", keyword},
+				{"Bar", typeName}, {"&", punctuation}, {"Baz", typeName}, {"{", punctuation},
+				{"Field.", description}, {"foo", field}, {"(", punctuation}, {"Arg.", description},
+				{"a", argument}, {":", punctuation}, {"Int", typeName}, {"=", punctuation},
+				{"1", number}, {"b", argument}, {":", punctuation}, {"String", typeName},
+				{"=", punctuation}, {"b", str}, {")", punctuation}, {":", punctuation},
+				{"[", punctuation}, {"String", typeName}, {"]", punctuation}, {"!", punctuation},
+				{"}", punctuation},
+			},
+		},
+		{
+			msg:   "other type system definitions",
+			input: "schema { query: Query }\nunion U = A | B\nextend enum E { \"Value.\" V }\nscalar S\ndirective @d(a: Int) repeatable on FIELD | QUERY\n{ foo }",
+			expected: []token{
+				{"schema", keyword}, {"{", punctuation}, {"query", keyword}, {":", punctuation},
+				{"Query", typeName}, {"}", punctuation}, {"union", keyword}, {"U", typeName},
+				{"=", punctuation}, {"A", typeName}, {"|", punctuation}, {"B", typeName},
+				{"extend", keyword}, {"enum", keyword}, {"E", typeName}, {"{", punctuation},
+				{"Value.", description}, {"V", none}, {"}", punctuation}, {"scalar", keyword},
+				{"S", typeName}, {"directive", keyword}, {"@", punctuation}, {"d", directive},
+				{"(", punctuation}, {"a", argument}, {":", punctuation}, {"Int", typeName},
+				{")", punctuation}, {"repeatable", keyword}, {"on", keyword}, {"FIELD", keyword},
+				{"|", punctuation}, {"QUERY", keyword}, {"{", punctuation}, {"foo", field},
+				{"}", punctuation},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			tokens, err := language.Tokenize([]byte(test.input))
+			require.NoError(t, err)
+
+			var actual []token
+			var end int
+
+			for _, tok := range tokens {
+				// Every byte of input should be covered by a token.
+				assert.Equal(t, end, tok.Start)
+				end = tok.End
+
+				switch tok.Kind {
+				case language.TokenKindWhiteSpace, language.TokenKindLineTerminator, language.TokenKindComma:
+					assert.Equal(t, none, tok.Class)
+				default:
+					actual = append(actual, token{tok.Literal, tok.Class})
+				}
+			}
+
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, len(test.input), end)
+		})
+	}
+
+	t.Run("should stop at an illegal token", func(t *testing.T) {
+		tokens, err := language.Tokenize([]byte(`{ foo(a: "bar`))
+		require.Error(t, err)
+
+		_, ok := err.(*language.SyntaxError)
+		assert.True(t, ok, "expected *language.SyntaxError")
+
+		require.NotEmpty(t, tokens)
+		assert.Equal(t, language.TokenKindIllegal, tokens[len(tokens)-1].Kind)
+	})
+}
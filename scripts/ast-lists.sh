@@ -2,19 +2,36 @@
 
 typeNames=(
   Argument
+  Comment
   Definition
   Directive
   EnumValueDefinition
   FieldDefinition
   InputValueDefinition
-  Location
   OperationTypeDefinition
   PathNode
+  Position
   Selection
   Type
   VariableDefinition
 )
 
+# nodeNames are the types, other than lists, that the parser allocates in an arena.
+nodeNames=(
+  DirectiveDefinition
+  ExecutableDefinition
+  FragmentDefinition
+  OperationDefinition
+  SchemaDefinition
+  SchemaExtension
+  Type
+  TypeCondition
+  TypeDefinition
+  TypeExtension
+  TypeSystemDefinition
+  TypeSystemExtension
+)
+
 types=
 for i in ${!typeNames[@]}; do
   types+=${typeNames[$i]}
@@ -24,5 +41,15 @@ for i in ${!typeNames[@]}; do
   fi
 done
 
+nodes=
+for i in ${!nodeNames[@]}; do
+  nodes+=${nodeNames[$i]}
+
+  if [ $(expr ${i} + 1) -lt ${#nodeNames[@]} ]; then
+    nodes+=,
+  fi
+done
+
 go run tools/listgen/main.go -package ast -types ${types} > ast/lists.go
-go fmt ast/lists.go
+go run tools/listgen/main.go -package ast -arena -types ${types} -nodes ${nodes} > ast/arena.go
+go fmt ast/lists.go ast/arena.go
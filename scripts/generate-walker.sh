@@ -5,4 +5,23 @@ go run tools/walkergen/cmd/walkergen/main.go \
   --package "validation" \
   > validation/walker.go
 
+go run tools/walkergen/cmd/walkergen/main.go \
+  --ast-path "./ast" \
+  --package "ast" \
+  --rewriter \
+  > ast/rewriter.go
+
+go run tools/walkergen/cmd/walkergen/main.go \
+  --ast-path "./ast" \
+  --package "ast" \
+  --clone \
+  > ast/clone.go
+
+go run tools/walkergen/cmd/walkergen/main.go \
+  --ast-path "./ast" \
+  --package "ast" \
+  --codec \
+  > ast/codec.go
+
 go fmt validation/*.go
+go fmt ast/rewriter.go ast/clone.go ast/codec.go
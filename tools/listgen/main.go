@@ -14,6 +14,8 @@ import (
 var (
 	packageName string
 	typeNames   string
+	nodeNames   string
+	arena       bool
 )
 
 var header = `
@@ -24,6 +26,8 @@ var header = `
 func main() {
 	flag.StringVar(&packageName, "package", "", "The package name to use in the generated code.")
 	flag.StringVar(&typeNames, "types", "", "Comma separated names of types to generate.")
+	flag.StringVar(&nodeNames, "nodes", "", "Comma separated names of other types to allocate in an arena.")
+	flag.BoolVar(&arena, "arena", false, "Generate an arena for the lists and nodes, instead of the lists.")
 	flag.Parse()
 
 	fmt.Fprintf(os.Stdout, strings.TrimSpace(header))
@@ -33,6 +37,22 @@ func main() {
 
 	sort.Strings(tns)
 
+	if arena {
+		var nns []string
+		if nodeNames != "" {
+			nns = strings.Split(nodeNames, ",")
+		}
+
+		sort.Strings(nns)
+
+		arenaTmpl.Execute(os.Stdout, map[string][]string{
+			"Lists": tns,
+			"Nodes": nns,
+		})
+
+		return
+	}
+
 	for _, tn := range tns {
 		typeNameLCF := lcfirst(tn)
 		if typeNameLCF == "type" {
@@ -229,6 +249,102 @@ func {{.TypeName}}sFromSlice(sl []{{.TypeName}}) *{{.TypeName}}s {
 }
 `))
 
+var arenaTmpl = template.Must(template.New("arena").Parse(`
+// arenaMaxChunkSize is the maximum number of values in each chunk of memory allocated by an Arena.
+// Chunks start small, and double in size up to this limit.
+const arenaMaxChunkSize = 1024
+
+// Arena allocates AST nodes in large chunks of memory, rather than individually, so that a whole
+// document is backed by a few large allocations, which can be released together. The zero value
+// is ready to use. Methods may be called on a nil Arena, in which case each node is allocated on
+// the heap individually instead. An Arena is not safe for concurrent use.
+type Arena struct {
+{{- range .Lists}}
+	list{{.}}s []{{.}}s
+{{- end}}
+{{- range .Nodes}}
+	node{{.}} []{{.}}
+{{- end}}
+}
+
+// NewArena returns a new, empty Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// Reset releases all of the memory allocated by this arena, apart from the most recent chunk of
+// each type, which is cleared and reused. Nodes allocated before calling Reset must not be used
+// afterwards.
+func (a *Arena) Reset() {
+{{- range .Lists}}
+	for i := range a.list{{.}}s {
+		a.list{{.}}s[i] = {{.}}s{}
+	}
+	a.list{{.}}s = a.list{{.}}s[:0]
+{{- end}}
+{{- range .Nodes}}
+	for i := range a.node{{.}} {
+		a.node{{.}}[i] = {{.}}{}
+	}
+	a.node{{.}} = a.node{{.}}[:0]
+{{- end}}
+}
+
+// arenaChunkSize returns the size of the chunk to allocate after a chunk of the given size.
+func arenaChunkSize(prev int) int {
+	if prev == 0 {
+		return 16
+	}
+
+	if prev >= arenaMaxChunkSize {
+		return arenaMaxChunkSize
+	}
+
+	return prev * 2
+}
+{{range .Lists}}
+// AddIn is like Add, but allocates the new head of this linked list in the given arena.
+func (l *{{.}}s) AddIn(a *Arena, data {{.}}) *{{.}}s {
+	if a == nil {
+		return l.Add(data)
+	}
+
+	var pos int
+
+	if l != nil {
+		pos = l.pos + 1
+	}
+
+	if len(a.list{{.}}s) == cap(a.list{{.}}s) {
+		a.list{{.}}s = make([]{{.}}s, 0, arenaChunkSize(cap(a.list{{.}}s)))
+	}
+
+	a.list{{.}}s = append(a.list{{.}}s, {{.}}s{
+		Data: data,
+		next: l,
+		pos:  pos,
+	})
+
+	return &a.list{{.}}s[len(a.list{{.}}s)-1]
+}
+{{end}}
+{{- range .Nodes}}
+// New{{.}} returns a pointer to a copy of the given {{.}}, allocated in this arena.
+func (a *Arena) New{{.}}(v {{.}}) *{{.}} {
+	if a == nil {
+		return &v
+	}
+
+	if len(a.node{{.}}) == cap(a.node{{.}}) {
+		a.node{{.}} = make([]{{.}}, 0, arenaChunkSize(cap(a.node{{.}})))
+	}
+
+	a.node{{.}} = append(a.node{{.}}, v)
+
+	return &a.node{{.}}[len(a.node{{.}})-1]
+}
+{{end}}`))
+
 func lcfirst(in string) string {
 	if len(in) == 0 {
 		return in
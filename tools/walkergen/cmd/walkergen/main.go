@@ -18,10 +18,16 @@ func main() {
 	var astPath string
 	var packageName string
 	var noImports bool
+	var rewriter bool
+	var clone bool
+	var codec bool
 
 	flag.StringVar(&astPath, "ast-path", "", "The path to the AST package on the filesystem.")
 	flag.StringVar(&packageName, "package", "", "The package name to use in the generated code.")
 	flag.BoolVar(&noImports, "no-imports", false, "Use this flag to exclude imports.")
+	flag.BoolVar(&rewriter, "rewriter", false, "Generate a rewriter in the AST package, instead of a walker.")
+	flag.BoolVar(&codec, "codec", false, "Generate binary encoding functions in the AST package, instead of a walker.")
+	flag.BoolVar(&clone, "clone", false, "Generate Clone and Equal methods in the AST package, instead of a walker.")
 	flag.Parse()
 
 	if astPath == "" {
@@ -54,6 +60,21 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if codec {
+		walker.GenerateCodec(os.Stdout, packageName, symbols)
+		return
+	}
+
+	if clone {
+		walker.GenerateClone(os.Stdout, packageName, symbols)
+		return
+	}
+
+	if rewriter {
+		walker.GenerateRewriter(os.Stdout, packageName, symbols)
+		return
+	}
+
 	// Output walker.
 	walker.Generate(os.Stdout, packageName, noImports, symbols)
 }
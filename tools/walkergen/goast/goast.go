@@ -50,6 +50,8 @@ func (c Const) SelfName() string {
 type Struct struct {
 	FieldNames []string
 	Fields     map[string]Type
+	// AllFieldNames also contains the names of ignored fields, in the order they're declared in.
+	AllFieldNames []string
 }
 
 // NewStruct returns a new Struct value with the map on it initialised.
@@ -65,6 +67,7 @@ type Type struct {
 	OnKinds   []string
 	IsArray   bool
 	IsPointer bool
+	IsIgnored bool
 }
 
 // ReadFile ...
@@ -140,6 +143,10 @@ func processConstDeclaration(symbols *SymbolTable, decl *ast.GenDecl) error {
 		return err
 	}
 
+	if annotations.Ignore {
+		return nil
+	}
+
 	var t Type
 	var ok bool
 
@@ -261,11 +268,17 @@ func processStructType(symbols *SymbolTable, name string, st *ast.StructType) er
 		for _, fieldIdent := range field.Names {
 			if t, ok := processExpr(field.Type); ok {
 				t.OnKinds = annotations.OnKinds
+				t.IsIgnored = annotations.Ignore
 
 				str := symbols.Structs[name]
-				str.FieldNames = append(str.FieldNames, fieldIdent.Name)
+				str.AllFieldNames = append(str.AllFieldNames, fieldIdent.Name)
 				str.Fields[fieldIdent.Name] = t
 
+				// Ignored fields aren't walked, but are still needed to copy or compare values.
+				if !annotations.Ignore {
+					str.FieldNames = append(str.FieldNames, fieldIdent.Name)
+				}
+
 				symbols.Structs[name] = str
 			}
 		}
@@ -0,0 +1,341 @@
+package walker
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bucketd/go-graphqlparser/tools/walkergen/goast"
+)
+
+// cloneHeader is a comment placed at the top of the generated Clone and Equal methods.
+var cloneHeader = `
+// Code generated by tools/walkergen
+// DO NOT EDIT!
+`
+
+// cloneType is the information needed to generate the Clone and Equal methods for a type.
+type cloneType struct {
+	TypeName      string
+	ShortTypeName string
+	Fields        []cloneTypeField
+
+	// Only set on linked list types.
+	IsLinkedList     bool
+	ElementTypeName  string
+	ElementShortName string
+}
+
+// cloneTypeField is the information needed to copy and compare a field of a type.
+type cloneTypeField struct {
+	Name      string
+	TypeName  string
+	IsStruct  bool
+	IsList    bool
+	IsPointer bool
+	IsSlice   bool
+}
+
+// GenerateClone generates Clone, Equal, and EqualIgnoringLocations methods for every struct type in
+// the AST, including the linked lists, other than EqualIgnoringLocations for Location itself. Unlike the walker, these cover fields that are ignored when
+// walking, like locations and comments, so that a clone shares no memory with the original value.
+func GenerateClone(w io.Writer, packageName string, st goast.SymbolTable) {
+	cts := buildCloneTypes(st)
+
+	fmt.Fprintf(os.Stdout, strings.TrimSpace(cloneHeader))
+	fmt.Fprintf(os.Stdout, "\npackage %s\n", packageName)
+
+	pointers := make(map[string]bool)
+	slices := make(map[string]bool)
+
+	for _, ct := range cts {
+		var err error
+		if ct.IsLinkedList {
+			err = cloneLinkedListTmpl.Execute(w, ct)
+		} else {
+			err = cloneFnTmpl(w, ct)
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, fld := range ct.Fields {
+			if fld.IsPointer && !fld.IsList {
+				pointers[fld.TypeName] = true
+			}
+
+			if fld.IsSlice {
+				slices[fld.TypeName] = true
+			}
+		}
+	}
+
+	for _, tn := range sortedKeys(pointers) {
+		err := clonePointerTmpl.Execute(w, tn)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, tn := range sortedKeys(slices) {
+		err := cloneSliceTmpl.Execute(w, tn)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// buildCloneTypes returns the clone types for all of the structs in the given symbol table, sorted
+// by their names.
+func buildCloneTypes(st goast.SymbolTable) []cloneType {
+	var cts []cloneType
+
+	for _, tn := range sortedStructNames(st) {
+		str := st.Structs[tn]
+
+		ct := cloneType{}
+		ct.TypeName = tn
+		ct.ShortTypeName = strings.Map(abridger, tn)
+
+		if isTypeLinkedList(tn, str) {
+			ct.IsLinkedList = true
+			ct.ShortTypeName += "s"
+			ct.ElementTypeName = str.Fields["Data"].TypeName
+			ct.ElementShortName = strings.Map(abridger, ct.ElementTypeName)
+
+			cts = append(cts, ct)
+			continue
+		}
+
+		for _, fn := range str.AllFieldNames {
+			fld := str.Fields[fn]
+			fldStr, isStruct := st.Structs[fld.TypeName]
+
+			ct.Fields = append(ct.Fields, cloneTypeField{
+				Name:      fn,
+				TypeName:  fld.TypeName,
+				IsStruct:  isStruct,
+				IsList:    isStruct && isTypeLinkedList(fld.TypeName, fldStr),
+				IsPointer: fld.IsPointer,
+				IsSlice:   fld.IsArray,
+			})
+		}
+
+		cts = append(cts, ct)
+	}
+
+	return cts
+}
+
+// cloneLinkedListTmpl is the template for generating the Clone and Equal methods of a linked list.
+var cloneLinkedListTmpl = template.Must(template.New("cloneLinkedListTmpl").Parse(`
+// Clone returns a deep copy of this linked list of {{.ElementTypeName}}.
+func ({{.ShortTypeName}} *{{.TypeName}}) Clone() *{{.TypeName}} {
+	var clone *{{.TypeName}}
+
+	{{.ShortTypeName}}.ForEach(func({{.ElementShortName}} {{.ElementTypeName}}, i int) {
+		clone = clone.Add({{.ElementShortName}}.Clone())
+	})
+
+	return clone.Reverse()
+}
+
+// Equal returns true if this linked list of {{.ElementTypeName}} is structurally equal to the given
+// one.
+func ({{.ShortTypeName}} *{{.TypeName}}) Equal(other *{{.TypeName}}) bool {
+	return equal{{.TypeName}}({{.ShortTypeName}}, other, false)
+}
+
+// EqualIgnoringLocations returns true if this linked list of {{.ElementTypeName}} is structurally
+// equal to the given one, without comparing the locations of any nodes.
+func ({{.ShortTypeName}} *{{.TypeName}}) EqualIgnoringLocations(other *{{.TypeName}}) bool {
+	return equal{{.TypeName}}({{.ShortTypeName}}, other, true)
+}
+
+// equal{{.TypeName}} ...
+func equal{{.TypeName}}(x, y *{{.TypeName}}, ignoreLocations bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for x != nil {
+		if !equal{{.ElementTypeName}}(&x.Data, &y.Data, ignoreLocations) {
+			return false
+		}
+
+		x, y = x.next, y.next
+	}
+
+	return true
+}
+`))
+
+// cloneHeadTmpl is the template for the start of the Clone method of a struct.
+var cloneHeadTmpl = template.Must(template.New("cloneHeadTmpl").Parse(`
+// Clone returns a deep copy of this {{.TypeName}}.
+func ({{.ShortTypeName}} {{.TypeName}}) Clone() {{.TypeName}} {
+`))
+
+// cloneFootTmpl is the template for the end of the Clone method of a struct, and the Equal methods.
+var cloneFootTmpl = template.Must(template.New("cloneFootTmpl").Parse(`
+// Equal returns true if this {{.TypeName}} is structurally equal to the given one.
+func ({{.ShortTypeName}} {{.TypeName}}) Equal(other {{.TypeName}}) bool {
+	return equal{{.TypeName}}(&{{.ShortTypeName}}, &other, false)
+}
+
+{{if ne .TypeName "Location"}}
+// EqualIgnoringLocations returns true if this {{.TypeName}} is structurally equal to the given one,
+// without comparing the locations of any nodes.
+func ({{.ShortTypeName}} {{.TypeName}}) EqualIgnoringLocations(other {{.TypeName}}) bool {
+	return equal{{.TypeName}}(&{{.ShortTypeName}}, &other, true)
+}
+{{end}}
+// equal{{.TypeName}} ...
+func equal{{.TypeName}}(x, y *{{.TypeName}}, ignoreLocations bool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+{{- if eq .TypeName "Location"}}
+
+	// Locations are equal to any other location when they're being ignored.
+	if ignoreLocations {
+		return true
+	}
+{{- end}}
+`))
+
+// clonePointerTmpl is the template for the function that copies a pointer to a struct.
+var clonePointerTmpl = template.Must(template.New("clonePointerTmpl").Parse(`
+// clone{{.}}Pointer ...
+func clone{{.}}Pointer(x *{{.}}) *{{.}} {
+	if x == nil {
+		return nil
+	}
+
+	clone := x.Clone()
+	return &clone
+}
+`))
+
+// cloneSliceTmpl is the template for the functions that copy and compare slices of a struct.
+var cloneSliceTmpl = template.Must(template.New("cloneSliceTmpl").Parse(`
+// clone{{.}}Slice ...
+func clone{{.}}Slice(xs []{{.}}) []{{.}} {
+	if xs == nil {
+		return nil
+	}
+
+	clone := make([]{{.}}, len(xs))
+	for i, x := range xs {
+		clone[i] = x.Clone()
+	}
+
+	return clone
+}
+
+// equal{{.}}Slice ...
+func equal{{.}}Slice(xs, ys []{{.}}, ignoreLocations bool) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+
+	for i := range xs {
+		if !equal{{.}}(&xs[i], &ys[i], ignoreLocations) {
+			return false
+		}
+	}
+
+	return true
+}
+`))
+
+// cloneFnTmpl writes the Clone and Equal methods for a struct that isn't a linked list.
+func cloneFnTmpl(w io.Writer, ct cloneType) error {
+	err := cloneHeadTmpl.Execute(w, ct)
+	if err != nil {
+		return err
+	}
+
+	stn := ct.ShortTypeName
+
+	fmt.Fprintf(w, "\tclone := %s\n", stn)
+
+	for _, fld := range ct.Fields {
+		switch {
+		case !fld.IsStruct:
+			continue
+		case fld.IsSlice:
+			fmt.Fprintf(w, "\tclone.%s = clone%sSlice(%s.%s)\n", fld.Name, fld.TypeName, stn, fld.Name)
+		case fld.IsList:
+			fmt.Fprintf(w, "\tclone.%s = %s.%s.Clone()\n", fld.Name, stn, fld.Name)
+		case fld.IsPointer:
+			fmt.Fprintf(w, "\tclone.%s = clone%sPointer(%s.%s)\n", fld.Name, fld.TypeName, stn, fld.Name)
+		default:
+			fmt.Fprintf(w, "\tclone.%s = %s.%s.Clone()\n", fld.Name, stn, fld.Name)
+		}
+	}
+
+	fmt.Fprintf(w, "\n\treturn clone\n}\n")
+
+	err = cloneFootTmpl.Execute(w, ct)
+	if err != nil {
+		return err
+	}
+
+	if len(ct.Fields) == 0 {
+		fmt.Fprintf(w, "\n\treturn true\n}\n")
+		return nil
+	}
+
+	fmt.Fprintf(w, "\n\treturn ")
+
+	for i, fld := range ct.Fields {
+		if i > 0 {
+			fmt.Fprintf(w, " &&\n\t\t")
+		}
+
+		switch {
+		case !fld.IsStruct:
+			fmt.Fprintf(w, "x.%s == y.%s", fld.Name, fld.Name)
+		case fld.IsSlice:
+			fmt.Fprintf(w, "equal%sSlice(x.%s, y.%s, ignoreLocations)", fld.TypeName, fld.Name, fld.Name)
+		case fld.IsList, fld.IsPointer:
+			fmt.Fprintf(w, "equal%s(x.%s, y.%s, ignoreLocations)", fld.TypeName, fld.Name, fld.Name)
+		default:
+			fmt.Fprintf(w, "equal%s(&x.%s, &y.%s, ignoreLocations)", fld.TypeName, fld.Name, fld.Name)
+		}
+	}
+
+	fmt.Fprintf(w, "\n}\n")
+
+	return nil
+}
+
+// sortedStructNames returns the names of all structs in the given symbol table, in order.
+func sortedStructNames(st goast.SymbolTable) []string {
+	var tns []string
+	for tn := range st.Structs {
+		tns = append(tns, tn)
+	}
+
+	sort.Strings(tns)
+
+	return tns
+}
+
+// sortedKeys returns the keys of the given set, in order.
+func sortedKeys(set map[string]bool) []string {
+	var keys []string
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,205 @@
+package walker
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/bucketd/go-graphqlparser/tools/walkergen/goast"
+)
+
+// GenerateCodec generates the functions used by the binary encoding of documents to encode and
+// decode every struct type in the AST, including the linked lists. Like the Clone methods, these
+// cover every field, including those that are ignored when walking.
+func GenerateCodec(w io.Writer, packageName string, st goast.SymbolTable) {
+	cts := buildCloneTypes(st)
+
+	fmt.Fprintf(os.Stdout, strings.TrimSpace(cloneHeader))
+	fmt.Fprintf(os.Stdout, "\npackage %s\n", packageName)
+
+	pointers := make(map[string]bool)
+	slices := make(map[string]bool)
+
+	for _, ct := range cts {
+		var err error
+		if ct.IsLinkedList {
+			err = codecLinkedListTmpl.Execute(w, ct)
+		} else {
+			err = codecFnTmpl(w, ct)
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, fld := range ct.Fields {
+			if fld.IsPointer && !fld.IsList {
+				pointers[fld.TypeName] = true
+			}
+
+			if fld.IsSlice {
+				slices[fld.TypeName] = true
+			}
+		}
+	}
+
+	for _, tn := range sortedKeys(pointers) {
+		err := codecPointerTmpl.Execute(w, tn)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, tn := range sortedKeys(slices) {
+		err := codecSliceTmpl.Execute(w, tn)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// codecLinkedListTmpl is the template for generating the functions that encode and decode a linked
+// list. Decoded lists are allocated all at once, in a single slice.
+var codecLinkedListTmpl = template.Must(template.New("codecLinkedListTmpl").Parse(`
+// encode{{.TypeName}} ...
+func (e *encoder) encode{{.TypeName}}({{.ShortTypeName}} *{{.TypeName}}) {
+	e.uint(uint64({{.ShortTypeName}}.Len()))
+
+	for ; {{.ShortTypeName}} != nil; {{.ShortTypeName}} = {{.ShortTypeName}}.next {
+		e.encode{{.ElementTypeName}}(&{{.ShortTypeName}}.Data)
+	}
+}
+
+// decode{{.TypeName}} ...
+func (d *decoder) decode{{.TypeName}}() *{{.TypeName}} {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	{{.ShortTypeName}} := make([]{{.TypeName}}, n)
+	for i := range {{.ShortTypeName}} {
+		d.decode{{.ElementTypeName}}(&{{.ShortTypeName}}[i].Data)
+		{{.ShortTypeName}}[i].pos = n - 1 - i
+
+		if i < n-1 {
+			{{.ShortTypeName}}[i].next = &{{.ShortTypeName}}[i+1]
+		}
+	}
+
+	return &{{.ShortTypeName}}[0]
+}
+`))
+
+// codecPointerTmpl is the template for the functions that encode and decode a pointer to a struct.
+var codecPointerTmpl = template.Must(template.New("codecPointerTmpl").Parse(`
+// encode{{.}}Pointer ...
+func (e *encoder) encode{{.}}Pointer(x *{{.}}) {
+	e.bool(x != nil)
+
+	if x != nil {
+		e.encode{{.}}(x)
+	}
+}
+
+// decode{{.}}Pointer ...
+func (d *decoder) decode{{.}}Pointer() *{{.}} {
+	if !d.bool() {
+		return nil
+	}
+
+	x := &{{.}}{}
+	d.decode{{.}}(x)
+
+	return x
+}
+`))
+
+// codecSliceTmpl is the template for the functions that encode and decode a slice of a struct.
+var codecSliceTmpl = template.Must(template.New("codecSliceTmpl").Parse(`
+// encode{{.}}Slice ...
+func (e *encoder) encode{{.}}Slice(xs []{{.}}) {
+	e.uint(uint64(len(xs)))
+
+	for i := range xs {
+		e.encode{{.}}(&xs[i])
+	}
+}
+
+// decode{{.}}Slice ...
+func (d *decoder) decode{{.}}Slice() []{{.}} {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+
+	xs := make([]{{.}}, n)
+	for i := range xs {
+		d.decode{{.}}(&xs[i])
+	}
+
+	return xs
+}
+`))
+
+// codecFnTmpl writes the functions that encode and decode a struct that isn't a linked list. Named
+// types that aren't structs, other than strings, booleans, and floats, are kinds, which are encoded
+// as integers.
+func codecFnTmpl(w io.Writer, ct cloneType) error {
+	fmt.Fprintf(w, "\n// encode%s ...\n", ct.TypeName)
+	fmt.Fprintf(w, "func (e *encoder) encode%s(x *%s) {\n", ct.TypeName, ct.TypeName)
+
+	for _, fld := range ct.Fields {
+		switch {
+		case fld.IsSlice:
+			fmt.Fprintf(w, "\te.encode%sSlice(x.%s)\n", fld.TypeName, fld.Name)
+		case fld.IsList:
+			fmt.Fprintf(w, "\te.encode%s(x.%s)\n", fld.TypeName, fld.Name)
+		case fld.IsPointer:
+			fmt.Fprintf(w, "\te.encode%sPointer(x.%s)\n", fld.TypeName, fld.Name)
+		case fld.IsStruct:
+			fmt.Fprintf(w, "\te.encode%s(&x.%s)\n", fld.TypeName, fld.Name)
+		case fld.TypeName == "string":
+			fmt.Fprintf(w, "\te.string(x.%s)\n", fld.Name)
+		case fld.TypeName == "bool":
+			fmt.Fprintf(w, "\te.bool(x.%s)\n", fld.Name)
+		case fld.TypeName == "float64":
+			fmt.Fprintf(w, "\te.float(x.%s)\n", fld.Name)
+		default:
+			fmt.Fprintf(w, "\te.int(int64(x.%s))\n", fld.Name)
+		}
+	}
+
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// decode%s ...\n", ct.TypeName)
+	fmt.Fprintf(w, "func (d *decoder) decode%s(x *%s) {\n", ct.TypeName, ct.TypeName)
+
+	for _, fld := range ct.Fields {
+		switch {
+		case fld.IsSlice:
+			fmt.Fprintf(w, "\tx.%s = d.decode%sSlice()\n", fld.Name, fld.TypeName)
+		case fld.IsList:
+			fmt.Fprintf(w, "\tx.%s = d.decode%s()\n", fld.Name, fld.TypeName)
+		case fld.IsPointer:
+			fmt.Fprintf(w, "\tx.%s = d.decode%sPointer()\n", fld.Name, fld.TypeName)
+		case fld.IsStruct:
+			fmt.Fprintf(w, "\td.decode%s(&x.%s)\n", fld.TypeName, fld.Name)
+		case fld.TypeName == "string":
+			fmt.Fprintf(w, "\tx.%s = d.string()\n", fld.Name)
+		case fld.TypeName == "bool":
+			fmt.Fprintf(w, "\tx.%s = d.bool()\n", fld.Name)
+		case fld.TypeName == "float64":
+			fmt.Fprintf(w, "\tx.%s = d.float()\n", fld.Name)
+		default:
+			fmt.Fprintf(w, "\tx.%s = %s(d.int())\n", fld.Name, fld.TypeName)
+		}
+	}
+
+	fmt.Fprintf(w, "}\n")
+
+	return nil
+}
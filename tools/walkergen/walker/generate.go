@@ -14,27 +14,7 @@ import (
 
 // Generate ...
 func Generate(w io.Writer, packageName string, noImports bool, st goast.SymbolTable) {
-	// First pass, get walker types for types that are actually defined in the symbol table. The
-	// actual list of walker types will grow once we add the "kind" types later.
-	wts := buildBaseTypes(st)
-
-	// Second pass, now we have all base types, with as much information populated as possible, we
-	// need to attach those types to fields, and kinds.
-	wts = hydrateAllTypes(wts)
-
-	// Third pass, this one adds in the types that aren't actually in the AST, as in, if we have a
-	// "self" kind type, we add all of those types too, so that we do actually generate walker
-	// functions for them.
-	wts = injectSelfKindTypes(wts)
-
-	// Fourth pass, this one removes fields that are on a type, that shouldn't be used on that type.
-	// This will happen with different "kind" types.
-	wts = removeUnusableFields(wts)
-
-	// Then sort them all into order so that we end up with a consistent result.
-	sort.Slice(wts, func(i, j int) bool {
-		return wts[i].FuncName < wts[j].FuncName
-	})
+	wts := buildWalkerTypes(st)
 
 	// Header and package name
 	fmt.Fprintf(os.Stdout, strings.TrimSpace(header))
@@ -62,6 +42,34 @@ func Generate(w io.Writer, packageName string, noImports bool, st goast.SymbolTa
 	}
 }
 
+// buildWalkerTypes returns the walker types for all of the types in the given symbol table, sorted
+// by the names of their walk functions.
+func buildWalkerTypes(st goast.SymbolTable) []walkerType {
+	// First pass, get walker types for types that are actually defined in the symbol table. The
+	// actual list of walker types will grow once we add the "kind" types later.
+	wts := buildBaseTypes(st)
+
+	// Second pass, now we have all base types, with as much information populated as possible, we
+	// need to attach those types to fields, and kinds.
+	wts = hydrateAllTypes(wts)
+
+	// Third pass, this one adds in the types that aren't actually in the AST, as in, if we have a
+	// "self" kind type, we add all of those types too, so that we do actually generate walker
+	// functions for them.
+	wts = injectSelfKindTypes(wts)
+
+	// Fourth pass, this one removes fields that are on a type, that shouldn't be used on that type.
+	// This will happen with different "kind" types.
+	wts = removeUnusableFields(wts)
+
+	// Then sort them all into order so that we end up with a consistent result.
+	sort.Slice(wts, func(i, j int) bool {
+		return wts[i].FuncName < wts[j].FuncName
+	})
+
+	return wts
+}
+
 // buildBaseTypes ...
 func buildBaseTypes(st goast.SymbolTable) []walkerType {
 	var wts []walkerType
@@ -342,7 +350,7 @@ func isTypeAlwaysPointer(st goast.SymbolTable, tn string) bool {
 
 	for _, str := range st.Structs {
 		for _, fld := range str.Fields {
-			if fld.TypeName != tn {
+			if fld.IsIgnored || fld.TypeName != tn {
 				continue
 			}
 
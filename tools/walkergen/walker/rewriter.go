@@ -0,0 +1,362 @@
+package walker
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/bucketd/go-graphqlparser/tools/walkergen/goast"
+)
+
+// rewriterHeader is a comment placed at the top of the generated rewriter.
+var rewriterHeader = `
+// Code generated by tools/walkergen
+// DO NOT EDIT!
+`
+
+// GenerateRewriter generates a rewriter, which is like the walker, except that it's generated in the
+// same package as the AST, and its handlers receive pointers to the nodes they're called for, so
+// that they may modify them, or delete them from, or insert nodes into the lists that they're in.
+func GenerateRewriter(w io.Writer, packageName string, st goast.SymbolTable) {
+	wts := buildWalkerTypes(st)
+
+	fmt.Fprintf(os.Stdout, strings.TrimSpace(rewriterHeader))
+	fmt.Fprintf(os.Stdout, "\npackage %s\n", packageName)
+
+	err := rewriterTypeTmpl.Execute(w, wts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, wt := range wts {
+		err := rewriteHandlersTmpl.Execute(w, wt)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = rewriterFnTmpl(w, wt)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, wt := range sliceElementTypes(wts) {
+		err := rewriteFnSliceTmpl.Execute(w, wt)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// rewriterTypeTmpl is the template used to generate the type declaration for the Rewriter type, and
+// the Cursor type that's given to its handlers.
+var rewriterTypeTmpl = template.Must(template.New("rewriterTypeTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+import "fmt"
+
+// Rewriter holds handlers for entering and leaving AST nodes, which may modify those nodes. Unlike
+// the handlers of a walker, which receive copies of nodes, rewrite handlers receive pointers to the
+// nodes themselves, so any changes they make are made to the AST in place.
+//
+// Nodes in lists, e.g. Selections, or Arguments, may also be deleted, or have nodes inserted before
+// or after them, using the Cursor given to their handlers. The zero value is ready to use.
+type Rewriter struct { {{- range .}}
+	{{untitle .FuncName}}Enter []{{.FuncName}}RewriteHandler
+	{{untitle .FuncName}}Leave []{{.FuncName}}RewriteHandler{{end}}
+}
+
+// Rewrite traverses an entire AST document, calling any handlers for each node, and returns the
+// rewritten document. The given document is modified in place, and must not be used afterwards.
+// The counts of each kind of definition on the document aren't updated, see CountDefinitions.
+func (r *Rewriter) Rewrite(doc Document) Document {
+	r.rewriteDocument(nil, &doc)
+	return doc
+}
+
+// Cursor describes the position of a node that's being rewritten, allowing a handler to delete the
+// node, or insert nodes around it, if it's in a list. Nodes that aren't in a list are given a nil
+// Cursor. Nodes are only removed from, and inserted into, their list once the node has been left.
+type Cursor struct {
+	elem    string // The name of the type of the nodes in the list, e.g. "Selection".
+	before  []interface{}
+	after   []interface{}
+	deleted bool
+}
+
+// InList returns true if the node that this cursor is for is in a list, and so may be deleted, or
+// have nodes inserted around it.
+func (c *Cursor) InList() bool {
+	return c != nil
+}
+
+// Delete removes the current node from its list. No more handlers are called for the node, or any
+// of the nodes within it.
+func (c *Cursor) Delete() {
+	c.mustBeInList("Delete")
+	c.deleted = true
+}
+
+// InsertBefore inserts the given node into the current node's list, before the current node. The
+// given node must be of the same type as the nodes in the list, e.g. Selection, not *Selection,
+// or it panics. The inserted node isn't rewritten itself.
+func (c *Cursor) InsertBefore(node interface{}) {
+	c.mustBeInList("InsertBefore")
+	c.mustBeElem("InsertBefore", node)
+	c.before = append(c.before, node)
+}
+
+// InsertAfter inserts the given node into the current node's list, after the current node. The
+// given node must be of the same type as the nodes in the list, e.g. Selection, not *Selection,
+// or it panics. The inserted node isn't rewritten itself.
+func (c *Cursor) InsertAfter(node interface{}) {
+	c.mustBeInList("InsertAfter")
+	c.mustBeElem("InsertAfter", node)
+	c.after = append(c.after, node)
+}
+
+// changed returns true if the list containing the current node needs to be changed.
+func (c *Cursor) changed() bool {
+	return c.deleted || len(c.before) > 0 || len(c.after) > 0
+}
+
+// isDeleted returns true if the current node has been deleted.
+func (c *Cursor) isDeleted() bool {
+	return c != nil && c.deleted
+}
+
+// mustBeInList panics if the current node isn't in a list.
+func (c *Cursor) mustBeInList(method string) {
+	if c == nil {
+		panic("ast: Cursor." + method + " called for a node that isn't in a list")
+	}
+}
+
+// mustBeElem panics if the given node isn't of the same type as the nodes in the current list, as
+// it couldn't be inserted into it.
+func (c *Cursor) mustBeElem(method string, node interface{}) {
+	if typ := fmt.Sprintf("%T", node); typ != "ast."+c.elem {
+		panic("ast: Cursor." + method + " called with a " + typ + ", in a list of ast." + c.elem)
+	}
+}
+`))
+
+// rewriteHandlersTmpl is the template used to generate the handler type for a type, and the
+// functions for adding handlers to the rewriter.
+var rewriteHandlersTmpl = template.Must(template.New("rewriteHandlersTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+// {{.FuncName}}RewriteHandler function can handle enter/leave events for {{.FuncName}}.
+type {{.FuncName}}RewriteHandler func(*Cursor, *{{.TypeName}})
+
+// Add{{.FuncName}}EnterHandler adds a handler to be called when entering {{.FuncName}} nodes.
+func (r *Rewriter) Add{{.FuncName}}EnterHandler(h {{.FuncName}}RewriteHandler) {
+	r.{{untitle .FuncName}}Enter = append(r.{{untitle .FuncName}}Enter, h)
+}
+
+// Add{{.FuncName}}LeaveHandler adds a handler to be called when leaving {{.FuncName}} nodes.
+func (r *Rewriter) Add{{.FuncName}}LeaveHandler(h {{.FuncName}}RewriteHandler) {
+	r.{{untitle .FuncName}}Leave = append(r.{{untitle .FuncName}}Leave, h)
+}
+`))
+
+// rewriteFnHeadTmpl is the template for generating the head of a rewrite function.
+var rewriteFnHeadTmpl = template.Must(template.New("rewriteFnHeadTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+// rewrite{{.FuncName}} is a function that rewrites {{.FuncName}} type's AST node.
+func (r *Rewriter) rewrite{{.FuncName}}(cursor *Cursor, {{.ShortTypeName}} *{{.TypeName}}) {
+	for _, handler := range r.{{untitle .FuncName}}Enter {
+		if handler(cursor, {{.ShortTypeName}}); cursor.isDeleted() {
+			return
+		}
+	}
+`))
+
+// rewriteFnFootTmpl is the template for generating the foot of a rewrite function.
+var rewriteFnFootTmpl = template.Must(template.New("rewriteFnFootTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+	for _, handler := range r.{{untitle .FuncName}}Leave {
+		if handler(cursor, {{.ShortTypeName}}); cursor.isDeleted() {
+			return
+		}
+	}
+}
+`))
+
+var rewriteFnKindsTmpl = template.Must(template.New("rewriteFnKindsTmpl").Parse(`
+	switch {{.ShortTypeName}}.Kind {
+	{{range .Kinds -}}
+	case {{.ConstName}}:
+		r.rewrite{{.Type.FuncName}}(cursor, {{if .IsSelf}}{{$.ShortTypeName}}{{else}}{{if not .Field.IsPointerType}}&{{end}}{{$.ShortTypeName}}.{{.Field.Name}}{{end}})
+	{{end -}}
+	}
+
+	if cursor.isDeleted() {
+		return
+	}
+`))
+
+// rewriteFnLinkedListTmpl is the template for generating the rewrite function for a linked list
+// type. Lists are only copied if a node in them is deleted, or has nodes inserted around it.
+var rewriteFnLinkedListTmpl = template.Must(template.New("rewriteFnLinkedListTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+// rewrite{{.FuncName}} is a function that rewrites {{.FuncName}} type's AST node, returning the
+// rewritten list.
+func (r *Rewriter) rewrite{{.FuncName}}({{.ShortTypeName}} *{{.TypeName}}) *{{.TypeName}} {
+	for _, handler := range r.{{untitle .FuncName}}Enter {
+		handler(nil, {{.ShortTypeName}})
+	}
+
+	var rewritten *{{.TypeName}}
+	var changed bool
+
+	for current := {{.ShortTypeName}}; current != nil; current = current.next {
+		cursor := Cursor{elem: "{{.LinkedListType.TypeName}}"}
+		r.rewrite{{.LinkedListType.FuncName}}(&cursor, &current.Data)
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+
+			for unchanged := {{.ShortTypeName}}; unchanged != current; unchanged = unchanged.next {
+				rewritten = rewritten.Add(unchanged.Data)
+			}
+		}
+
+		for _, node := range cursor.before {
+			rewritten = rewritten.Add(node.({{.LinkedListType.TypeName}}))
+		}
+
+		if !cursor.deleted {
+			rewritten = rewritten.Add(current.Data)
+		}
+
+		for _, node := range cursor.after {
+			rewritten = rewritten.Add(node.({{.LinkedListType.TypeName}}))
+		}
+	}
+
+	if changed {
+		{{.ShortTypeName}} = rewritten.Reverse()
+	}
+
+	for _, handler := range r.{{untitle .FuncName}}Leave {
+		handler(nil, {{.ShortTypeName}})
+	}
+
+	return {{.ShortTypeName}}
+}
+`))
+
+// rewriteFnSliceTmpl is the template for generating the function that rewrites a slice of a type.
+// Slices are only copied if a node in them is deleted, or has nodes inserted around it.
+var rewriteFnSliceTmpl = template.Must(template.New("rewriteFnSliceTmpl").Funcs(sprig.TxtFuncMap()).Parse(`
+// rewrite{{.TypeName}}Slice is a function that rewrites each {{.TypeName}} in a slice, returning
+// the rewritten slice.
+func (r *Rewriter) rewrite{{.TypeName}}Slice({{.ShortTypeName}}s []{{.TypeName}}) []{{.TypeName}} {
+	var rewritten []{{.TypeName}}
+	var changed bool
+
+	for i := range {{.ShortTypeName}}s {
+		cursor := Cursor{elem: "{{.TypeName}}"}
+		r.rewrite{{.FuncName}}(&cursor, &{{.ShortTypeName}}s[i])
+
+		if !changed && !cursor.changed() {
+			continue
+		}
+
+		if !changed {
+			changed = true
+			rewritten = append(make([]{{.TypeName}}, 0, len({{.ShortTypeName}}s)), {{.ShortTypeName}}s[:i]...)
+		}
+
+		for _, node := range cursor.before {
+			rewritten = append(rewritten, node.({{.TypeName}}))
+		}
+
+		if !cursor.deleted {
+			rewritten = append(rewritten, {{.ShortTypeName}}s[i])
+		}
+
+		for _, node := range cursor.after {
+			rewritten = append(rewritten, node.({{.TypeName}}))
+		}
+	}
+
+	if changed {
+		return rewritten
+	}
+
+	return {{.ShortTypeName}}s
+}
+`))
+
+// rewriterFnTmpl ...
+func rewriterFnTmpl(w io.Writer, wt walkerType) error {
+	if wt.IsLinkedList {
+		return rewriteFnLinkedListTmpl.Execute(w, wt)
+	}
+
+	err := rewriteFnHeadTmpl.Execute(w, wt)
+	if err != nil {
+		return err
+	}
+
+	if len(wt.Kinds) > 0 {
+		err := rewriteFnKindsTmpl.Execute(w, wt)
+		if err != nil {
+			return err
+		}
+	} else if len(wt.Fields) > 0 {
+		fmt.Fprintln(w, "")
+		for i, fld := range wt.Fields {
+			switch {
+			case fld.IsSliceType:
+				fmt.Fprintf(w, "\t%s.%s = r.rewrite%sSlice(%s.%s)\n", wt.ShortTypeName, fld.Name, fld.Type.TypeName, wt.ShortTypeName, fld.Name)
+			case fld.Type.IsLinkedList:
+				fmt.Fprintf(w, "\tif %s.%s != nil {\n", wt.ShortTypeName, fld.Name)
+				fmt.Fprintf(w, "\t\t%s.%s = r.rewrite%s(%s.%s)\n", wt.ShortTypeName, fld.Name, fld.Type.FuncName, wt.ShortTypeName, fld.Name)
+				fmt.Fprintf(w, "\t}\n")
+			case fld.IsPointerType:
+				fmt.Fprintf(w, "\tif %s.%s != nil {\n", wt.ShortTypeName, fld.Name)
+				fmt.Fprintf(w, "\t\tr.rewrite%s(nil, %s.%s)\n", fld.Type.FuncName, wt.ShortTypeName, fld.Name)
+				fmt.Fprintf(w, "\t}\n")
+			default:
+				fmt.Fprintf(w, "\tr.rewrite%s(nil, &%s.%s)\n", fld.Type.FuncName, wt.ShortTypeName, fld.Name)
+			}
+
+			if i < len(wt.Fields)-1 {
+				fmt.Fprintf(w, "\n")
+			}
+		}
+	}
+
+	return rewriteFnFootTmpl.Execute(w, wt)
+}
+
+// sliceElementTypes returns the types that are used as the elements of slices in the AST, which
+// need functions to rewrite those slices.
+func sliceElementTypes(wts []walkerType) []walkerType {
+	seen := make(map[string]bool)
+
+	var ewts []walkerType
+
+	for _, wt := range wts {
+		for _, fld := range wt.Fields {
+			if !fld.IsSliceType || seen[fld.Type.TypeName] {
+				continue
+			}
+
+			seen[fld.Type.TypeName] = true
+
+			ewts = append(ewts, fld.Type)
+		}
+	}
+
+	sort.Slice(ewts, func(i, j int) bool {
+		return ewts[i].TypeName < ewts[j].TypeName
+	})
+
+	return ewts
+}
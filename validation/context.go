@@ -224,6 +224,11 @@ type SDLContext struct {
 	// IsExtending is true if this context was created with an existing Schema, and it's being
 	// extended by another SDL file.
 	IsExtending bool
+
+	// typeLocations and directiveLocations store where each type and directive in the document
+	// was defined, so that both definitions can be reported if a name is used more than once.
+	typeLocations      map[string]ast.Location
+	directiveLocations map[string]ast.Location
 }
 
 // setExecutableDefinition ...
@@ -317,6 +322,14 @@ func PrepareContextSDL(ctx *Context) {
 		ctx.SDLContext.TypeDefinitions = make(map[string]*ast.TypeDefinition, size)
 	}
 
+	if ctx.SDLContext.typeLocations == nil {
+		ctx.SDLContext.typeLocations = make(map[string]ast.Location, ctx.Document.TypeDefinitions)
+	}
+
+	if ctx.SDLContext.directiveLocations == nil {
+		ctx.SDLContext.directiveLocations = make(map[string]ast.Location, ctx.Document.DirectiveDefinitions)
+	}
+
 	if ctx.SDLContext.SchemaExtensions == nil {
 		ctx.SDLContext.SchemaExtensions = make([]*ast.SchemaExtension, 0, ctx.Document.SchemaExtensions)
 	}
@@ -350,14 +363,16 @@ func PrepareContextSDL(ctx *Context) {
 				ddef := def.TypeSystemDefinition.DirectiveDefinition
 
 				if _, ok := ctx.Schema.Directives[ddef.Name]; ok {
-					ctx.AddError(ExistedDirectiveNameError(ddef.Name, 0, 0))
+					ctx.AddError(ExistedDirectiveNameError(ddef.Name, 0, 0).WithLocations(ctx.Document.Position(def.Loc)))
 					return
 				}
 
 				if _, ok := ctx.SDLContext.DirectiveDefinitions[ddef.Name]; ok {
-					ctx.AddError(DuplicateDirectiveNameError(ddef.Name, 0, 0))
+					prev := ctx.SDLContext.directiveLocations[ddef.Name]
+					ctx.AddError(DuplicateDirectiveNameError(ddef.Name, 0, 0).WithLocations(ctx.Document.Position(prev), ctx.Document.Position(def.Loc)))
 				} else {
 					ctx.SDLContext.DirectiveDefinitions[ddef.Name] = ddef
+					ctx.SDLContext.directiveLocations[ddef.Name] = def.Loc
 				}
 
 			// LoneSchemaDefinition:
@@ -383,14 +398,16 @@ func PrepareContextSDL(ctx *Context) {
 				tdef := def.TypeSystemDefinition.TypeDefinition
 
 				if _, ok := ctx.Schema.Types[tdef.Name]; ok {
-					ctx.AddError(ExistedTypeNameError(tdef.Name, 0, 0))
+					ctx.AddError(ExistedTypeNameError(tdef.Name, 0, 0).WithLocations(ctx.Document.Position(def.Loc)))
 					return
 				}
 
 				if _, ok := ctx.SDLContext.TypeDefinitions[tdef.Name]; ok {
-					ctx.AddError(DuplicateTypeNameError(tdef.Name, 0, 0))
+					prev := ctx.SDLContext.typeLocations[tdef.Name]
+					ctx.AddError(DuplicateTypeNameError(tdef.Name, 0, 0).WithLocations(ctx.Document.Position(prev), ctx.Document.Position(def.Loc)))
 				} else {
 					ctx.SDLContext.TypeDefinitions[tdef.Name] = tdef
+					ctx.SDLContext.typeLocations[tdef.Name] = def.Loc
 				}
 			}
 
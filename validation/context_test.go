@@ -382,3 +382,25 @@ func BenchmarkContext_RecursivelyReferencedFragments(b *testing.B) {
 //}
 
 func TestSetRecursiveVariableUsages(t *testing.T) {}
+
+func TestPrepareContextSDL_Sources(t *testing.T) {
+	doc, err := language.ParseSources([]language.Source{
+		{Name: "a.graphql", Body: []byte("type Query { foo: Foo }\ntype Foo { bar: String }")},
+		{Name: "b.graphql", Body: []byte("\n  type Foo { baz: String }")},
+	}, language.ParserOptions{})
+
+	require.NoError(t, err)
+
+	ctx := NewSDLContext(doc, nil)
+	require.Equal(t, 1, ctx.Errors.Len())
+
+	var locations []ast.Position
+	ctx.Errors.Data.Locations.ForEach(func(l ast.Position, i int) {
+		locations = append(locations, l)
+	})
+
+	require.Equal(t, []ast.Position{
+		{Line: 2, Column: 1, Source: "a.graphql"},
+		{Line: 2, Column: 3, Source: "b.graphql"},
+	}, locations)
+}
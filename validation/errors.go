@@ -123,6 +123,13 @@ func ExtendingUnknownTypeError(typeName string, line, col int) graphql.Error {
 	return graphql.NewError("Cannot extend type \"" + typeName + "\" because it is not defined.")
 }
 
+// IntOutOfRangeError ...
+func IntOutOfRangeError(value string, pos ast.Position) graphql.Error {
+	return graphql.NewError(
+		"Expected type Int, found " + value + "; Int cannot represent non 32-bit signed integer value: " + value,
+	).WithLocations(pos)
+}
+
 // InvalidNameError ...
 func InvalidNameError(name string, line, col int) graphql.Error {
 	return graphql.NewError(
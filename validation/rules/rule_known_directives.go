@@ -30,14 +30,14 @@ func KnownDirectives(w *validation.Walker) {
 		}
 
 		if def == nil || !ok {
-			ctx.AddError(validation.UnknownDirectiveError(dir.Name, 0, 0))
+			ctx.AddError(validation.UnknownDirectiveError(dir.Name, 0, 0).WithLocations(ctx.Document.Position(dir.Loc)))
 			return
 		}
 
 		// The directive definition doesn't contain the location this directive is currently being
 		// used on it.
 		if def.DirectiveLocations&dir.Location == 0 {
-			ctx.AddError(validation.MisplacedDirectiveError(dir.Name, dir.Location, 0, 0))
+			ctx.AddError(validation.MisplacedDirectiveError(dir.Name, dir.Location, 0, 0).WithLocations(ctx.Document.Position(dir.Loc)))
 		}
 	})
 }
@@ -62,7 +62,7 @@ func TestKnownDirectives(t *testing.T) {
 					}
 				`,
 				errs: (*graphql.Errors)(nil).
-					Add(validation.UnknownDirectiveError("unknown", 0, 0)),
+					Add(validation.UnknownDirectiveError("unknown", 0, 0).WithLocations(ast.Position{Line: 3, Column: 11})),
 			},
 			{
 				msg: "with many unknown directives",
@@ -80,9 +80,9 @@ func TestKnownDirectives(t *testing.T) {
 					}
 				`,
 				errs: (*graphql.Errors)(nil).
-					Add(validation.UnknownDirectiveError("unknown", 0, 0)).
-					Add(validation.UnknownDirectiveError("unknown", 0, 0)).
-					Add(validation.UnknownDirectiveError("unknown", 0, 0)),
+					Add(validation.UnknownDirectiveError("unknown", 0, 0).WithLocations(ast.Position{Line: 3, Column: 11})).
+					Add(validation.UnknownDirectiveError("unknown", 0, 0).WithLocations(ast.Position{Line: 6, Column: 13})).
+					Add(validation.UnknownDirectiveError("unknown", 0, 0).WithLocations(ast.Position{Line: 8, Column: 13})),
 			},
 			{
 				msg: "with well placed directives",
@@ -121,10 +121,10 @@ func TestKnownDirectives(t *testing.T) {
 					}
 				`,
 				errs: (*graphql.Errors)(nil).
-					Add(validation.MisplacedDirectiveError("include", ast.DirectiveLocationKindQuery, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindField, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindFragmentSpread, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindMutation, 0, 0)),
+					Add(validation.MisplacedDirectiveError("include", ast.DirectiveLocationKindQuery, 0, 0).WithLocations(ast.Position{Line: 2, Column: 31})).
+					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindField, 0, 0).WithLocations(ast.Position{Line: 3, Column: 12})).
+					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindFragmentSpread, 0, 0).WithLocations(ast.Position{Line: 4, Column: 15})).
+					Add(validation.MisplacedDirectiveError("onQuery", ast.DirectiveLocationKindMutation, 0, 0).WithLocations(ast.Position{Line: 7, Column: 19})),
 			},
 			// TODO: This is in the working draft, not the 2018 spec.
 			//{
@@ -196,7 +196,7 @@ func TestKnownDirectives(t *testing.T) {
 					extend type Query @unknown
 				`,
 				errs: (*graphql.Errors)(nil).
-					Add(validation.UnknownDirectiveError("unknown", 0, 0)),
+					Add(validation.UnknownDirectiveError("unknown", 0, 0).WithLocations(ast.Position{Line: 2, Column: 24})),
 			},
 			{
 				msg: "well placed on schema",
@@ -278,20 +278,20 @@ func TestKnownDirectives(t *testing.T) {
 					extend schema @onObject
 				`,
 				errs: (*graphql.Errors)(nil).
-					Add(validation.MisplacedDirectiveError("onInterface", ast.DirectiveLocationKindObject, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindArgumentDefinition, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindFieldDefinition, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onEnum", ast.DirectiveLocationKindScalar, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindInterface, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindArgumentDefinition, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindFieldDefinition, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onEnumValue", ast.DirectiveLocationKindUnion, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onScalar", ast.DirectiveLocationKindEnum, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onUnion", ast.DirectiveLocationKindEnumValue, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onEnum", ast.DirectiveLocationKindInputObject, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onArgumentDefinition", ast.DirectiveLocationKindInputFieldDefinition, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindEnum, 0, 0)).
-					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindSchema, 0, 0)),
+					Add(validation.MisplacedDirectiveError("onInterface", ast.DirectiveLocationKindObject, 0, 0).WithLocations(ast.Position{Line: 2, Column: 40})).
+					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindArgumentDefinition, 0, 0).WithLocations(ast.Position{Line: 3, Column: 26})).
+					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindFieldDefinition, 0, 0).WithLocations(ast.Position{Line: 3, Column: 59})).
+					Add(validation.MisplacedDirectiveError("onEnum", ast.DirectiveLocationKindScalar, 0, 0).WithLocations(ast.Position{Line: 6, Column: 22})).
+					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindInterface, 0, 0).WithLocations(ast.Position{Line: 8, Column: 28})).
+					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindArgumentDefinition, 0, 0).WithLocations(ast.Position{Line: 9, Column: 26})).
+					Add(validation.MisplacedDirectiveError("onInputFieldDefinition", ast.DirectiveLocationKindFieldDefinition, 0, 0).WithLocations(ast.Position{Line: 9, Column: 59})).
+					Add(validation.MisplacedDirectiveError("onEnumValue", ast.DirectiveLocationKindUnion, 0, 0).WithLocations(ast.Position{Line: 12, Column: 20})).
+					Add(validation.MisplacedDirectiveError("onScalar", ast.DirectiveLocationKindEnum, 0, 0).WithLocations(ast.Position{Line: 14, Column: 18})).
+					Add(validation.MisplacedDirectiveError("onUnion", ast.DirectiveLocationKindEnumValue, 0, 0).WithLocations(ast.Position{Line: 15, Column: 16})).
+					Add(validation.MisplacedDirectiveError("onEnum", ast.DirectiveLocationKindInputObject, 0, 0).WithLocations(ast.Position{Line: 18, Column: 20})).
+					Add(validation.MisplacedDirectiveError("onArgumentDefinition", ast.DirectiveLocationKindInputFieldDefinition, 0, 0).WithLocations(ast.Position{Line: 19, Column: 20})).
+					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindEnum, 0, 0).WithLocations(ast.Position{Line: 22, Column: 21})).
+					Add(validation.MisplacedDirectiveError("onObject", ast.DirectiveLocationKindSchema, 0, 0).WithLocations(ast.Position{Line: 26, Column: 20})),
 			},
 		}
 
@@ -14,28 +14,35 @@ func PossibleNames(w *validation.Walker) {
 	// InputFields...
 	w.AddInputValueDefinitionEnterEventHandler(func(ctx *validation.Context, def ast.InputValueDefinition) {
 		if !isValidName(def.Name) {
-			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0))
+			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0).WithLocations(ctx.Document.Position(def.Loc)))
 		}
 	})
 
-	// Directives...
-	w.AddDirectiveDefinitionEnterEventHandler(func(ctx *validation.Context, def *ast.DirectiveDefinition) {
-		if !isValidName(def.Name) {
-			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0))
+	// Directives, and types... Only their definitions have locations, so they're checked from there.
+	w.AddDefinitionEnterEventHandler(func(ctx *validation.Context, def ast.Definition) {
+		if def.Kind != ast.DefinitionKindTypeSystem {
+			return
 		}
-	})
 
-	// Fields...
-	w.AddFieldDefinitionEnterEventHandler(func(ctx *validation.Context, def ast.FieldDefinition) {
-		if !isValidName(def.Name) {
-			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0))
+		var name string
+		switch def.TypeSystemDefinition.Kind {
+		case ast.TypeSystemDefinitionKindType:
+			name = def.TypeSystemDefinition.TypeDefinition.Name
+		case ast.TypeSystemDefinitionKindDirective:
+			name = def.TypeSystemDefinition.DirectiveDefinition.Name
+		default:
+			return
+		}
+
+		if !isValidName(name) {
+			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(name, 0, 0).WithLocations(ctx.Document.Position(def.Loc)))
 		}
 	})
 
-	// Types...
-	w.AddTypeDefinitionEnterEventHandler(func(ctx *validation.Context, def *ast.TypeDefinition) {
+	// Fields...
+	w.AddFieldDefinitionEnterEventHandler(func(ctx *validation.Context, def ast.FieldDefinition) {
 		if !isValidName(def.Name) {
-			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0))
+			ctx.AddError(validation.NameStartsWithTwoUnderscoresError(def.Name, 0, 0).WithLocations(ctx.Document.Position(def.Loc)))
 		}
 	})
 }
@@ -3,6 +3,7 @@ package rules_test
 import (
 	"testing"
 
+	"github.com/bucketd/go-graphqlparser/ast"
 	"github.com/bucketd/go-graphqlparser/graphql"
 	"github.com/bucketd/go-graphqlparser/validation"
 	"github.com/bucketd/go-graphqlparser/validation/rules"
@@ -46,17 +47,17 @@ func TestPossibleNames(t *testing.T) {
 				directive @__fooDirective on FIELD
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooObject", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooInputObject", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__bar", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__arg1", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__arg2", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__bar", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooScalar", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooInterface", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooUnion", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__FooEnum", 0, 0)).
-				Add(validation.NameStartsWithTwoUnderscoresError("__fooDirective", 0, 0)),
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooObject", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooInputObject", 0, 0).WithLocations(ast.Position{Line: 6, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__bar", 0, 0).WithLocations(ast.Position{Line: 3, Column: 6})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__arg1", 0, 0).WithLocations(ast.Position{Line: 3, Column: 12})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__arg2", 0, 0).WithLocations(ast.Position{Line: 3, Column: 28})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__bar", 0, 0).WithLocations(ast.Position{Line: 7, Column: 6})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooScalar", 0, 0).WithLocations(ast.Position{Line: 10, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooInterface", 0, 0).WithLocations(ast.Position{Line: 11, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooUnion", 0, 0).WithLocations(ast.Position{Line: 12, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__FooEnum", 0, 0).WithLocations(ast.Position{Line: 13, Column: 5})).
+				Add(validation.NameStartsWithTwoUnderscoresError("__fooDirective", 0, 0).WithLocations(ast.Position{Line: 14, Column: 5})),
 		},
 		{
 			msg: "invalid names in extensions",
@@ -71,7 +72,7 @@ func TestPossibleNames(t *testing.T) {
 				}
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.NameStartsWithTwoUnderscoresError("__baz", 0, 0)),
+				Add(validation.NameStartsWithTwoUnderscoresError("__baz", 0, 0).WithLocations(ast.Position{Line: 3, Column: 6})),
 		},
 	}
 
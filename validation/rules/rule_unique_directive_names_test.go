@@ -3,6 +3,7 @@ package rules_test
 import (
 	"testing"
 
+	"github.com/bucketd/go-graphqlparser/ast"
 	"github.com/bucketd/go-graphqlparser/graphql"
 	"github.com/bucketd/go-graphqlparser/validation"
 )
@@ -46,7 +47,7 @@ func TestUniqueDirectiveNames(t *testing.T) {
 				directive @foo on SCHEMA
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.DuplicateDirectiveNameError("foo", 0, 0)),
+				Add(validation.DuplicateDirectiveNameError("foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 3, Column: 5})),
 		},
 		{
 			msg: "adding new directive to existing schema",
@@ -66,7 +67,7 @@ func TestUniqueDirectiveNames(t *testing.T) {
 				directive @skip on SCHEMA
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.ExistedDirectiveNameError("skip", 0, 0)),
+				Add(validation.ExistedDirectiveNameError("skip", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5})),
 		},
 		{
 			msg: "adding new directive to existing schema with same-named type",
@@ -86,7 +87,7 @@ func TestUniqueDirectiveNames(t *testing.T) {
 				directive @foo on SCHEMA
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.ExistedDirectiveNameError("foo", 0, 0)),
+				Add(validation.ExistedDirectiveNameError("foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5})),
 		},
 	}
 
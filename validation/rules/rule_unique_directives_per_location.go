@@ -2,6 +2,7 @@ package rules
 
 import (
 	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/graphql"
 	"github.com/bucketd/go-graphqlparser/validation"
 )
 
@@ -17,6 +18,10 @@ func UniqueDirectivesPerLocation(w *validation.Walker) {
 		directives.ForEach(func(directive ast.Directive, i int) {
 			directiveName := directive.Name
 
+			if isRepeatableDirective(ctx, directiveName) {
+				return
+			}
+
 			if _, ok := knownDirectives[directiveName]; ok {
 				ctx.AddError(validation.DuplicateDirectiveError(directiveName, 0, 0))
 			} else {
@@ -25,3 +30,33 @@ func UniqueDirectivesPerLocation(w *validation.Walker) {
 		})
 	})
 }
+
+// isRepeatableDirective returns true if the directive with the given name is defined, and may be
+// used more than once at the same location. Unknown directives are handled by KnownDirectives.
+func isRepeatableDirective(ctx *validation.Context, name string) bool {
+	def, ok := directiveDefinition(ctx, name)
+
+	return ok && def.Repeatable
+}
+
+// directiveDefinition finds the definition of the directive with the given name, looking in the
+// schema, then the document being validated if it's an SDL document, then the directives defined
+// by the specification.
+func directiveDefinition(ctx *validation.Context, name string) (*ast.DirectiveDefinition, bool) {
+	var def *ast.DirectiveDefinition
+	var ok bool
+
+	if ctx.Schema != nil && ctx.Schema.Directives != nil {
+		def, ok = ctx.Schema.Directives[name]
+	}
+
+	if !ok && ctx.SDLContext != nil {
+		def, ok = ctx.SDLContext.DirectiveDefinitions[name]
+	}
+
+	if !ok {
+		def, ok = graphql.SpecifiedDirectives()[name]
+	}
+
+	return def, ok && def != nil
+}
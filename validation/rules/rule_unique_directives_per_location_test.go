@@ -95,6 +95,24 @@ func TestUniqueDirectivesPerLocation(t *testing.T) {
 					Add(validation.DuplicateDirectiveError("directive", 0, 0)).
 					Add(validation.DuplicateDirectiveError("directive", 0, 0)),
 			},
+			{
+				msg: "repeatable directives in same locations",
+				query: `
+					fragment Test on Type @repeatable @repeatable {
+						field @repeatable @repeatable
+					}
+				`,
+			},
+			{
+				msg: "repeatable directives mixed with duplicate directives",
+				query: `
+					fragment Test on Type {
+						field @repeatable @directive @repeatable @directive
+					}
+				`,
+				errs: (*graphql.Errors)(nil).
+					Add(validation.DuplicateDirectiveError("directive", 0, 0)),
+			},
 		}
 
 		queryRuleTester(t, tt, rules.UniqueDirectivesPerLocation)
@@ -137,6 +155,28 @@ func TestUniqueDirectivesPerLocation(t *testing.T) {
 					Add(validation.DuplicateDirectiveError("directive", 0, 0)).
 					Add(validation.DuplicateDirectiveError("directive", 0, 0)),
 			},
+			{
+				msg: "repeatable directives defined in the same document",
+				query: `
+					directive @repeatable repeatable on SCALAR | OBJECT
+
+					scalar TestScalar @repeatable @repeatable
+					extend scalar TestScalar @repeatable @repeatable
+
+					type TestObject @repeatable @repeatable
+					extend type TestObject @repeatable @repeatable
+				`,
+			},
+			{
+				msg: "repeatable directives defined in an extended schema",
+				query: `
+					scalar TestScalar @repeatable @repeatable
+					extend scalar TestScalar @repeatable @repeatable
+				`,
+				schema: mustBuildSchema(nil, []byte(`
+					directive @repeatable repeatable on SCALAR
+				`)),
+			},
 		}
 
 		sdlRuleTester(t, tt, rules.UniqueDirectivesPerLocation)
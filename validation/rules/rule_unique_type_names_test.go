@@ -3,6 +3,7 @@ package rules_test
 import (
 	"testing"
 
+	"github.com/bucketd/go-graphqlparser/ast"
 	"github.com/bucketd/go-graphqlparser/graphql"
 	"github.com/bucketd/go-graphqlparser/validation"
 )
@@ -52,12 +53,12 @@ func TestUniqueTypeNames(t *testing.T) {
 				input Foo
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)).
-				Add(validation.DuplicateTypeNameError("Foo", 0, 0)),
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 4, Column: 5})).
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 5, Column: 5})).
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 6, Column: 5})).
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 7, Column: 5})).
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 8, Column: 5})).
+				Add(validation.DuplicateTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5}, ast.Position{Line: 9, Column: 5})),
 		},
 		{
 			msg: "adding new types to existing schema",
@@ -91,12 +92,12 @@ func TestUniqueTypeNames(t *testing.T) {
 				input Foo
 			`,
 			errs: (*graphql.Errors)(nil).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)).
-				Add(validation.ExistedTypeNameError("Foo", 0, 0)),
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 2, Column: 5})).
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 3, Column: 5})).
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 4, Column: 5})).
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 5, Column: 5})).
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 6, Column: 5})).
+				Add(validation.ExistedTypeNameError("Foo", 0, 0).WithLocations(ast.Position{Line: 7, Column: 5})),
 		},
 	}
 
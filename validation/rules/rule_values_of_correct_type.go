@@ -1,8 +1,178 @@
 package rules
 
 import (
+	"math"
+
+	"github.com/bucketd/go-graphqlparser/ast"
 	"github.com/bucketd/go-graphqlparser/validation"
 )
 
 // ValuesOfCorrectType ...
-func ValuesOfCorrectType(w *validation.Walker) {}
+// TODO: Only the range of Int values is checked so far.
+func ValuesOfCorrectType(w *validation.Walker) {
+	w.AddOperationDefinitionEnterEventHandler(func(ctx *validation.Context, def *ast.OperationDefinition) {
+		def.VariableDefinitions.ForEach(func(vd ast.VariableDefinition, _ int) {
+			if vd.DefaultValue != nil {
+				checkValueOfType(ctx, vd.Type, *vd.DefaultValue)
+			}
+		})
+
+		var rootType *ast.Type
+
+		switch def.Kind {
+		case ast.OperationDefinitionKindQuery:
+			rootType = ctx.Schema.QueryType
+		case ast.OperationDefinitionKindMutation:
+			rootType = ctx.Schema.MutationType
+		case ast.OperationDefinitionKindSubscription:
+			rootType = ctx.Schema.SubscriptionType
+		}
+
+		if rootType != nil {
+			checkSelectionValues(ctx, rootType.NamedType, def.SelectionSet)
+		}
+	})
+
+	w.AddFragmentDefinitionEnterEventHandler(func(ctx *validation.Context, def *ast.FragmentDefinition) {
+		if def.TypeCondition != nil {
+			checkSelectionValues(ctx, def.TypeCondition.NamedType.NamedType, def.SelectionSet)
+		}
+	})
+
+	w.AddDirectiveEnterEventHandler(func(ctx *validation.Context, dir ast.Directive) {
+		if dd, ok := directiveDefinition(ctx, dir.Name); ok {
+			checkArgumentValues(ctx, dd.ArgumentsDefinition, dir.Arguments)
+		}
+	})
+
+	// Arguments, input fields, and directive arguments defined in SDL documents may have default
+	// values too.
+	w.AddInputValueDefinitionEnterEventHandler(func(ctx *validation.Context, ivd ast.InputValueDefinition) {
+		if ivd.DefaultValue != nil {
+			checkValueOfType(ctx, ivd.Type, *ivd.DefaultValue)
+		}
+	})
+}
+
+// checkSelectionValues checks the values of the arguments given to each field in the given
+// selection set, which is on the type with the given name. Directives are handled separately.
+func checkSelectionValues(ctx *validation.Context, typeName string, selections *ast.Selections) {
+	selections.ForEach(func(sel ast.Selection, _ int) {
+		switch sel.Kind {
+		case ast.SelectionKindField:
+			typeDef, ok := ctx.Schema.Types[typeName]
+			if !ok {
+				return
+			}
+
+			fieldDef, ok := fieldDefinition(typeDef, sel.Name)
+			if !ok {
+				return
+			}
+
+			checkArgumentValues(ctx, fieldDef.ArgumentsDefinition, sel.Arguments)
+			checkSelectionValues(ctx, namedType(fieldDef.Type), sel.SelectionSet)
+		case ast.SelectionKindInlineFragment:
+			if sel.TypeCondition != nil {
+				checkSelectionValues(ctx, sel.TypeCondition.NamedType.NamedType, sel.SelectionSet)
+			} else {
+				checkSelectionValues(ctx, typeName, sel.SelectionSet)
+			}
+		}
+	})
+}
+
+// checkArgumentValues checks the values of the given arguments against the definitions of those
+// arguments. Unknown arguments are ignored, they're reported by KnownArgumentNames.
+func checkArgumentValues(ctx *validation.Context, defs *ast.InputValueDefinitions, args *ast.Arguments) {
+	args.ForEach(func(arg ast.Argument, _ int) {
+		if ivd, ok := inputValueDefinition(defs, arg.Name); ok {
+			checkValueOfType(ctx, ivd.Type, arg.Value)
+		}
+	})
+}
+
+// checkValueOfType checks the given value against the given type, recursing into list and object
+// values.
+func checkValueOfType(ctx *validation.Context, t ast.Type, v ast.Value) {
+	switch v.Kind {
+	case ast.ValueKindInt:
+		if t.Kind != ast.TypeKindNamed || t.NamedType != "Int" {
+			return
+		}
+
+		bi, ok := v.BigInt()
+		if !ok || !bi.IsInt64() || bi.Int64() < math.MinInt32 || bi.Int64() > math.MaxInt32 {
+			ctx.AddError(validation.IntOutOfRangeError(v.RawValue, ctx.Document.Position(v.Loc)))
+		}
+	case ast.ValueKindList:
+		// A single value may also be given where a list is expected.
+		itemType := t
+		if t.Kind == ast.TypeKindList {
+			itemType = *t.ListType
+		}
+
+		for _, item := range v.ListValue {
+			checkValueOfType(ctx, itemType, item)
+		}
+	case ast.ValueKindObject:
+		if t.Kind != ast.TypeKindNamed {
+			return
+		}
+
+		typeDef, ok := typeDefinition(ctx, t.NamedType)
+		if !ok {
+			return
+		}
+
+		for _, field := range v.ObjectValue {
+			if ivd, ok := inputValueDefinition(typeDef.InputFieldsDefinition, field.Name); ok {
+				checkValueOfType(ctx, ivd.Type, field.Value)
+			}
+		}
+	}
+}
+
+// typeDefinition finds the definition of the type with the given name, looking in the schema, then
+// the document being validated if it's an SDL document.
+func typeDefinition(ctx *validation.Context, name string) (*ast.TypeDefinition, bool) {
+	typeDef, ok := ctx.Schema.Types[name]
+	if !ok && ctx.SDLContext != nil {
+		typeDef, ok = ctx.SDLContext.TypeDefinitions[name]
+	}
+
+	return typeDef, ok && typeDef != nil
+}
+
+// fieldDefinition finds the definition of the field with the given name on the given type.
+func fieldDefinition(typeDef *ast.TypeDefinition, name string) (ast.FieldDefinition, bool) {
+	gen := typeDef.FieldsDefinition.Generator()
+	for fd, i := gen.Next(); i >= 0; fd, i = gen.Next() {
+		if fd.Name == name {
+			return fd, true
+		}
+	}
+
+	return ast.FieldDefinition{}, false
+}
+
+// inputValueDefinition finds the input value definition with the given name in the given list.
+func inputValueDefinition(defs *ast.InputValueDefinitions, name string) (ast.InputValueDefinition, bool) {
+	gen := defs.Generator()
+	for ivd, i := gen.Next(); i >= 0; ivd, i = gen.Next() {
+		if ivd.Name == name {
+			return ivd, true
+		}
+	}
+
+	return ast.InputValueDefinition{}, false
+}
+
+// namedType returns the name of the type at the core of the given type, unwrapping any lists.
+func namedType(t ast.Type) string {
+	for t.Kind == ast.TypeKindList {
+		t = *t.ListType
+	}
+
+	return t.NamedType
+}
@@ -3,11 +3,159 @@ package rules_test
 import (
 	"testing"
 
+	"github.com/bucketd/go-graphqlparser/ast"
+	"github.com/bucketd/go-graphqlparser/graphql"
+	"github.com/bucketd/go-graphqlparser/validation"
 	"github.com/bucketd/go-graphqlparser/validation/rules"
 )
 
 func TestValuesOfCorrectType(t *testing.T) {
-	tt := []ruleTestCase{}
+	tt := []ruleTestCase{
+		{
+			msg: "good int value",
+			query: `
+				{
+					complicatedArgs {
+						intArgField(intArg: 2)
+					}
+				}
+			`,
+		},
+		{
+			msg: "max and min int values",
+			query: `
+				{
+					complicatedArgs {
+						a: intArgField(intArg: 2147483647)
+						b: intArgField(intArg: -2147483648)
+					}
+				}
+			`,
+		},
+		{
+			msg: "int value too large",
+			query: `
+				{
+					complicatedArgs {
+						intArgField(intArg: 2147483648)
+					}
+				}
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("2147483648", ast.Position{Line: 4, Column: 27})),
+		},
+		{
+			msg: "int value too large for 64 bits",
+			query: `
+				{
+					complicatedArgs {
+						intArgField(intArg: 9223372036854775808)
+					}
+				}
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("9223372036854775808", ast.Position{Line: 4, Column: 27})),
+		},
+		{
+			msg: "int value too small in input object",
+			query: `
+				{
+					complicatedArgs {
+						complexArgField(complexArg: { requiredField: true, intField: -2147483649 })
+					}
+				}
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("-2147483649", ast.Position{Line: 4, Column: 68})),
+		},
+		{
+			msg: "int value too large in fragment",
+			query: `
+				{
+					...Args
+				}
+
+				fragment Args on QueryRoot {
+					complicatedArgs {
+						... on ComplicatedArgs {
+							intArgField(intArg: 2147483648)
+						}
+					}
+				}
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("2147483648", ast.Position{Line: 9, Column: 28})),
+		},
+		{
+			msg: "int value too large as variable default value",
+			query: `
+				query ($a: [Int] = [1, 2147483648]) {
+					complicatedArgs {
+						intArgField(intArg: $a)
+					}
+				}
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("2147483648", ast.Position{Line: 2, Column: 28})),
+		},
+		{
+			msg: "large int value for custom scalar",
+			query: `
+				{
+					anyArg(arg: 9223372036854775808)
+				}
+			`,
+		},
+	}
 
 	queryRuleTester(t, tt, rules.ValuesOfCorrectType)
 }
+
+func TestValuesOfCorrectType_SDL(t *testing.T) {
+	tt := []ruleTestCase{
+		{
+			msg: "good default values",
+			query: `
+				input Input { a: Int = 1 }
+				type Query { f(a: Int = 2147483647, b: Input = { a: -2147483648 }): Int }
+				directive @dir(a: [Int] = [1, 2]) on FIELD
+			`,
+		},
+		{
+			msg: "argument default value too large",
+			query: `
+				type Query { f(a: Int = 99999999999999): Int }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("99999999999999", ast.Position{Line: 2, Column: 29})),
+		},
+		{
+			msg: "input field default value too large",
+			query: `
+				input Input { a: [Int] = [1, 2147483648] }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("2147483648", ast.Position{Line: 2, Column: 34})),
+		},
+		{
+			msg: "int value too small in input object default value",
+			query: `
+				input Input { a: Int }
+				type Query { f(a: Input = { a: -2147483649 }): Int }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("-2147483649", ast.Position{Line: 3, Column: 36})),
+		},
+		{
+			msg: "directive argument value too large",
+			query: `
+				directive @dir(a: Int) on OBJECT
+				type Query @dir(a: 2147483648) { f: Int }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(validation.IntOutOfRangeError("2147483648", ast.Position{Line: 3, Column: 24})),
+		},
+	}
+
+	sdlRuleTester(t, tt, rules.ValuesOfCorrectType)
+}
@@ -31,7 +31,7 @@ var Specified = []validation.VisitFunc{
 	UniqueDirectivesPerLocation,
 	// KnownArgumentNames,
 	UniqueArgumentNames,
-	// ValuesOfCorrectType,
+	ValuesOfCorrectType,
 	// ProvidedRequiredArguments,
 	// VariablesInAllowedPosition,
 	// OverlappingFieldsCanBeMerged,
@@ -60,6 +60,7 @@ var SpecifiedSDL = []validation.VisitFunc{
 	PossibleNames,
 	PossibleTypeExtensions,
 	ProvidedRequiredArgumentsOnDirectives,
+	ValuesOfCorrectType,
 
 	// These rules are handled after walking:
 	//UniqueEnumValueNames,
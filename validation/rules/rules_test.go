@@ -137,6 +137,7 @@ var (
 		directive @onFragmentSpread on FRAGMENT_SPREAD
 		directive @onInlineFragment on INLINE_FRAGMENT
 		directive @onVariableDefinition on VARIABLE_DEFINITION
+		directive @repeatable repeatable on FIELD | FRAGMENT_DEFINITION
 	`)
 )
 
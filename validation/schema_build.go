@@ -140,11 +140,16 @@ func mergeTypeExtensions(ctx *Context) {
 			typeDef.Directives.Join(typeExt.Directives)
 
 			switch {
-			case ast.IsObjectTypeExtension(typeExt):
-				typeDef.FieldsDefinition.Join(typeExt.FieldsDefinition)
-				typeDef.ImplementsInterface.Join(typeExt.ImplementsInterface)
-			case ast.IsInterfaceTypeExtension(typeExt):
+			case ast.IsObjectTypeExtension(typeExt), ast.IsInterfaceTypeExtension(typeExt):
 				typeDef.FieldsDefinition.Join(typeExt.FieldsDefinition)
+
+				// Join does nothing on an empty list, and types often don't implement anything
+				// until they're extended.
+				if typeDef.ImplementsInterface == nil {
+					typeDef.ImplementsInterface = typeExt.ImplementsInterface
+				} else {
+					typeDef.ImplementsInterface.Join(typeExt.ImplementsInterface)
+				}
 			case ast.IsUnionTypeExtension(typeExt):
 				typeDef.UnionMemberTypes.Join(typeExt.UnionMemberTypes)
 			case ast.IsEnumTypeExtension(typeExt):
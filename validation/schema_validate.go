@@ -100,10 +100,10 @@ func validateTypes(ctx *Context, schema *graphql.Schema) {
 		switch {
 		case ast.IsObjectTypeDefinition(typeDef):
 			validateFields(ctx, schema, typeDef)
-			validateObjectInterfaces(ctx, schema, typeDef)
+			validateInterfaces(ctx, schema, typeDef)
 		case ast.IsInterfaceTypeDefinition(typeDef):
 			validateFields(ctx, schema, typeDef)
-			// TODO: ...
+			validateInterfaces(ctx, schema, typeDef)
 		case ast.IsUnionTypeDefinition(typeDef):
 			// TODO: ...
 		case ast.IsEnumTypeDefinition(typeDef):
@@ -158,8 +158,8 @@ func validateFields(ctx *Context, schema *graphql.Schema, typeDef *ast.TypeDefin
 	})
 }
 
-// validateObjectInterfaces ...
-func validateObjectInterfaces(ctx *Context, schema *graphql.Schema, typeDef *ast.TypeDefinition) {
+// validateInterfaces validates the interfaces implemented by an object or interface type.
+func validateInterfaces(ctx *Context, schema *graphql.Schema, typeDef *ast.TypeDefinition) {
 	implementedTypeNames := make(map[string]struct{}, typeDef.ImplementsInterface.Len())
 
 	typeDef.ImplementsInterface.ForEach(func(t ast.Type, i int) {
@@ -172,6 +172,15 @@ func validateObjectInterfaces(ctx *Context, schema *graphql.Schema, typeDef *ast
 			return
 		}
 
+		if t.NamedType == typeDef.Name {
+			ctx.AddError(graphql.NewError(
+				"Type " + typeDef.Name + " cannot implement itself because it would create a " +
+					"circular reference.",
+				// TODO: Location.
+			))
+			return
+		}
+
 		if _, ok := implementedTypeNames[t.NamedType]; ok {
 			ctx.AddError(graphql.NewError(
 				"Type " + typeDef.Name + " can only implement " + t.NamedType + " once.",
@@ -185,12 +194,47 @@ func validateObjectInterfaces(ctx *Context, schema *graphql.Schema, typeDef *ast
 		// It's safe to assume this exists at this point, thanks to IsInterfaceType.
 		ifaceDef := schema.Types[t.NamedType]
 
-		validateObjectImplementsInterface(ctx, schema, typeDef, ifaceDef)
+		validateTypeImplementsAncestors(ctx, typeDef, ifaceDef)
+		validateTypeImplementsInterface(ctx, schema, typeDef, ifaceDef)
+	})
+}
+
+// validateTypeImplementsAncestors checks that a type also implements all of the interfaces that
+// are implemented by one of its interfaces. Interfaces that implement each other are reported as
+// circular references here too.
+func validateTypeImplementsAncestors(ctx *Context, typeDef *ast.TypeDefinition, ifaceDef *ast.TypeDefinition) {
+	ifaceDef.ImplementsInterface.ForEach(func(transitive ast.Type, i int) {
+		var found bool
+
+		typeDef.ImplementsInterface.ForEach(func(t ast.Type, j int) {
+			if t.NamedType == transitive.NamedType {
+				found = true
+			}
+		})
+
+		if found {
+			return
+		}
+
+		if transitive.NamedType == typeDef.Name {
+			ctx.AddError(graphql.NewError(
+				"Type " + typeDef.Name + " cannot implement " + ifaceDef.Name + " because it would " +
+					"create a circular reference.",
+				// TODO: Location.
+			))
+			return
+		}
+
+		ctx.AddError(graphql.NewError(
+			"Type " + typeDef.Name + " must implement " + transitive.NamedType + " because it is " +
+				"implemented by " + ifaceDef.Name + ".",
+			// TODO: Location.
+		))
 	})
 }
 
-// validateObjectImplementsInterface ...
-func validateObjectImplementsInterface(
+// validateTypeImplementsInterface ...
+func validateTypeImplementsInterface(
 	ctx *Context,
 	schema *graphql.Schema,
 	typeDef *ast.TypeDefinition,
@@ -0,0 +1,87 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/bucketd/go-graphqlparser"
+	"github.com/bucketd/go-graphqlparser/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema_InterfacesImplementingInterfaces(t *testing.T) {
+	tt := []struct {
+		msg  string
+		sdl  string
+		errs *graphql.Errors
+	}{
+		{
+			msg: "interface hierarchy",
+			sdl: `
+				type Query { node: Node }
+				interface Node { id: ID! parent: Node }
+				interface Resource implements Node { id: ID! parent: Resource url: String }
+				type File implements Resource & Node { id: ID! parent: File url: String }
+			`,
+		},
+		{
+			msg: "interface hierarchy from extension",
+			sdl: `
+				type Query { node: Node }
+				interface Node { id: ID! }
+				interface Resource { id: ID! }
+				extend interface Resource implements Node
+			`,
+		},
+		{
+			msg: "missing transitive interface",
+			sdl: `
+				type Query { node: Node }
+				interface Node { id: ID! }
+				interface Resource implements Node { id: ID! }
+				type File implements Resource { id: ID! }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(graphql.NewError("Type File must implement Node because it is implemented by Resource.")),
+		},
+		{
+			msg: "missing interface field",
+			sdl: `
+				type Query { node: Node }
+				interface Node { id: ID! }
+				interface Resource implements Node { url: String }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(graphql.NewError("Interface field Node.id expected but Resource does not provide it.")),
+		},
+		{
+			msg: "implements itself",
+			sdl: `
+				type Query { node: Node }
+				interface Node implements Node { id: ID! }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(graphql.NewError("Type Node cannot implement itself because it would create a circular reference.")),
+		},
+		{
+			msg: "circular implementations",
+			sdl: `
+				type Query { node: Node }
+				interface Node implements Resource { id: ID! }
+				interface Resource implements Node { id: ID! }
+			`,
+			errs: (*graphql.Errors)(nil).
+				Add(graphql.NewError("Type Node cannot implement Resource because it would create a circular reference.")).
+				Add(graphql.NewError("Type Resource cannot implement Node because it would create a circular reference.")),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, errs, err := graphqlparser.ParseSDLDoc([]byte(tc.sdl), nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, graphql.SortErrors(tc.errs), graphql.SortErrors(errs))
+		})
+	}
+}
@@ -122,6 +122,19 @@ func IsTypeSubTypeOf(schema *graphql.Schema, maybeSubType, superType ast.Type) b
 		return true
 	}
 
+	// Interfaces may also implement other interfaces.
+	if IsInterfaceType(schema, superType) && IsInterfaceType(schema, maybeSubType) {
+		var found bool
+
+		schema.Types[maybeSubType.NamedType].ImplementsInterface.ForEach(func(t ast.Type, i int) {
+			if t.NamedType == superType.NamedType {
+				found = true
+			}
+		})
+
+		return found
+	}
+
 	return false
 }
 
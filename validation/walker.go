@@ -9,6 +9,8 @@ type Walker struct {
 	argumentEventHandlers                        ArgumentEventHandlers
 	argumentsEventHandlers                       ArgumentsEventHandlers
 	booleanValueEventHandlers                    BooleanValueEventHandlers
+	commentEventHandlers                         CommentEventHandlers
+	commentsEventHandlers                        CommentsEventHandlers
 	definitionEventHandlers                      DefinitionEventHandlers
 	definitionsEventHandlers                     DefinitionsEventHandlers
 	directiveEventHandlers                       DirectiveEventHandlers
@@ -39,7 +41,6 @@ type Walker struct {
 	listTypeEventHandlers                        ListTypeEventHandlers
 	listValueEventHandlers                       ListValueEventHandlers
 	locationEventHandlers                        LocationEventHandlers
-	locationsEventHandlers                       LocationsEventHandlers
 	mutationOperationDefinitionEventHandlers     MutationOperationDefinitionEventHandlers
 	namedTypeEventHandlers                       NamedTypeEventHandlers
 	nullValueEventHandlers                       NullValueEventHandlers
@@ -52,6 +53,8 @@ type Walker struct {
 	operationTypeDefinitionsEventHandlers        OperationTypeDefinitionsEventHandlers
 	pathNodeEventHandlers                        PathNodeEventHandlers
 	pathNodesEventHandlers                       PathNodesEventHandlers
+	positionEventHandlers                        PositionEventHandlers
+	positionsEventHandlers                       PositionsEventHandlers
 	queryOperationDefinitionEventHandlers        QueryOperationDefinitionEventHandlers
 	scalarTypeDefinitionEventHandlers            ScalarTypeDefinitionEventHandlers
 	scalarTypeExtensionEventHandlers             ScalarTypeExtensionEventHandlers
@@ -218,6 +221,92 @@ func (w *Walker) walkBooleanValue(ctx *Context, v ast.Value) {
 	w.OnBooleanValueLeave(ctx, v)
 }
 
+// CommentEventHandler function can handle enter/leave events for Comment.
+type CommentEventHandler func(*Context, ast.Comment)
+
+// CommentEventHandlers stores the enter and leave events handlers.
+type CommentEventHandlers struct {
+	enter []CommentEventHandler
+	leave []CommentEventHandler
+}
+
+// AddCommentEnterEventHandler adds an event handler to be called when entering Comment nodes.
+func (w *Walker) AddCommentEnterEventHandler(h CommentEventHandler) {
+	w.commentEventHandlers.enter = append(w.commentEventHandlers.enter, h)
+}
+
+// AddCommentLeaveEventHandler adds an event handler to be called when leaving Comment nodes.
+func (w *Walker) AddCommentLeaveEventHandler(h CommentEventHandler) {
+	w.commentEventHandlers.leave = append(w.commentEventHandlers.leave, h)
+}
+
+// OnCommentEnter calls the enter event handlers registered for this node type.
+func (w *Walker) OnCommentEnter(ctx *Context, c ast.Comment) {
+	for _, handler := range w.commentEventHandlers.enter {
+		handler(ctx, c)
+	}
+}
+
+// OnCommentLeave calls the leave event handlers registered for this node type.
+func (w *Walker) OnCommentLeave(ctx *Context, c ast.Comment) {
+	for _, handler := range w.commentEventHandlers.leave {
+		handler(ctx, c)
+	}
+}
+
+// walkComment is a function that walks Comment type's AST node.
+func (w *Walker) walkComment(ctx *Context, c ast.Comment) {
+	w.OnCommentEnter(ctx, c)
+
+	w.walkLocation(ctx, c.Loc)
+
+	w.OnCommentLeave(ctx, c)
+}
+
+// CommentsEventHandler function can handle enter/leave events for Comments.
+type CommentsEventHandler func(*Context, *ast.Comments)
+
+// CommentsEventHandlers stores the enter and leave events handlers.
+type CommentsEventHandlers struct {
+	enter []CommentsEventHandler
+	leave []CommentsEventHandler
+}
+
+// AddCommentsEnterEventHandler adds an event handler to be called when entering Comments nodes.
+func (w *Walker) AddCommentsEnterEventHandler(h CommentsEventHandler) {
+	w.commentsEventHandlers.enter = append(w.commentsEventHandlers.enter, h)
+}
+
+// AddCommentsLeaveEventHandler adds an event handler to be called when leaving Comments nodes.
+func (w *Walker) AddCommentsLeaveEventHandler(h CommentsEventHandler) {
+	w.commentsEventHandlers.leave = append(w.commentsEventHandlers.leave, h)
+}
+
+// OnCommentsEnter calls the enter event handlers registered for this node type.
+func (w *Walker) OnCommentsEnter(ctx *Context, cs *ast.Comments) {
+	for _, handler := range w.commentsEventHandlers.enter {
+		handler(ctx, cs)
+	}
+}
+
+// OnCommentsLeave calls the leave event handlers registered for this node type.
+func (w *Walker) OnCommentsLeave(ctx *Context, cs *ast.Comments) {
+	for _, handler := range w.commentsEventHandlers.leave {
+		handler(ctx, cs)
+	}
+}
+
+// walkComments is a function that walks Comments type's AST node.
+func (w *Walker) walkComments(ctx *Context, cs *ast.Comments) {
+	w.OnCommentsEnter(ctx, cs)
+
+	cs.ForEach(func(c ast.Comment, i int) {
+		w.walkComment(ctx, c)
+	})
+
+	w.OnCommentsLeave(ctx, cs)
+}
+
 // DefinitionEventHandler function can handle enter/leave events for Definition.
 type DefinitionEventHandler func(*Context, ast.Definition)
 
@@ -1016,6 +1105,10 @@ func (w *Walker) OnFragmentDefinitionLeave(ctx *Context, fd *ast.FragmentDefinit
 func (w *Walker) walkFragmentDefinition(ctx *Context, fd *ast.FragmentDefinition) {
 	w.OnFragmentDefinitionEnter(ctx, fd)
 
+	if fd.VariableDefinitions != nil {
+		w.walkVariableDefinitions(ctx, fd.VariableDefinitions)
+	}
+
 	if fd.TypeCondition != nil {
 		w.walkTypeCondition(ctx, fd.TypeCondition)
 	}
@@ -1704,50 +1797,6 @@ func (w *Walker) walkLocation(ctx *Context, l ast.Location) {
 	w.OnLocationLeave(ctx, l)
 }
 
-// LocationsEventHandler function can handle enter/leave events for Locations.
-type LocationsEventHandler func(*Context, *ast.Locations)
-
-// LocationsEventHandlers stores the enter and leave events handlers.
-type LocationsEventHandlers struct {
-	enter []LocationsEventHandler
-	leave []LocationsEventHandler
-}
-
-// AddLocationsEnterEventHandler adds an event handler to be called when entering Locations nodes.
-func (w *Walker) AddLocationsEnterEventHandler(h LocationsEventHandler) {
-	w.locationsEventHandlers.enter = append(w.locationsEventHandlers.enter, h)
-}
-
-// AddLocationsLeaveEventHandler adds an event handler to be called when leaving Locations nodes.
-func (w *Walker) AddLocationsLeaveEventHandler(h LocationsEventHandler) {
-	w.locationsEventHandlers.leave = append(w.locationsEventHandlers.leave, h)
-}
-
-// OnLocationsEnter calls the enter event handlers registered for this node type.
-func (w *Walker) OnLocationsEnter(ctx *Context, ls *ast.Locations) {
-	for _, handler := range w.locationsEventHandlers.enter {
-		handler(ctx, ls)
-	}
-}
-
-// OnLocationsLeave calls the leave event handlers registered for this node type.
-func (w *Walker) OnLocationsLeave(ctx *Context, ls *ast.Locations) {
-	for _, handler := range w.locationsEventHandlers.leave {
-		handler(ctx, ls)
-	}
-}
-
-// walkLocations is a function that walks Locations type's AST node.
-func (w *Walker) walkLocations(ctx *Context, ls *ast.Locations) {
-	w.OnLocationsEnter(ctx, ls)
-
-	ls.ForEach(func(l ast.Location, i int) {
-		w.walkLocation(ctx, l)
-	})
-
-	w.OnLocationsLeave(ctx, ls)
-}
-
 // MutationOperationDefinitionEventHandler function can handle enter/leave events for MutationOperationDefinition.
 type MutationOperationDefinitionEventHandler func(*Context, *ast.OperationDefinition)
 
@@ -2324,6 +2373,90 @@ func (w *Walker) walkPathNodes(ctx *Context, pns *ast.PathNodes) {
 	w.OnPathNodesLeave(ctx, pns)
 }
 
+// PositionEventHandler function can handle enter/leave events for Position.
+type PositionEventHandler func(*Context, ast.Position)
+
+// PositionEventHandlers stores the enter and leave events handlers.
+type PositionEventHandlers struct {
+	enter []PositionEventHandler
+	leave []PositionEventHandler
+}
+
+// AddPositionEnterEventHandler adds an event handler to be called when entering Position nodes.
+func (w *Walker) AddPositionEnterEventHandler(h PositionEventHandler) {
+	w.positionEventHandlers.enter = append(w.positionEventHandlers.enter, h)
+}
+
+// AddPositionLeaveEventHandler adds an event handler to be called when leaving Position nodes.
+func (w *Walker) AddPositionLeaveEventHandler(h PositionEventHandler) {
+	w.positionEventHandlers.leave = append(w.positionEventHandlers.leave, h)
+}
+
+// OnPositionEnter calls the enter event handlers registered for this node type.
+func (w *Walker) OnPositionEnter(ctx *Context, p ast.Position) {
+	for _, handler := range w.positionEventHandlers.enter {
+		handler(ctx, p)
+	}
+}
+
+// OnPositionLeave calls the leave event handlers registered for this node type.
+func (w *Walker) OnPositionLeave(ctx *Context, p ast.Position) {
+	for _, handler := range w.positionEventHandlers.leave {
+		handler(ctx, p)
+	}
+}
+
+// walkPosition is a function that walks Position type's AST node.
+func (w *Walker) walkPosition(ctx *Context, p ast.Position) {
+	w.OnPositionEnter(ctx, p)
+
+	w.OnPositionLeave(ctx, p)
+}
+
+// PositionsEventHandler function can handle enter/leave events for Positions.
+type PositionsEventHandler func(*Context, *ast.Positions)
+
+// PositionsEventHandlers stores the enter and leave events handlers.
+type PositionsEventHandlers struct {
+	enter []PositionsEventHandler
+	leave []PositionsEventHandler
+}
+
+// AddPositionsEnterEventHandler adds an event handler to be called when entering Positions nodes.
+func (w *Walker) AddPositionsEnterEventHandler(h PositionsEventHandler) {
+	w.positionsEventHandlers.enter = append(w.positionsEventHandlers.enter, h)
+}
+
+// AddPositionsLeaveEventHandler adds an event handler to be called when leaving Positions nodes.
+func (w *Walker) AddPositionsLeaveEventHandler(h PositionsEventHandler) {
+	w.positionsEventHandlers.leave = append(w.positionsEventHandlers.leave, h)
+}
+
+// OnPositionsEnter calls the enter event handlers registered for this node type.
+func (w *Walker) OnPositionsEnter(ctx *Context, ps *ast.Positions) {
+	for _, handler := range w.positionsEventHandlers.enter {
+		handler(ctx, ps)
+	}
+}
+
+// OnPositionsLeave calls the leave event handlers registered for this node type.
+func (w *Walker) OnPositionsLeave(ctx *Context, ps *ast.Positions) {
+	for _, handler := range w.positionsEventHandlers.leave {
+		handler(ctx, ps)
+	}
+}
+
+// walkPositions is a function that walks Positions type's AST node.
+func (w *Walker) walkPositions(ctx *Context, ps *ast.Positions) {
+	w.OnPositionsEnter(ctx, ps)
+
+	ps.ForEach(func(p ast.Position, i int) {
+		w.walkPosition(ctx, p)
+	})
+
+	w.OnPositionsLeave(ctx, ps)
+}
+
 // QueryOperationDefinitionEventHandler function can handle enter/leave events for QueryOperationDefinition.
 type QueryOperationDefinitionEventHandler func(*Context, *ast.OperationDefinition)
 